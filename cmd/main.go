@@ -15,6 +15,14 @@ func main() {
 
 	ctx := context.Background()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(ctx, os.Args[2:], os.Stdout); err != nil {
+			slog.Error(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := run(ctx); err != nil {
 		slog.Error(err.Error())
 		os.Exit(1)
@@ -35,7 +43,7 @@ func run(ctx context.Context) error {
 
 	errStart := container.Start()
 	if errStart != nil {
-		log.Fatal(err)
+		log.Fatal(errStart)
 	}
 
 	httpServer := server.New(container, config)
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/env"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/migration"
+)
+
+const migrateUsage = "usage: migrate up | down [steps] | status"
+
+// runMigrate handles "migrate up", "migrate down [steps]" and "migrate status",
+// printing the migration status of the configured database once the action is done.
+func runMigrate(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New(migrateUsage)
+	}
+
+	action := args[0]
+	steps := 1
+	if len(args) == 2 {
+		if action != migration.ActionDown {
+			return errors.New(migrateUsage)
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid steps %q, %s", args[1], migrateUsage)
+		}
+		steps = n
+	}
+
+	config, err := env.LoadEnvConfig()
+	if err != nil {
+		return err
+	}
+
+	container, err := container.New(config)
+	if err != nil {
+		return err
+	}
+
+	states, err := container.Migrate(ctx, action, steps)
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "VERSION\tDESCRIPTION\tAPPLIED AT")
+	for _, state := range states {
+		appliedAt := "pending"
+		if state.AppliedAt != nil {
+			appliedAt = state.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(writer, "%d\t%s\t%s\n", state.Version, state.Description, appliedAt)
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type CreateCategoryController struct {
+	usc *usecase.UscCreateCategory
+}
+
+func NewCreateCategoryController(container *container.Container) *CreateCategoryController {
+	return &CreateCategoryController{
+		usc: usecase.NewUseCaseCreateCategory(
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewCategoryPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *CreateCategoryController) Execute(ctx context.Context, category dto.CreateCategory) (dto.Category, error) {
+	return ctl.usc.Create(ctx, category)
+}
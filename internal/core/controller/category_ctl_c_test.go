@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestCreateCategoryController_Execute_Success(t *testing.T) {
+	ctx := context.Background()
+
+	categoryRepo := &repository.MockCategoryRepo{
+		CreateFunc: func(ctx context.Context, category *model.Category) error {
+			category.ID = 5
+			return nil
+		},
+	}
+
+	testContainer := &container.Container{
+		CategoryRepository: categoryRepo,
+	}
+
+	controller := NewCreateCategoryController(testContainer)
+
+	result, err := controller.Execute(ctx, dto.CreateCategory{Name: "Combo"})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, result.ID)
+	assert.Equal(t, "Combo", result.Name)
+}
+
+func TestCreateCategoryController_Execute_Error(t *testing.T) {
+	ctx := context.Background()
+
+	categoryRepo := &repository.MockCategoryRepo{
+		CreateFunc: func(ctx context.Context, category *model.Category) error {
+			return assert.AnError
+		},
+	}
+
+	testContainer := &container.Container{
+		CategoryRepository: categoryRepo,
+	}
+
+	controller := NewCreateCategoryController(testContainer)
+
+	_, err := controller.Execute(ctx, dto.CreateCategory{Name: "Combo"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type DeleteCategoryController struct {
+	usc *usecase.UscDeleteCategory
+}
+
+func NewDeleteCategoryController(container *container.Container) *DeleteCategoryController {
+	return &DeleteCategoryController{
+		usc: usecase.NewUseCaseDeleteCategory(
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+		),
+	}
+}
+
+func (ctl *DeleteCategoryController) Execute(ctx context.Context, input int) error {
+	return ctl.usc.DeleteById(ctx, input)
+}
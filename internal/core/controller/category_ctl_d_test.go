@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestDeleteCategoryController_Execute_Success(t *testing.T) {
+
+	deleted := 0
+
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			CountFunc: func(ctx context.Context, query model.ProductQuery) (int64, error) {
+				return 0, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepo{
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+				return &model.Category{ID: id, Name: "Combo"}, nil
+			},
+			DeleteByIdFunc: func(ctx context.Context, id int) error {
+				deleted = id
+				return nil
+			},
+		},
+	}
+
+	controller := NewDeleteCategoryController(container)
+
+	err := controller.Execute(context.Background(), 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, deleted)
+}
+
+func TestDeleteCategoryController_Execute_InUse(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			CountFunc: func(ctx context.Context, query model.ProductQuery) (int64, error) {
+				return 1, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepo{
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+				return &model.Category{ID: id, Name: "Lanche"}, nil
+			},
+		},
+	}
+
+	controller := NewDeleteCategoryController(container)
+
+	err := controller.Execute(context.Background(), 1)
+
+	assert.ErrorIs(t, err, usecase.ErrCategoryInUse)
+}
+
+func TestDeleteCategoryController_Execute_NotFound(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepo{},
+		CategoryRepository: &repository.MockCategoryRepoNotFound{},
+	}
+
+	controller := NewDeleteCategoryController(container)
+
+	err := controller.Execute(context.Background(), 42)
+
+	assert.ErrorIs(t, err, usecase.ErrCategoryNotFound)
+}
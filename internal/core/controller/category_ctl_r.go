@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindCategoryController struct {
+	usc *usecase.UscFindCategory
+}
+
+func NewFindCategoryController(container *container.Container) *FindCategoryController {
+	return &FindCategoryController{
+		usc: usecase.NewUseCaseFindCategory(
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewCategoryPresenter(),
+		),
+	}
+}
+
+func (ctl *FindCategoryController) Execute(ctx context.Context) (dto.CategoryContent, error) {
+	return ctl.usc.FindAll(ctx)
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindOneCategoryController struct {
+	usc *usecase.UscFindOneCategory
+}
+
+func NewFindOneCategoryController(container *container.Container) *FindOneCategoryController {
+	return &FindOneCategoryController{
+		usc: usecase.NewUseCaseFindOneCategory(
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewCategoryPresenter(),
+		),
+	}
+}
+
+func (ctl *FindOneCategoryController) Execute(ctx context.Context, input int) (dto.Category, error) {
+	return ctl.usc.FindById(ctx, input)
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestFindOneCategoryController_Execute_Success(t *testing.T) {
+
+	categoryRepo := &repository.MockCategoryRepo{
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+			if id == 5 {
+				return &model.Category{ID: 5, Name: "Combo"}, nil
+			}
+			return nil, nil
+		},
+	}
+
+	container := &container.Container{
+		CategoryRepository: categoryRepo,
+	}
+
+	controller := NewFindOneCategoryController(container)
+
+	result, err := controller.Execute(context.Background(), 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Combo", result.Name)
+}
+
+func TestFindOneCategoryController_Execute_NotFound(t *testing.T) {
+
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoNotFound{},
+	}
+
+	controller := NewFindOneCategoryController(container)
+
+	_, err := controller.Execute(context.Background(), 42)
+
+	assert.ErrorIs(t, err, usecase.ErrCategoryNotFound)
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestFindCategoryController_Execute_Success(t *testing.T) {
+
+	categoryRepo := &repository.MockCategoryRepo{
+		FindAllFunc: func(ctx context.Context) (*[]model.Category, error) {
+			return &[]model.Category{
+				{ID: 1, Name: "Lanche"},
+				{ID: 5, Name: "Combo"},
+			}, nil
+		},
+	}
+
+	container := &container.Container{
+		CategoryRepository: categoryRepo,
+	}
+
+	controller := NewFindCategoryController(container)
+
+	result, err := controller.Execute(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Content, 2)
+	assert.Equal(t, "Combo", result.Content[1].Name)
+}
+
+func TestFindCategoryController_Execute_Error(t *testing.T) {
+
+	categoryRepo := &repository.MockCategoryRepo{
+		FindAllFunc: func(ctx context.Context) (*[]model.Category, error) {
+			return nil, assert.AnError
+		},
+	}
+
+	container := &container.Container{
+		CategoryRepository: categoryRepo,
+	}
+
+	controller := NewFindCategoryController(container)
+
+	_, err := controller.Execute(context.Background())
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type UpdateCategoryController struct {
+	usc *usecase.UscUpdateCategory
+}
+
+func NewUpdateCategoryController(container *container.Container) *UpdateCategoryController {
+	return &UpdateCategoryController{
+		usc: usecase.NewUseCaseUpdateCategory(
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewCategoryPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *UpdateCategoryController) Execute(ctx context.Context, command dto.UpdateCategory) (dto.Category, error) {
+	return ctl.usc.UpdateById(ctx, command)
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestUpdateCategoryController_Execute_Success(t *testing.T) {
+
+	var updated *model.Category
+
+	categoryRepo := &repository.MockCategoryRepo{
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+			return &model.Category{ID: id, Name: "Combo"}, nil
+		},
+		UpdateByIdFunc: func(ctx context.Context, category *model.Category) error {
+			updated = category
+			return nil
+		},
+	}
+
+	container := &container.Container{
+		CategoryRepository: categoryRepo,
+	}
+
+	controller := NewUpdateCategoryController(container)
+
+	result, err := controller.Execute(context.Background(), dto.UpdateCategory{CategoryId: 5, Name: "Combos"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Combos", result.Name)
+	assert.Equal(t, 5, updated.ID)
+	assert.Equal(t, "Combos", updated.Name)
+}
+
+func TestUpdateCategoryController_Execute_NotFound(t *testing.T) {
+
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoNotFound{},
+	}
+
+	controller := NewUpdateCategoryController(container)
+
+	_, err := controller.Execute(context.Background(), dto.UpdateCategory{CategoryId: 42, Name: "Combos"})
+
+	assert.ErrorIs(t, err, usecase.ErrCategoryNotFound)
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type CreateDaypartController struct {
+	usc *usecase.UscCreateDaypart
+}
+
+func NewCreateDaypartController(container *container.Container) *CreateDaypartController {
+	return &CreateDaypartController{
+		usc: usecase.NewUseCaseCreateDaypart(
+			gateway.NewDaypartGateway(container.DaypartRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewDaypartPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *CreateDaypartController) Execute(ctx context.Context, document dto.DaypartDocument) (dto.Daypart, error) {
+	return ctl.usc.Create(ctx, document)
+}
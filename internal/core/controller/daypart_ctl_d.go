@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type DeleteDaypartController struct {
+	usc *usecase.UscDeleteDaypart
+}
+
+func NewDeleteDaypartController(container *container.Container) *DeleteDaypartController {
+	return &DeleteDaypartController{
+		usc: usecase.NewUseCaseDeleteDaypart(
+			gateway.NewDaypartGateway(container.DaypartRepository),
+		),
+	}
+}
+
+func (ctl *DeleteDaypartController) Execute(ctx context.Context, daypartId string) error {
+	return ctl.usc.DeleteById(ctx, daypartId)
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindDaypartController struct {
+	usc *usecase.UscFindDaypart
+}
+
+func NewFindDaypartController(container *container.Container) *FindDaypartController {
+	return &FindDaypartController{
+		usc: usecase.NewUseCaseFindDaypart(
+			gateway.NewDaypartGateway(container.DaypartRepository),
+			presenter.NewDaypartPresenter(),
+		),
+	}
+}
+
+func (ctl *FindDaypartController) Execute(ctx context.Context) (dto.DaypartContent, error) {
+	return ctl.usc.Find(ctx)
+}
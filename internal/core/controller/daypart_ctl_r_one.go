@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindOneDaypartController struct {
+	usc *usecase.UscFindDaypart
+}
+
+func NewFindOneDaypartController(container *container.Container) *FindOneDaypartController {
+	return &FindOneDaypartController{
+		usc: usecase.NewUseCaseFindDaypart(
+			gateway.NewDaypartGateway(container.DaypartRepository),
+			presenter.NewDaypartPresenter(),
+		),
+	}
+}
+
+func (ctl *FindOneDaypartController) Execute(ctx context.Context, daypartId string) (dto.Daypart, error) {
+	return ctl.usc.FindById(ctx, daypartId)
+}
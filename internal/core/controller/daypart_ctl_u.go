@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type UpdateDaypartController struct {
+	usc *usecase.UscUpdateDaypart
+}
+
+func NewUpdateDaypartController(container *container.Container) *UpdateDaypartController {
+	return &UpdateDaypartController{
+		usc: usecase.NewUseCaseUpdateDaypart(
+			gateway.NewDaypartGateway(container.DaypartRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewDaypartPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *UpdateDaypartController) Execute(ctx context.Context, command dto.UpdateDaypart) (dto.Daypart, error) {
+	return ctl.usc.UpdateById(ctx, command)
+}
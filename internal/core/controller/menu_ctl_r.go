@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindMenuController struct {
+	usc *usecase.UscFindMenu
+}
+
+func NewFindMenuController(container *container.Container) *FindMenuController {
+	return &FindMenuController{
+		usc: usecase.NewUseCaseFindMenu(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewDaypartGateway(container.DaypartRepository),
+			gateway.NewStockGateway(container.StockRepository, container.Clock),
+			presenter.NewMenuPresenter(),
+			container.MenuLocation,
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *FindMenuController) Execute(ctx context.Context, query dto.MenuQuery) (dto.Menu, error) {
+	return ctl.usc.Find(ctx, query)
+}
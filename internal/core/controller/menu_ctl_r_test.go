@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func newMenuTestContainer(t *testing.T, now time.Time) *container.Container {
+	t.Helper()
+
+	products := []model.Product{
+		{ID: "bread", Name: "Pão na chapa", CategoryId: 1},
+		{ID: "burger", Name: "X-Burger", CategoryId: 1},
+		{ID: "soda", Name: "Refrigerante", CategoryId: 3},
+	}
+
+	daypartRepo := dbrepository.NewDaypartMemoryRepository()
+	require.NoError(t, daypartRepo.Create(context.Background(), &model.Daypart{
+		ID:         "breakfast",
+		Name:       "Café da manhã",
+		Schedules:  model.DaypartSchedules{{Weekdays: []int{1, 2, 3, 4, 5}, Start: 6 * 60, End: 11 * 60}},
+		ProductIds: model.DaypartProducts{"bread"},
+	}))
+	require.NoError(t, daypartRepo.Create(context.Background(), &model.Daypart{
+		ID:          "lunch",
+		Name:        "Almoço",
+		Schedules:   model.DaypartSchedules{{Weekdays: []int{1, 2, 3, 4, 5}, Start: 11 * 60, End: 15 * 60}},
+		CategoryIds: model.DaypartCategories{1},
+	}))
+
+	return &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+				return &products, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepo{
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+				return &model.Category{ID: id, Name: "Category"}, nil
+			},
+		},
+		DaypartRepository: daypartRepo,
+		StockRepository:   dbrepository.NewStockMemoryRepository(),
+		MenuLocation:      time.UTC,
+		Clock:             clock.Fixed(now),
+	}
+}
+
+func menuProductIds(menu dto.Menu) []string {
+	ids := []string{}
+	for _, section := range menu.Sections {
+		for _, product := range section.Products {
+			ids = append(ids, product.ProductId)
+		}
+	}
+	return ids
+}
+
+func TestFindMenuController_Execute_AtTheClockTime(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 10, 59, 0, 0, time.UTC)
+
+	controller := NewFindMenuController(newMenuTestContainer(t, now))
+
+	result, err := controller.Execute(ctx, dto.MenuQuery{})
+	assert.NoError(t, err)
+	assert.True(t, result.At.Equal(now))
+	assert.Equal(t, "UTC", result.TimeZone)
+	assert.Equal(t, []string{"bread", "soda"}, menuProductIds(result))
+}
+
+func TestFindMenuController_Execute_AtTheInstantAsked(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 10, 59, 0, 0, time.UTC)
+	at := now.Add(time.Minute)
+
+	controller := NewFindMenuController(newMenuTestContainer(t, now))
+
+	result, err := controller.Execute(ctx, dto.MenuQuery{At: &at})
+	assert.NoError(t, err)
+	assert.True(t, result.At.Equal(at))
+	assert.Equal(t, []string{"burger", "soda"}, menuProductIds(result))
+	require.Len(t, result.Sections, 2)
+	assert.Equal(t, 1, result.Sections[0].Category.ID)
+	assert.Equal(t, 3, result.Sections[1].Category.ID)
+}
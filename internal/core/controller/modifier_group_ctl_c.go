@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type CreateModifierGroupController struct {
+	usc *usecase.UscCreateModifierGroup
+}
+
+func NewCreateModifierGroupController(container *container.Container) *CreateModifierGroupController {
+	return &CreateModifierGroupController{
+		usc: usecase.NewUseCaseCreateModifierGroup(
+			gateway.NewModifierGroupGateway(container.ModifierGroupRepository),
+			presenter.NewModifierGroupPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *CreateModifierGroupController) Execute(ctx context.Context, document dto.ModifierGroupDocument) (dto.ModifierGroup, error) {
+	return ctl.usc.Create(ctx, document)
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type DeleteModifierGroupController struct {
+	usc *usecase.UscDeleteModifierGroup
+}
+
+func NewDeleteModifierGroupController(container *container.Container) *DeleteModifierGroupController {
+	return &DeleteModifierGroupController{
+		usc: usecase.NewUseCaseDeleteModifierGroup(
+			gateway.NewModifierGroupGateway(container.ModifierGroupRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+		),
+	}
+}
+
+func (ctl *DeleteModifierGroupController) Execute(ctx context.Context, groupId string) error {
+	return ctl.usc.DeleteById(ctx, groupId)
+}
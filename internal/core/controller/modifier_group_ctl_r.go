@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindModifierGroupController struct {
+	usc *usecase.UscFindModifierGroup
+}
+
+func NewFindModifierGroupController(container *container.Container) *FindModifierGroupController {
+	return &FindModifierGroupController{
+		usc: usecase.NewUseCaseFindModifierGroup(
+			gateway.NewModifierGroupGateway(container.ModifierGroupRepository),
+			presenter.NewModifierGroupPresenter(),
+		),
+	}
+}
+
+func (ctl *FindModifierGroupController) Execute(ctx context.Context) (dto.ModifierGroupContent, error) {
+	return ctl.usc.Find(ctx)
+}
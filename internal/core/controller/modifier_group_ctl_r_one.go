@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindOneModifierGroupController struct {
+	usc *usecase.UscFindModifierGroup
+}
+
+func NewFindOneModifierGroupController(container *container.Container) *FindOneModifierGroupController {
+	return &FindOneModifierGroupController{
+		usc: usecase.NewUseCaseFindModifierGroup(
+			gateway.NewModifierGroupGateway(container.ModifierGroupRepository),
+			presenter.NewModifierGroupPresenter(),
+		),
+	}
+}
+
+func (ctl *FindOneModifierGroupController) Execute(ctx context.Context, groupId string) (dto.ModifierGroup, error) {
+	return ctl.usc.FindById(ctx, groupId)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type UpdateModifierGroupController struct {
+	usc *usecase.UscUpdateModifierGroup
+}
+
+func NewUpdateModifierGroupController(container *container.Container) *UpdateModifierGroupController {
+	return &UpdateModifierGroupController{
+		usc: usecase.NewUseCaseUpdateModifierGroup(
+			gateway.NewModifierGroupGateway(container.ModifierGroupRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewModifierGroupPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *UpdateModifierGroupController) Execute(ctx context.Context, command dto.UpdateModifierGroup) (dto.ModifierGroup, error) {
+	return ctl.usc.UpdateById(ctx, command)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type ChangeProductAvailabilityController struct {
+	usc *usecase.UscChangeProductAvailability
+}
+
+func NewChangeProductAvailabilityController(container *container.Container) *ChangeProductAvailabilityController {
+	return &ChangeProductAvailabilityController{
+		usc: usecase.NewUseCaseChangeProductAvailability(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewAvailabilityGateway(container.AvailabilityRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *ChangeProductAvailabilityController) Execute(ctx context.Context, command dto.ChangeProductAvailability) (dto.Product, error) {
+	return ctl.usc.Change(ctx, command)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindProductAvailabilityController struct {
+	usc *usecase.UscFindProductAvailability
+}
+
+func NewFindProductAvailabilityController(container *container.Container) *FindProductAvailabilityController {
+	return &FindProductAvailabilityController{
+		usc: usecase.NewUseCaseFindProductAvailability(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewAvailabilityGateway(container.AvailabilityRepository),
+			presenter.NewProductPresenter(),
+		),
+	}
+}
+
+func (ctl *FindProductAvailabilityController) Execute(ctx context.Context, productId string) (dto.ProductAvailability, error) {
+	return ctl.usc.Find(ctx, productId)
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type BulkProductController struct {
+	usc *usecase.UscBulkProduct
+}
+
+func NewBulkProductController(container *container.Container) *BulkProductController {
+	return &BulkProductController{
+		usc: usecase.NewUseCaseBulkProduct(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *BulkProductController) Execute(ctx context.Context, command dto.BulkProducts) (dto.BulkReport, error) {
+	return ctl.usc.Bulk(ctx, command)
+}
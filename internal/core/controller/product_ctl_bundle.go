@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type ReplaceBundleComponentsController struct {
+	usc *usecase.UscReplaceBundleComponents
+}
+
+func NewReplaceBundleComponentsController(container *container.Container) *ReplaceBundleComponentsController {
+	return &ReplaceBundleComponentsController{
+		usc: usecase.NewUseCaseReplaceBundleComponents(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *ReplaceBundleComponentsController) Execute(ctx context.Context, command dto.ReplaceBundleComponents) (dto.Product, error) {
+	return ctl.usc.Replace(ctx, command)
+}
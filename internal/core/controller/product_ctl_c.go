@@ -19,9 +19,11 @@ func NewCreateProductController(container *container.Container) *CreateProductCo
 	return &CreateProductController{
 		container: container,
 		usc: usecase.NewUseCaseCreateProduct(
-			gateway.NewProductGateway(container.ProductRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
 			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
 			presenter.NewProductPresenter(),
+			container.Clock,
 		),
 	}
 }
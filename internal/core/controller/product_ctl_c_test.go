@@ -3,12 +3,13 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 	"github.com/tbtec/tremligeiro/test/repository"
 )
 
@@ -21,11 +22,11 @@ func TestCreateProductController_Execute_Success(t *testing.T) {
 		},
 	}
 	categoryRepo := &repository.MockCategoryRepo{
-		FindByIdFunc: func(id int) *entity.Category {
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
 			if id == 1 {
-				return &entity.Category{ID: 1, Name: "Category 1"}
+				return &model.Category{ID: 1, Name: "Category 1"}, nil
 			}
-			return nil
+			return nil, nil
 		},
 	}
 
@@ -49,19 +50,53 @@ func TestCreateProductController_Execute_Success(t *testing.T) {
 	assert.Equal(t, 1, result.Category.ID)
 }
 
-func TestCreateProductController_Execute_CategoryNotFound(t *testing.T) {
+func TestCreateProductController_Execute_AtTheClockTime(t *testing.T) {
 	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
 
+	var created *model.Product
 	productRepo := &repository.MockProductRepo{
 		CreateFunc: func(ctx context.Context, product *model.Product) error {
+			created = product
 			return nil
 		},
 	}
 	categoryRepo := &repository.MockCategoryRepo{
-		FindByIdFunc: func(id int) *entity.Category {
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+			return &model.Category{ID: id, Name: "Category 1"}, nil
+		},
+	}
+
+	testContainer := &container.Container{
+		ProductRepository:  productRepo,
+		CategoryRepository: categoryRepo,
+		Clock:              clock.Fixed(now),
+	}
+
+	controller := NewCreateProductController(testContainer)
+
+	result, err := controller.Execute(ctx, dto.CreateProduct{Name: "Product 1", Description: "Description 1", CategoryId: 1, Amount: 100.0})
+	assert.NoError(t, err)
+	assert.True(t, result.CreatedAt.Equal(now))
+	assert.True(t, result.UpdatedAt.Equal(now))
+	if assert.NotNil(t, created) {
+		assert.True(t, created.CreatedAt.Equal(now))
+	}
+}
+
+func TestCreateProductController_Execute_CategoryNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	productRepo := &repository.MockProductRepo{
+		CreateFunc: func(ctx context.Context, product *model.Product) error {
 			return nil
 		},
 	}
+	categoryRepo := &repository.MockCategoryRepo{
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+			return nil, nil
+		},
+	}
 
 	testContainer := &container.Container{
 		ProductRepository:  productRepo,
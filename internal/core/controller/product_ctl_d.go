@@ -6,6 +6,7 @@ import (
 	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
 	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 )
 
@@ -16,12 +17,14 @@ type DeleteProductController struct {
 func NewDeleteProductController(container *container.Container) *DeleteProductController {
 	return &DeleteProductController{
 		usc: usecase.NewUseCaseDeleteProduct(
-			gateway.NewProductGateway(container.ProductRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
 			presenter.NewProductPresenter(),
+			container.Clock,
 		),
 	}
 }
 
-func (ctl *DeleteProductController) Execute(ctx context.Context, input string) (string, error) {
-	return ctl.usc.DeleteById(ctx, input)
+func (ctl *DeleteProductController) Execute(ctx context.Context, command dto.DeleteProduct) (string, error) {
+	return ctl.usc.DeleteById(ctx, command)
 }
@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 	"github.com/tbtec/tremligeiro/test/repository"
 )
 
@@ -15,7 +20,7 @@ func TestDeleteProductController_Execute_Success(t *testing.T) {
 	ctx := context.Background()
 
 	productRepo := &repository.MockProductRepo{
-		DeleteByIdFunc: func(ctx context.Context, id string) (*model.Product, error) {
+		DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
 			if id == "prod1" {
 				return &model.Product{ID: "prod1"}, nil
 			}
@@ -30,16 +35,40 @@ func TestDeleteProductController_Execute_Success(t *testing.T) {
 	controller := NewDeleteProductController(testContainer)
 
 	id := "prod1"
-	result, err := controller.Execute(ctx, id)
+	result, err := controller.Execute(ctx, dto.DeleteProduct{ProductId: id})
 	assert.NoError(t, err)
 	assert.Equal(t, id, result)
 }
 
+func TestDeleteProductController_Execute_StampedByClock(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+
+	var deletedAt time.Time
+	productRepo := &repository.MockProductRepo{
+		DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+			deletedAt = at
+			return &model.Product{ID: id}, nil
+		},
+	}
+
+	testContainer := &container.Container{
+		ProductRepository: productRepo,
+		Clock:             clock.Fixed(now),
+	}
+
+	controller := NewDeleteProductController(testContainer)
+
+	_, err := controller.Execute(ctx, dto.DeleteProduct{ProductId: "prod1"})
+	assert.NoError(t, err)
+	assert.True(t, deletedAt.Equal(now))
+}
+
 func TestDeleteProductController_Execute_NotFound(t *testing.T) {
 	ctx := context.Background()
 
 	productRepo := &repository.MockProductRepo{
-		DeleteByIdFunc: func(ctx context.Context, id string) (*model.Product, error) {
+		DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
 			return nil, errors.New("not found")
 		},
 	}
@@ -51,7 +80,22 @@ func TestDeleteProductController_Execute_NotFound(t *testing.T) {
 	controller := NewDeleteProductController(testContainer)
 
 	id := ""
-	result, err := controller.Execute(ctx, id)
+	result, err := controller.Execute(ctx, dto.DeleteProduct{ProductId: id})
 	assert.Error(t, err)
 	assert.Empty(t, result)
 }
+
+func TestDeleteProductController_Execute_Missing(t *testing.T) {
+	productRepo := &repository.MockProductRepo{
+		DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+			return nil, dbrepository.ErrNotFound
+		},
+	}
+
+	controller := NewDeleteProductController(&container.Container{ProductRepository: productRepo})
+
+	result, err := controller.Execute(context.Background(), dto.DeleteProduct{ProductId: "prod-404"})
+
+	assert.ErrorIs(t, err, usecase.ErrProductNotFound)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,162 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func newEventContainer(productRepo dbrepository.IProductRepository) *container.Container {
+	return &container.Container{
+		ProductRepository:  productRepo,
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+		OutboxRepository:   dbrepository.NewOutboxMemoryRepository(),
+		Transactor:         dbrepository.NewMemoryTransactor(),
+	}
+}
+
+func pendingEvents(t *testing.T, c *container.Container) []model.OutboxEvent {
+	t.Helper()
+
+	events, err := c.OutboxRepository.FindPending(context.Background(), 10)
+	require.NoError(t, err)
+
+	return *events
+}
+
+func TestCreateProductController_Execute_RecordsEvent(t *testing.T) {
+	productRepo := &repository.MockProductRepo{
+		CreateFunc: func(ctx context.Context, product *model.Product) error {
+			return nil
+		},
+	}
+	c := newEventContainer(productRepo)
+
+	result, err := NewCreateProductController(c).Execute(context.Background(), dto.CreateProduct{
+		Name: "Product 1", Description: "Description 1", CategoryId: 1, Amount: 10.0,
+	})
+	require.NoError(t, err)
+
+	events := pendingEvents(t, c)
+	require.Len(t, events, 1)
+	assert.Equal(t, string(entity.ProductCreated), events[0].Type)
+	assert.Equal(t, result.ProductId, events[0].AggregateId)
+
+	payload := dto.ProductEvent{}
+	require.NoError(t, json.Unmarshal([]byte(events[0].Payload), &payload))
+	assert.Equal(t, result, payload.Product)
+	assert.Nil(t, payload.PreviousAmount)
+}
+
+func TestCreateProductController_Execute_OutboxFailure(t *testing.T) {
+	productRepo := &repository.MockProductRepo{
+		CreateFunc: func(ctx context.Context, product *model.Product) error {
+			return nil
+		},
+	}
+	c := newEventContainer(productRepo)
+	c.OutboxRepository = &repository.MockOutboxRepo{
+		AddFunc: func(ctx context.Context, events ...model.OutboxEvent) error {
+			return assert.AnError
+		},
+	}
+
+	_, err := NewCreateProductController(c).Execute(context.Background(), dto.CreateProduct{
+		Name: "Product 1", Description: "Description 1", CategoryId: 1, Amount: 10.0,
+	})
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUpdateProductController_Execute_RecordsEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		types  []string
+	}{
+		{
+			name:   "same price",
+			amount: 10.0,
+			types:  []string{string(entity.ProductUpdated)},
+		},
+		{
+			name:   "new price",
+			amount: 12.5,
+			types:  []string{string(entity.ProductUpdated), string(entity.ProductPriceChanged)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productRepo := &repository.MockProductRepo{
+				FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+					return &model.Product{ID: id, Name: "Old Name", CategoryId: 1, Amount: 10.0, Version: 1}, nil
+				},
+				UpdateByIdFunc: func(ctx context.Context, product *model.Product) error {
+					product.Version++
+					return nil
+				},
+			}
+			c := newEventContainer(productRepo)
+
+			_, err := NewUpdateProductController(c).Execute(context.Background(), dto.UpdateProduct{
+				ProductId: "prod-1", Name: "New Name", CategoryId: 1, Amount: tt.amount,
+			})
+			require.NoError(t, err)
+
+			types := []string{}
+			for _, event := range pendingEvents(t, c) {
+				types = append(types, event.Type)
+				if event.Type == string(entity.ProductPriceChanged) {
+					payload := dto.ProductEvent{}
+					require.NoError(t, json.Unmarshal([]byte(event.Payload), &payload))
+					assert.Equal(t, 10.0, *payload.PreviousAmount)
+					assert.Equal(t, tt.amount, payload.Amount)
+					assert.Equal(t, int64(2), payload.Version)
+				}
+			}
+			assert.Equal(t, tt.types, types)
+		})
+	}
+}
+
+func TestDeleteProductController_Execute_RecordsEvent(t *testing.T) {
+	productRepo := &repository.MockProductRepo{
+		DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+			return &model.Product{ID: id, CategoryId: 2, Version: 3}, nil
+		},
+	}
+	c := newEventContainer(productRepo)
+
+	_, err := NewDeleteProductController(c).Execute(context.Background(), dto.DeleteProduct{ProductId: "prod-1"})
+	require.NoError(t, err)
+
+	events := pendingEvents(t, c)
+	require.Len(t, events, 1)
+	assert.Equal(t, string(entity.ProductDeleted), events[0].Type)
+	assert.Equal(t, "prod-1", events[0].AggregateId)
+}
+
+func TestDeleteProductController_Execute_NoEventWhenMissing(t *testing.T) {
+	productRepo := &repository.MockProductRepo{
+		DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+			return nil, dbrepository.ErrNotFound
+		},
+	}
+	c := newEventContainer(productRepo)
+
+	_, err := NewDeleteProductController(c).Execute(context.Background(), dto.DeleteProduct{ProductId: "prod-404"})
+
+	assert.Error(t, err)
+	assert.Empty(t, pendingEvents(t, c))
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+	"io"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type ExportProductController struct {
+	usc *usecase.UscExportProduct
+}
+
+func NewExportProductController(container *container.Container) *ExportProductController {
+	return &ExportProductController{
+		usc: usecase.NewUseCaseExportProduct(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewProductPresenter(),
+		),
+	}
+}
+
+func (ctl *ExportProductController) Execute(ctx context.Context, command dto.ExportProducts) (func(w io.Writer) error, error) {
+	return ctl.usc.Export(ctx, command)
+}
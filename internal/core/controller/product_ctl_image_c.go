@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type UploadProductImageController struct {
+	usc *usecase.UscUploadProductImage
+}
+
+func NewUploadProductImageController(container *container.Container) *UploadProductImageController {
+	return &UploadProductImageController{
+		usc: usecase.NewUseCaseUploadProductImage(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.ImageStorage,
+			container.ImagePolicy,
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *UploadProductImageController) Execute(ctx context.Context, command dto.UploadProductImage) (dto.Product, error) {
+	return ctl.usc.Upload(ctx, command)
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type DeleteProductImageController struct {
+	usc *usecase.UscDeleteProductImage
+}
+
+func NewDeleteProductImageController(container *container.Container) *DeleteProductImageController {
+	return &DeleteProductImageController{
+		usc: usecase.NewUseCaseDeleteProductImage(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			container.ImageStorage,
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *DeleteProductImageController) Execute(ctx context.Context, command dto.DeleteProductImage) error {
+	return ctl.usc.DeleteById(ctx, command)
+}
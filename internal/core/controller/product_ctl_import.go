@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type ImportProductController struct {
+	usc *usecase.UscImportProduct
+}
+
+func NewImportProductController(container *container.Container) *ImportProductController {
+	return &ImportProductController{
+		usc: usecase.NewUseCaseImportProduct(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *ImportProductController) Execute(ctx context.Context, command dto.ImportProducts) (dto.ImportReport, error) {
+	return ctl.usc.Import(ctx, command)
+}
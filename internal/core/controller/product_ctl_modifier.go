@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type AttachModifierGroupsController struct {
+	usc *usecase.UscAttachModifierGroups
+}
+
+func NewAttachModifierGroupsController(container *container.Container) *AttachModifierGroupsController {
+	return &AttachModifierGroupsController{
+		usc: usecase.NewUseCaseAttachModifierGroups(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewModifierGroupGateway(container.ModifierGroupRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *AttachModifierGroupsController) Execute(ctx context.Context, command dto.AttachModifierGroups) (dto.Product, error) {
+	return ctl.usc.Attach(ctx, command)
+}
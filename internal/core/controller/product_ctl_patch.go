@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type PatchProductController struct {
+	usc *usecase.UscPatchProduct
+}
+
+func NewPatchProductController(container *container.Container) *PatchProductController {
+	return &PatchProductController{
+		usc: usecase.NewUseCasePatchProduct(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *PatchProductController) Execute(ctx context.Context, command dto.PatchProduct) (dto.Product, error) {
+	return ctl.usc.PatchById(ctx, command)
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type PriceProductController struct {
+	usc *usecase.UscPriceProduct
+}
+
+func NewPriceProductController(container *container.Container) *PriceProductController {
+	return &PriceProductController{
+		usc: usecase.NewUseCasePriceProduct(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			presenter.NewProductPresenter(),
+		),
+	}
+}
+
+func (ctl *PriceProductController) Execute(ctx context.Context, command dto.PriceProduct) (dto.ProductPrice, error) {
+	return ctl.usc.Price(ctx, command)
+}
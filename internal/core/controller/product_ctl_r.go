@@ -17,13 +17,13 @@ type FindProductController struct {
 func NewFindProductController(container *container.Container) *FindProductController {
 	return &FindProductController{
 		usc: usecase.NewUseCaseFindProduct(
-			gateway.NewProductGateway(container.ProductRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
 			gateway.NewCategoryGateway(container.CategoryRepository),
 			presenter.NewProductPresenter(),
 		),
 	}
 }
 
-func (ctl *FindProductController) Execute(ctx context.Context, input int) (dto.ProductContent, error) {
-	return ctl.usc.FindByCategory(ctx, input)
+func (ctl *FindProductController) Execute(ctx context.Context, input dto.ProductQuery) (dto.ProductContent, error) {
+	return ctl.usc.Find(ctx, input)
 }
@@ -17,7 +17,7 @@ type FindOneProductController struct {
 func NewFindOneProductController(container *container.Container) *FindOneProductController {
 	return &FindOneProductController{
 		usc: usecase.NewUseCaseFindOneProduct(
-			gateway.NewProductGateway(container.ProductRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
 			gateway.NewCategoryGateway(container.CategoryRepository),
 			presenter.NewProductPresenter(),
 		),
@@ -7,9 +7,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
 	"github.com/tbtec/tremligeiro/test/repository"
 )
 
@@ -34,11 +35,11 @@ func TestFindOneProductController_Execute_Success_WithMock(t *testing.T) {
 	}
 
 	categoryRepo := &repository.MockCategoryRepo{
-		FindByIdFunc: func(id int) *entity.Category {
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
 			if id == 1 {
-				return &entity.Category{ID: 1, Name: "Category 1"}
+				return &model.Category{ID: 1, Name: "Category 1"}, nil
 			}
-			return nil
+			return nil, nil
 		},
 	}
 
@@ -67,8 +68,8 @@ func TestFindOneProductController_Execute_NotFound(t *testing.T) {
 	}
 
 	categoryRepo := &repository.MockCategoryRepo{
-		FindByIdFunc: func(id int) *entity.Category {
-			return nil
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+			return nil, nil
 		},
 	}
 
@@ -84,3 +85,23 @@ func TestFindOneProductController_Execute_NotFound(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestFindOneProductController_Execute_Missing(t *testing.T) {
+
+	productRepo := &repository.MockProductRepo{
+		FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+			return nil, dbrepository.ErrNotFound
+		},
+	}
+
+	container := &container.Container{
+		ProductRepository:  productRepo,
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+
+	controller := NewFindOneProductController(container)
+
+	_, err := controller.Execute(context.Background(), "prod-404")
+
+	assert.ErrorIs(t, err, usecase.ErrProductNotFound)
+}
@@ -3,10 +3,11 @@ package controller
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
 	"github.com/tbtec/tremligeiro/test/repository"
@@ -21,8 +22,8 @@ func TestFindProductController_Execute_Success(t *testing.T) {
 	mockProducts := &mockProductsSlice
 
 	productRepo := &repository.MockProductRepo{
-		FindByCategoryFunc: func(ctx context.Context, categoryId int) (*[]model.Product, error) {
-			if categoryId == 10 {
+		FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+			if slices.Contains(query.CategoryIds, 10) {
 
 				return mockProducts, nil
 			}
@@ -32,11 +33,11 @@ func TestFindProductController_Execute_Success(t *testing.T) {
 	}
 
 	categoryRepo := &repository.MockCategoryRepo{
-		FindByIdFunc: func(id int) *entity.Category {
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
 			if id == 10 {
-				return &entity.Category{ID: 10, Name: "Category 10"}
+				return &model.Category{ID: 10, Name: "Category 10"}, nil
 			}
-			return nil
+			return nil, nil
 		},
 	}
 
@@ -48,7 +49,7 @@ func TestFindProductController_Execute_Success(t *testing.T) {
 	controller := NewFindProductController(container)
 
 	ctx := context.Background()
-	result, err := controller.Execute(ctx, 10)
+	result, err := controller.Execute(ctx, dto.ProductQuery{CategoryIds: []int{10}})
 
 	assert.NoError(t, err)
 	assert.Len(t, result.Content, 2)
@@ -64,8 +65,8 @@ func TestFindProductController_Execute_Error(t *testing.T) {
 		},
 	}
 	categoryRepo := &repository.MockCategoryRepo{
-		FindByIdFunc: func(id int) *entity.Category {
-			return nil
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+			return nil, nil
 		},
 	}
 
@@ -76,7 +77,7 @@ func TestFindProductController_Execute_Error(t *testing.T) {
 	controller := NewFindProductController(container)
 
 	ctx := context.Background()
-	_, err := controller.Execute(ctx, 10)
+	_, err := controller.Execute(ctx, dto.ProductQuery{CategoryIds: []int{10}})
 
 	assert.Error(t, err)
 }
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type RestoreProductController struct {
+	usc *usecase.UscRestoreProduct
+}
+
+func NewRestoreProductController(container *container.Container) *RestoreProductController {
+	return &RestoreProductController{
+		usc: usecase.NewUseCaseRestoreProduct(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *RestoreProductController) Execute(ctx context.Context, productId string) (dto.Product, error) {
+	return ctl.usc.RestoreById(ctx, productId)
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestRestoreProductController_Execute_Success(t *testing.T) {
+	productRepo := &repository.MockProductRepo{
+		RestoreFunc: func(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+			return &model.Product{ID: id, Name: "Pudim", CategoryId: 4, Amount: 9.9, Version: 3, CreatedAt: time.Now()}, nil
+		},
+	}
+
+	testContainer := &container.Container{
+		ProductRepository: productRepo,
+		CategoryRepository: &repository.MockCategoryRepo{
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+				return &model.Category{ID: id, Name: "Sobremesa"}, nil
+			},
+		},
+	}
+
+	controller := NewRestoreProductController(testContainer)
+
+	result, err := controller.Execute(context.Background(), "prod1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "prod1", result.ProductId)
+	assert.Equal(t, "Sobremesa", result.Category.Name)
+	assert.Nil(t, result.DeletedAt)
+	assert.Equal(t, int64(3), result.Version)
+}
+
+func TestRestoreProductController_Execute_NotInTrash(t *testing.T) {
+	testContainer := &container.Container{
+		ProductRepository:  &repository.MockProductRepo{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+
+	controller := NewRestoreProductController(testContainer)
+
+	_, err := controller.Execute(context.Background(), "prod1")
+
+	assert.ErrorIs(t, err, usecase.ErrProductNotFound)
+}
+
+func TestRestoreProductController_Execute_CategoryRemoved(t *testing.T) {
+	testContainer := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			RestoreFunc: func(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+				return &model.Product{ID: id, CategoryId: 9}, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoNotFound{},
+	}
+
+	controller := NewRestoreProductController(testContainer)
+
+	_, err := controller.Execute(context.Background(), "prod1")
+
+	assert.ErrorIs(t, err, usecase.ErrCategoryNotExists)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type SearchProductController struct {
+	usc *usecase.UscSearchProduct
+}
+
+func NewSearchProductController(container *container.Container) *SearchProductController {
+	return &SearchProductController{
+		usc: usecase.NewUseCaseSearchProduct(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			presenter.NewProductPresenter(),
+		),
+	}
+}
+
+func (ctl *SearchProductController) Execute(ctx context.Context, input dto.ProductSearch) (dto.ProductSearchContent, error) {
+	return ctl.usc.Search(ctx, input)
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestSearchProductController_Execute_Success(t *testing.T) {
+
+	var receivedText string
+	var receivedLimit int
+
+	productRepo := &repository.MockProductRepo{
+		SearchFunc: func(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+			receivedText = text
+			receivedLimit = limit
+			return &[]model.ProductMatch{
+				{Product: model.Product{ID: "prod1", Name: "Pão de Queijo", Description: "Porção com 6 pães", CategoryId: 2}, Score: 12.5},
+				{Product: model.Product{ID: "prod2", Name: "Açaí", Description: "Tigela de açaí com granola", CategoryId: 4}, Score: 3},
+			}, nil
+		},
+	}
+
+	container := &container.Container{
+		ProductRepository:  productRepo,
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+
+	controller := NewSearchProductController(container)
+
+	result, err := controller.Execute(context.Background(), dto.ProductSearch{Query: "pao acai", Limit: 10})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "pao acai", receivedText)
+	assert.Equal(t, 10, receivedLimit)
+	assert.Len(t, result.Content, 2)
+	assert.Equal(t, 12.5, result.Content[0].Score)
+	assert.Equal(t, "<em>Pão</em> de Queijo", result.Content[0].Highlights["name"])
+	assert.NotContains(t, result.Content[0].Highlights, "description")
+	assert.Equal(t, "<em>Açaí</em>", result.Content[1].Highlights["name"])
+	assert.Equal(t, "Tigela de <em>açaí</em> com granola", result.Content[1].Highlights["description"])
+}
+
+func TestSearchProductController_Execute_Error(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepoError{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+
+	controller := NewSearchProductController(container)
+
+	_, err := controller.Execute(context.Background(), dto.ProductSearch{Query: "pao", Limit: 10})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type ChangeProductStockController struct {
+	usc *usecase.UscChangeProductStock
+}
+
+func NewChangeProductStockController(container *container.Container) *ChangeProductStockController {
+	return &ChangeProductStockController{
+		usc: usecase.NewUseCaseChangeProductStock(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewStockGateway(container.StockRepository, container.Clock),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *ChangeProductStockController) Execute(ctx context.Context, command dto.ChangeProductStock) (dto.ProductStock, error) {
+	return ctl.usc.Change(ctx, command)
+}
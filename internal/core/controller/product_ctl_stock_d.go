@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type DeleteProductStockController struct {
+	usc *usecase.UscDeleteProductStock
+}
+
+func NewDeleteProductStockController(container *container.Container) *DeleteProductStockController {
+	return &DeleteProductStockController{
+		usc: usecase.NewUseCaseDeleteProductStock(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewStockGateway(container.StockRepository, container.Clock),
+		),
+	}
+}
+
+func (ctl *DeleteProductStockController) Execute(ctx context.Context, productId string) error {
+	return ctl.usc.Delete(ctx, productId)
+}
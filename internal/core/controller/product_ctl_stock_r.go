@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindProductStockController struct {
+	usc *usecase.UscFindProductStock
+}
+
+func NewFindProductStockController(container *container.Container) *FindProductStockController {
+	return &FindProductStockController{
+		usc: usecase.NewUseCaseFindProductStock(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			presenter.NewProductPresenter(),
+		),
+	}
+}
+
+func (ctl *FindProductStockController) Execute(ctx context.Context, productId string) (dto.ProductStock, error) {
+	return ctl.usc.Find(ctx, productId)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type SaveProductStockController struct {
+	usc *usecase.UscSaveProductStock
+}
+
+func NewSaveProductStockController(container *container.Container) *SaveProductStockController {
+	return &SaveProductStockController{
+		usc: usecase.NewUseCaseSaveProductStock(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewStockGateway(container.StockRepository, container.Clock),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *SaveProductStockController) Execute(ctx context.Context, command dto.SaveProductStock) (dto.ProductStock, error) {
+	return ctl.usc.Save(ctx, command)
+}
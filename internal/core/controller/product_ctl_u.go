@@ -17,9 +17,11 @@ type UpdateProductController struct {
 func NewUpdateProductController(container *container.Container) *UpdateProductController {
 	return &UpdateProductController{
 		usc: usecase.NewUseCaseUpdateProduct(
-			gateway.NewProductGateway(container.ProductRepository),
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
 			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
 			presenter.NewProductPresenter(),
+			container.Clock,
 		),
 	}
 }
@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
 	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
 	"github.com/tbtec/tremligeiro/test/repository"
 )
 
@@ -40,11 +42,11 @@ func TestUpdateProductController_Execute_Success(t *testing.T) {
 	}
 
 	categoryRepo := &repository.MockCategoryRepo{
-		FindByIdFunc: func(id int) *entity.Category {
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
 			if id == 1 {
-				return &entity.Category{ID: 1, Name: "Category 1"}
+				return &model.Category{ID: 1, Name: "Category 1"}, nil
 			}
-			return nil
+			return nil, nil
 		},
 	}
 
@@ -87,8 +89,8 @@ func TestUpdateProductController_Execute_NotFound(t *testing.T) {
 	}
 	categoryRepo := &repository.MockCategoryRepo{
 
-		FindByIdFunc: func(id int) *entity.Category {
-			return nil
+		FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+			return nil, nil
 		},
 	}
 
@@ -108,3 +110,118 @@ func TestUpdateProductController_Execute_NotFound(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestUpdateProductController_Execute_VersionConflict(t *testing.T) {
+
+	updated := false
+	productRepo := &repository.MockProductRepo{
+		FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+			return &model.Product{ID: id, Name: "Old Name", CategoryId: 1, Amount: 10.0, Version: 4}, nil
+		},
+		UpdateByIdFunc: func(ctx context.Context, product *model.Product) error {
+			updated = true
+			return nil
+		},
+	}
+
+	container := &container.Container{
+		ProductRepository:  productRepo,
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	controller := NewUpdateProductController(container)
+
+	updateCmd := dto.UpdateProduct{
+		ProductId:  "prod-1",
+		Name:       "New Name",
+		CategoryId: 1,
+		Amount:     20.0,
+		Version:    3,
+	}
+	_, err := controller.Execute(context.Background(), updateCmd)
+
+	var conflict xerrors.ConflictError
+	assert.ErrorAs(t, err, &conflict)
+	assert.False(t, updated)
+}
+
+func TestUpdateProductController_Execute_ExpectedVersion(t *testing.T) {
+
+	var expected int64
+	productRepo := &repository.MockProductRepo{
+		FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+			return &model.Product{ID: id, Name: "Old Name", CategoryId: 1, Amount: 10.0, Version: 4}, nil
+		},
+		UpdateByIdFunc: func(ctx context.Context, product *model.Product) error {
+			expected = product.Version
+			product.Version++
+			return nil
+		},
+	}
+
+	container := &container.Container{
+		ProductRepository:  productRepo,
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	controller := NewUpdateProductController(container)
+
+	updateCmd := dto.UpdateProduct{
+		ProductId:  "prod-1",
+		Name:       "New Name",
+		CategoryId: 1,
+		Amount:     20.0,
+		Version:    4,
+	}
+	result, err := controller.Execute(context.Background(), updateCmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), expected)
+	assert.Equal(t, int64(5), result.Version)
+}
+
+func TestUpdateProductController_Execute_Missing(t *testing.T) {
+	tests := []struct {
+		name        string
+		findOne     func(ctx context.Context, id string) (*model.Product, error)
+		updateError error
+	}{
+		{
+			name: "absent before the update",
+			findOne: func(ctx context.Context, id string) (*model.Product, error) {
+				return nil, dbrepository.ErrNotFound
+			},
+		},
+		{
+			name: "deleted during the update",
+			findOne: func(ctx context.Context, id string) (*model.Product, error) {
+				return &model.Product{ID: id, CategoryId: 1}, nil
+			},
+			updateError: dbrepository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productRepo := &repository.MockProductRepo{
+				FindOneFunc: tt.findOne,
+				UpdateByIdFunc: func(ctx context.Context, product *model.Product) error {
+					return tt.updateError
+				},
+			}
+
+			container := &container.Container{
+				ProductRepository:  productRepo,
+				CategoryRepository: &repository.MockCategoryRepoInterface{},
+			}
+			controller := NewUpdateProductController(container)
+
+			_, err := controller.Execute(context.Background(), dto.UpdateProduct{
+				ProductId:  "prod-404",
+				Name:       "Doesn't Matter",
+				CategoryId: 1,
+				Amount:     99.0,
+			})
+
+			assert.ErrorIs(t, err, usecase.ErrProductNotFound)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type CreateProductVariantController struct {
+	usc *usecase.UscCreateProductVariant
+}
+
+func NewCreateProductVariantController(container *container.Container) *CreateProductVariantController {
+	return &CreateProductVariantController{
+		usc: usecase.NewUseCaseCreateProductVariant(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *CreateProductVariantController) Execute(ctx context.Context, command dto.CreateProductVariant) (dto.Product, error) {
+	return ctl.usc.Create(ctx, command)
+}
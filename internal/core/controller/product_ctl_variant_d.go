@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type DeleteProductVariantController struct {
+	usc *usecase.UscDeleteProductVariant
+}
+
+func NewDeleteProductVariantController(container *container.Container) *DeleteProductVariantController {
+	return &DeleteProductVariantController{
+		usc: usecase.NewUseCaseDeleteProductVariant(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *DeleteProductVariantController) Execute(ctx context.Context, command dto.DeleteProductVariant) error {
+	return ctl.usc.DeleteById(ctx, command)
+}
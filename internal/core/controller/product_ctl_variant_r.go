@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindProductVariantController struct {
+	usc *usecase.UscFindProductVariant
+}
+
+func NewFindProductVariantController(container *container.Container) *FindProductVariantController {
+	return &FindProductVariantController{
+		usc: usecase.NewUseCaseFindProductVariant(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			presenter.NewProductPresenter(),
+		),
+	}
+}
+
+func (ctl *FindProductVariantController) Execute(ctx context.Context, productId string) (dto.ProductVariantContent, error) {
+	return ctl.usc.Find(ctx, productId)
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindOneProductVariantController struct {
+	usc *usecase.UscFindProductVariant
+}
+
+func NewFindOneProductVariantController(container *container.Container) *FindOneProductVariantController {
+	return &FindOneProductVariantController{
+		usc: usecase.NewUseCaseFindProductVariant(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			presenter.NewProductPresenter(),
+		),
+	}
+}
+
+func (ctl *FindOneProductVariantController) Execute(ctx context.Context, productId string, variantId string) (dto.ProductVariant, error) {
+	return ctl.usc.FindById(ctx, productId, variantId)
+}
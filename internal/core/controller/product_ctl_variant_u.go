@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type UpdateProductVariantController struct {
+	usc *usecase.UscUpdateProductVariant
+}
+
+func NewUpdateProductVariantController(container *container.Container) *UpdateProductVariantController {
+	return &UpdateProductVariantController{
+		usc: usecase.NewUseCaseUpdateProductVariant(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewProductPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *UpdateProductVariantController) Execute(ctx context.Context, command dto.UpdateProductVariant) (dto.Product, error) {
+	return ctl.usc.UpdateById(ctx, command)
+}
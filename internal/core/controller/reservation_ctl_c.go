@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type CreateReservationController struct {
+	usc *usecase.UscCreateReservation
+}
+
+func NewCreateReservationController(container *container.Container) *CreateReservationController {
+	return &CreateReservationController{
+		usc: usecase.NewUseCaseCreateReservation(
+			gateway.NewProductGateway(container.ProductRepository, container.StockRepository, container.Clock),
+			gateway.NewCategoryGateway(container.CategoryRepository),
+			gateway.NewStockGateway(container.StockRepository, container.Clock),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			gateway.NewReservationGateway(container.ReservationRepository),
+			presenter.NewReservationPresenter(),
+			container.Config.ReservationTtl,
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *CreateReservationController) Execute(ctx context.Context, command dto.CreateReservation) (dto.Reservation, error) {
+	return ctl.usc.Create(ctx, command)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindOneReservationController struct {
+	usc *usecase.UscFindOneReservation
+}
+
+func NewFindOneReservationController(container *container.Container) *FindOneReservationController {
+	return &FindOneReservationController{
+		usc: usecase.NewUseCaseFindOneReservation(
+			gateway.NewReservationGateway(container.ReservationRepository),
+			presenter.NewReservationPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *FindOneReservationController) Execute(ctx context.Context, reservationId string) (dto.Reservation, error) {
+	return ctl.usc.FindOne(ctx, reservationId)
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type ReleaseReservationController struct {
+	usc *usecase.UscReleaseReservation
+}
+
+func NewReleaseReservationController(container *container.Container) *ReleaseReservationController {
+	return &ReleaseReservationController{
+		usc: usecase.NewUseCaseReleaseReservation(
+			gateway.NewReservationGateway(container.ReservationRepository),
+			gateway.NewStockGateway(container.StockRepository, container.Clock),
+			gateway.NewProductEventGateway(container.Transactor, container.OutboxRepository),
+			presenter.NewReservationPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *ReleaseReservationController) Execute(ctx context.Context, reservationId string) (dto.Reservation, error) {
+	return ctl.usc.Release(ctx, reservationId)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type CreateWebhookController struct {
+	usc *usecase.UscCreateWebhook
+}
+
+func NewCreateWebhookController(container *container.Container) *CreateWebhookController {
+	return &CreateWebhookController{
+		usc: usecase.NewUseCaseCreateWebhook(
+			gateway.NewWebhookGateway(container.WebhookRepository),
+			presenter.NewWebhookPresenter(),
+			container.Clock,
+		),
+	}
+}
+
+func (ctl *CreateWebhookController) Execute(ctx context.Context, webhook dto.CreateWebhook) (dto.Webhook, error) {
+	return ctl.usc.Create(ctx, webhook)
+}
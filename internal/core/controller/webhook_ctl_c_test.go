@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+)
+
+func TestCreateWebhookController_Execute_Success(t *testing.T) {
+	ctx := context.Background()
+
+	webhookRepo := dbrepository.NewWebhookMemoryRepository()
+	controller := NewCreateWebhookController(&container.Container{WebhookRepository: webhookRepo})
+
+	result, err := controller.Execute(ctx, dto.CreateWebhook{
+		Url:        "https://kiosk.example.com/hooks",
+		EventTypes: []string{"ProductCreated", "ProductDeleted", "ProductCreated"},
+		Secret:     "kiosk-secret-0001",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.ID)
+	assert.Equal(t, []string{"ProductCreated", "ProductDeleted"}, result.EventTypes)
+
+	saved, err := webhookRepo.FindById(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "kiosk-secret-0001", saved.Secret)
+}
+
+func TestDeleteWebhookController_Execute_NotFound(t *testing.T) {
+	controller := NewDeleteWebhookController(&container.Container{WebhookRepository: dbrepository.NewWebhookMemoryRepository()})
+
+	err := controller.Execute(context.Background(), "missing")
+	assert.ErrorIs(t, err, usecase.ErrWebhookNotFound)
+}
+
+func TestFindWebhookDeliveriesController_Execute_NotFound(t *testing.T) {
+	controller := NewFindWebhookDeliveriesController(&container.Container{WebhookRepository: dbrepository.NewWebhookMemoryRepository()})
+
+	_, err := controller.Execute(context.Background(), dto.WebhookDeliveryQuery{WebhookId: "missing", Size: 20})
+	assert.ErrorIs(t, err, usecase.ErrWebhookNotFound)
+}
@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type DeleteWebhookController struct {
+	usc *usecase.UscDeleteWebhook
+}
+
+func NewDeleteWebhookController(container *container.Container) *DeleteWebhookController {
+	return &DeleteWebhookController{
+		usc: usecase.NewUseCaseDeleteWebhook(
+			gateway.NewWebhookGateway(container.WebhookRepository),
+		),
+	}
+}
+
+func (ctl *DeleteWebhookController) Execute(ctx context.Context, webhookId string) error {
+	return ctl.usc.DeleteById(ctx, webhookId)
+}
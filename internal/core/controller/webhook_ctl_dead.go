@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+// FindDeadWebhookDeliveriesController lists the dead letters, the deliveries of every webhook
+// that exhausted their attempts.
+type FindDeadWebhookDeliveriesController struct {
+	usc *usecase.UscFindWebhookDeliveries
+}
+
+func NewFindDeadWebhookDeliveriesController(container *container.Container) *FindDeadWebhookDeliveriesController {
+	return &FindDeadWebhookDeliveriesController{
+		usc: usecase.NewUseCaseFindWebhookDeliveries(
+			gateway.NewWebhookGateway(container.WebhookRepository),
+			presenter.NewWebhookPresenter(),
+		),
+	}
+}
+
+func (ctl *FindDeadWebhookDeliveriesController) Execute(ctx context.Context, query dto.WebhookDeliveryQuery) (dto.WebhookDeliveryContent, error) {
+	return ctl.usc.FindDead(ctx, query)
+}
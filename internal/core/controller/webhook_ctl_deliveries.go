@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindWebhookDeliveriesController struct {
+	usc *usecase.UscFindWebhookDeliveries
+}
+
+func NewFindWebhookDeliveriesController(container *container.Container) *FindWebhookDeliveriesController {
+	return &FindWebhookDeliveriesController{
+		usc: usecase.NewUseCaseFindWebhookDeliveries(
+			gateway.NewWebhookGateway(container.WebhookRepository),
+			presenter.NewWebhookPresenter(),
+		),
+	}
+}
+
+func (ctl *FindWebhookDeliveriesController) Execute(ctx context.Context, query dto.WebhookDeliveryQuery) (dto.WebhookDeliveryContent, error) {
+	return ctl.usc.FindByWebhook(ctx, query)
+}
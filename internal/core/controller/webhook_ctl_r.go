@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+)
+
+type FindWebhookController struct {
+	usc *usecase.UscFindWebhook
+}
+
+func NewFindWebhookController(container *container.Container) *FindWebhookController {
+	return &FindWebhookController{
+		usc: usecase.NewUseCaseFindWebhook(
+			gateway.NewWebhookGateway(container.WebhookRepository),
+			presenter.NewWebhookPresenter(),
+		),
+	}
+}
+
+func (ctl *FindWebhookController) Execute(ctx context.Context) (dto.WebhookContent, error) {
+	return ctl.usc.FindAll(ctx)
+}
@@ -1,6 +1,10 @@
 package entity
 
+import "time"
+
 type Category struct {
-	ID   int
-	Name string
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
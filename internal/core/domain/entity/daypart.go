@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// MinutesPerDay bounds the times of a schedule, End may be MinutesPerDay to run until midnight.
+const MinutesPerDay = 24 * 60
+
+// Daypart is a named part of the week, as the breakfast, when the products assigned to it, or to
+// one of its categories, can be ordered.
+type Daypart struct {
+	ID          string
+	Name        string
+	Schedules   []DaypartSchedule
+	ProductIds  []string
+	CategoryIds []int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// DaypartSchedule opens the daypart on the Weekdays from Start until End, minutes after midnight
+// in the time zone of the menu. An End before Start runs past midnight into the next day.
+type DaypartSchedule struct {
+	Weekdays []time.Weekday
+	Start    int
+	End      int
+}
+
+// Open tells whether the daypart is open at the given instant, read in the given time zone.
+func (daypart Daypart) Open(at time.Time, location *time.Location) bool {
+	local := at.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	for _, schedule := range daypart.Schedules {
+		if schedule.open(local.Weekday(), minute) {
+			return true
+		}
+	}
+	return false
+}
+
+func (schedule DaypartSchedule) open(weekday time.Weekday, minute int) bool {
+	if schedule.Start < schedule.End {
+		return slices.Contains(schedule.Weekdays, weekday) && minute >= schedule.Start && minute < schedule.End
+	}
+
+	// overnight, the early hours belong to the day before
+	if slices.Contains(schedule.Weekdays, weekday) && minute >= schedule.Start {
+		return true
+	}
+	return slices.Contains(schedule.Weekdays, (weekday+6)%7) && minute < schedule.End
+}
+
+// Scheduled tells whether the product is on the menu at the given instant. The dayparts the product
+// is assigned to rule it, or the ones of its category when it has none, and a product without any
+// daypart is always on the menu.
+func (product Product) Scheduled(dayparts []Daypart, at time.Time, location *time.Location) bool {
+	own := []Daypart{}
+	inherited := []Daypart{}
+	for _, daypart := range dayparts {
+		if slices.Contains(daypart.ProductIds, product.ID) {
+			own = append(own, daypart)
+		} else if slices.Contains(daypart.CategoryIds, product.CategoryId) {
+			inherited = append(inherited, daypart)
+		}
+	}
+
+	ruling := own
+	if len(ruling) == 0 {
+		ruling = inherited
+	}
+	if len(ruling) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(ruling, func(daypart Daypart) bool {
+		return daypart.Open(at, location)
+	})
+}
@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+func TestDaypart_Open(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	breakfast := Daypart{Schedules: []DaypartSchedule{{Weekdays: weekdays, Start: 6 * 60, End: 11 * 60}}}
+	lateNight := Daypart{Schedules: []DaypartSchedule{{Weekdays: []time.Weekday{time.Friday, time.Saturday}, Start: 22 * 60, End: 2 * 60}}}
+	weekend := Daypart{Schedules: []DaypartSchedule{{Weekdays: []time.Weekday{time.Saturday, time.Sunday}, Start: 0, End: MinutesPerDay}}}
+
+	tests := []struct {
+		name    string
+		daypart Daypart
+		at      string
+		open    bool
+	}{
+		{"breakfast on a monday morning", breakfast, "2026-10-19T09:30:00-03:00", true},
+		{"breakfast opening", breakfast, "2026-10-19T06:00:00-03:00", true},
+		{"breakfast closing", breakfast, "2026-10-19T11:00:00-03:00", false},
+		{"breakfast read in the menu time zone", breakfast, "2026-10-19T13:59:00Z", true},
+		{"breakfast after closing in the menu time zone", breakfast, "2026-10-19T14:00:00Z", false},
+		{"breakfast on a saturday", breakfast, "2026-10-24T09:30:00-03:00", false},
+		{"late night friday", lateNight, "2026-10-23T23:00:00-03:00", true},
+		{"late night early saturday", lateNight, "2026-10-24T01:59:00-03:00", true},
+		{"late night early sunday", lateNight, "2026-10-25T01:00:00-03:00", true},
+		{"late night early monday", lateNight, "2026-10-26T01:00:00-03:00", false},
+		{"late night closing", lateNight, "2026-10-24T02:00:00-03:00", false},
+		{"whole saturday", weekend, "2026-10-24T23:59:00-03:00", true},
+		{"no schedule", Daypart{}, "2026-10-19T09:30:00-03:00", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			at, err := time.Parse(time.RFC3339, test.at)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.open, test.daypart.Open(at, saoPaulo))
+		})
+	}
+}
+
+func TestProduct_Scheduled(t *testing.T) {
+	dayparts := []Daypart{
+		{ID: "breakfast", ProductIds: []string{"coffee"}, CategoryIds: []int{1},
+			Schedules: []DaypartSchedule{{Weekdays: weekdays, Start: 6 * 60, End: 11 * 60}}},
+		{ID: "lunch", CategoryIds: []int{1},
+			Schedules: []DaypartSchedule{{Weekdays: weekdays, Start: 11 * 60, End: 15 * 60}}},
+		{ID: "all day", ProductIds: []string{"coffee"}, CategoryIds: []int{3},
+			Schedules: []DaypartSchedule{{Weekdays: weekdays, Start: 15 * 60, End: 18 * 60}}},
+	}
+	coffee := Product{ID: "coffee", CategoryId: 1}
+	burger := Product{ID: "burger", CategoryId: 1}
+	sundae := Product{ID: "sundae", CategoryId: 4}
+
+	at := func(clock string) time.Time {
+		parsed, err := time.Parse(time.RFC3339, "2026-10-19T"+clock+":00Z")
+		require.NoError(t, err)
+		return parsed
+	}
+
+	assert.True(t, coffee.Scheduled(dayparts, at("07:00"), time.UTC))
+	assert.False(t, coffee.Scheduled(dayparts, at("12:00"), time.UTC), "its own dayparts rule over the category ones")
+	assert.True(t, coffee.Scheduled(dayparts, at("16:00"), time.UTC))
+	assert.True(t, burger.Scheduled(dayparts, at("07:00"), time.UTC))
+	assert.True(t, burger.Scheduled(dayparts, at("12:00"), time.UTC))
+	assert.False(t, burger.Scheduled(dayparts, at("16:00"), time.UTC))
+	assert.True(t, sundae.Scheduled(dayparts, at("03:00"), time.UTC), "always on the menu without dayparts")
+}
+
+func TestProduct_UnavailableAt(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	restoreAt := now.Add(2 * time.Hour)
+	product := Product{Unavailability: &ProductUnavailability{Reason: "Sem pão", Since: now, RestoreAt: &restoreAt}}
+
+	assert.False(t, product.UnavailableAt(now.Add(-time.Minute)))
+	assert.True(t, product.UnavailableAt(now))
+	assert.True(t, product.UnavailableAt(now.Add(time.Hour)))
+	assert.False(t, product.UnavailableAt(restoreAt))
+	assert.False(t, Product{}.UnavailableAt(now))
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// ModifierGroup is a set of options customers pick from when ordering a product, as the extras
+// or the removals of a sandwich. Between MinSelections and MaxSelections options are picked,
+// a Required group has MinSelections of at least one.
+type ModifierGroup struct {
+	ID            string
+	Name          string
+	Required      bool
+	MinSelections int
+	MaxSelections int
+	Options       []ModifierOption
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ModifierOption adds Amount to the price of the product, zero for the removals.
+type ModifierOption struct {
+	ID     string
+	Name   string
+	Amount float64
+}
+
+func (group ModifierGroup) Option(id string) (ModifierOption, bool) {
+	for _, option := range group.Options {
+		if option.ID == id {
+			return option, true
+		}
+	}
+	return ModifierOption{}, false
+}
@@ -12,11 +12,31 @@ type Product struct {
 	Description string
 	CategoryId  int
 	Amount      float64
+	Version     int64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+	Images      []ProductImage
+	// VariantsEnabled products are sold as one of their Variants, so they always have at least one
+	VariantsEnabled bool
+	Variants        []ProductVariant
+	// ModifierGroups are copies of the groups attached to the product, kept up to date with them
+	ModifierGroups []ModifierGroup
+	// Components make the product a bundle, sold for its Amount instead of the sum of theirs
+	Components []BundleComponent
+	// Stock is nil when the quantity of the product is not tracked
+	Stock *ProductStock
+	// Unavailability is nil unless the product was marked unavailable by hand
+	Unavailability *ProductUnavailability
 }
 
-func NewProduct(name string, description string, categoryId int, amount float64) (*Product, error) {
+// ProductMatch is a product found by a text search along with its relevance.
+type ProductMatch struct {
+	Product Product
+	Score   float64
+}
+
+func NewProduct(name string, description string, categoryId int, amount float64, now time.Time) (*Product, error) {
 
 	return &Product{
 		ID:          ulid.NewUlid().String(),
@@ -24,7 +44,8 @@ func NewProduct(name string, description string, categoryId int, amount float64)
 		Description: description,
 		CategoryId:  categoryId,
 		Amount:      amount,
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
+		Version:     1,
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}, nil
 }
@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// ProductUnavailability marks a product the kitchen cannot make for now ("86'd"), whatever its stock.
+// It ends by itself at RestoreAt when one was given.
+type ProductUnavailability struct {
+	Reason    string
+	Since     time.Time
+	RestoreAt *time.Time
+	ChangedBy string
+}
+
+// Over tells whether the product is available again at the given instant.
+func (unavailability ProductUnavailability) Over(now time.Time) bool {
+	return unavailability.RestoreAt != nil && !now.Before(*unavailability.RestoreAt)
+}
+
+// Available tells whether the product can be sold: not marked unavailable and, when its stock is
+// tracked, with units left for sale.
+func (product Product) Available() bool {
+	return product.Unavailability == nil && (product.Stock == nil || product.Stock.Free() > 0)
+}
+
+// UnavailableAt tells whether the product is marked unavailable at the given instant, which may be
+// in the past or the future of the moment it was marked.
+func (product Product) UnavailableAt(at time.Time) bool {
+	unavailability := product.Unavailability
+	return unavailability != nil && !at.Before(unavailability.Since) && !unavailability.Over(at)
+}
+
+// ProductAvailabilityChange is the audit of one toggle of the availability of a product.
+type ProductAvailabilityChange struct {
+	ID        string
+	ProductId string
+	Available bool
+	Reason    string
+	RestoreAt *time.Time
+	ChangedBy string
+	ChangedAt time.Time
+}
@@ -0,0 +1,40 @@
+package entity
+
+import "math"
+
+// BundleComponent is a product sold as part of a bundle. Swappable components may be exchanged
+// for another product of their category when ordering.
+type BundleComponent struct {
+	ProductId string
+	Quantity  int
+	Swappable bool
+}
+
+// BundlePrice compares the price of a bundle with the price of its components bought one by one.
+type BundlePrice struct {
+	ComponentsAmount float64
+	Savings          float64
+}
+
+func (product Product) IsBundle() bool {
+	return len(product.Components) > 0
+}
+
+// BundlePrice sums the components found in the map, by id, at their base amount. It reports
+// false when a component is missing, the price would not be the one of the bundle then.
+func (product Product) BundlePrice(components map[string]Product) (BundlePrice, bool) {
+	total := 0.0
+
+	for _, component := range product.Components {
+		found, ok := components[component.ProductId]
+		if !ok {
+			return BundlePrice{}, false
+		}
+		total += found.Amount * float64(component.Quantity)
+	}
+
+	return BundlePrice{
+		ComponentsAmount: math.Round(total*100) / 100,
+		Savings:          math.Round((total-product.Amount)*100) / 100,
+	}, true
+}
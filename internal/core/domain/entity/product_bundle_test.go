@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct_BundlePrice(t *testing.T) {
+	bundle := Product{Amount: 25.5, Components: []BundleComponent{
+		{ProductId: "burger", Quantity: 1},
+		{ProductId: "soda", Quantity: 2, Swappable: true},
+	}}
+	components := map[string]Product{
+		"burger": {ID: "burger", Amount: 19.9},
+		"soda":   {ID: "soda", Amount: 5.7},
+	}
+
+	price, ok := bundle.BundlePrice(components)
+	assert.True(t, ok)
+	assert.Equal(t, BundlePrice{ComponentsAmount: 31.3, Savings: 5.8}, price)
+
+	delete(components, "soda")
+	_, ok = bundle.BundlePrice(components)
+	assert.False(t, ok)
+
+	assert.True(t, bundle.IsBundle())
+	assert.False(t, Product{}.IsBundle())
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+)
+
+type ProductEventType string
+
+const (
+	ProductCreated      ProductEventType = "ProductCreated"
+	ProductUpdated      ProductEventType = "ProductUpdated"
+	ProductPriceChanged ProductEventType = "ProductPriceChanged"
+	ProductDeleted      ProductEventType = "ProductDeleted"
+	ProductLowStock     ProductEventType = "ProductLowStock"
+)
+
+// ProductEvent tells other services about a change to the catalog. Product is the state
+// after the change, consumers use its Version to discard events delivered more than once.
+// PreviousAmount is only set on ProductPriceChanged.
+type ProductEvent struct {
+	ID             string
+	Type           ProductEventType
+	Product        Product
+	Category       Category
+	PreviousAmount *float64
+	OccurredAt     time.Time
+}
+
+func newProductEvent(eventType ProductEventType, product Product, category Category, now time.Time) ProductEvent {
+	return ProductEvent{
+		ID:         ulid.NewUlid().String(),
+		Type:       eventType,
+		Product:    product,
+		Category:   category,
+		OccurredAt: now,
+	}
+}
+
+func NewProductCreated(product Product, category Category, now time.Time) []ProductEvent {
+	return []ProductEvent{newProductEvent(ProductCreated, product, category, now)}
+}
+
+// NewProductUpdated also reports a ProductPriceChanged when the amount differs from the previous one.
+func NewProductUpdated(previous Product, product Product, category Category, now time.Time) []ProductEvent {
+	events := []ProductEvent{newProductEvent(ProductUpdated, product, category, now)}
+
+	if previous.Amount != product.Amount {
+		priceChanged := newProductEvent(ProductPriceChanged, product, category, now)
+		previousAmount := previous.Amount
+		priceChanged.PreviousAmount = &previousAmount
+		events = append(events, priceChanged)
+	}
+
+	return events
+}
+
+// NewProductDeleted only carries the category id, the product is gone from the catalog.
+func NewProductDeleted(product Product, now time.Time) []ProductEvent {
+	return []ProductEvent{newProductEvent(ProductDeleted, product, Category{ID: product.CategoryId}, now)}
+}
+
+// NewProductLowStock reports the stock of the product falling to its low stock threshold.
+func NewProductLowStock(product Product, category Category, now time.Time) []ProductEvent {
+	return []ProductEvent{newProductEvent(ProductLowStock, product, category, now)}
+}
+
+// NewProductRestored announces a product taken out of the trash as updated, consumers upsert it back.
+func NewProductRestored(product Product, category Category, now time.Time) []ProductEvent {
+	return []ProductEvent{newProductEvent(ProductUpdated, product, category, now)}
+}
+
+// OutboxEvent is a product event saved in the outbox and not published yet, Payload is its JSON representation.
+type OutboxEvent struct {
+	ID          string
+	Type        ProductEventType
+	AggregateId string
+	Payload     []byte
+	OccurredAt  time.Time
+	Attempts    int
+}
@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+// ProductImage is a picture of the product kept in the object storage under Key,
+// along with its thumbnails. Url is where the clients download it.
+type ProductImage struct {
+	ID          string
+	ContentType string
+	Width       int
+	Height      int
+	Size        int64
+	Key         string
+	Url         string
+	Thumbnails  []ProductThumbnail
+	CreatedAt   time.Time
+}
+
+// ProductThumbnail is the image scaled down to fit a square of Size pixels.
+type ProductThumbnail struct {
+	Size   int
+	Width  int
+	Height int
+	Key    string
+	Url    string
+}
+
+// Keys lists the storage keys of the image and of its thumbnails.
+func (image ProductImage) Keys() []string {
+	keys := []string{image.Key}
+	for _, thumbnail := range image.Thumbnails {
+		keys = append(keys, thumbnail.Key)
+	}
+	return keys
+}
+
+// ProductImagePolicy limits the images of a product and lists the thumbnail sizes made of each.
+// Thumbnails are never larger than the image, the sizes above its longest side are skipped.
+type ProductImagePolicy struct {
+	MaxBytes       int64
+	MaxImages      int
+	ThumbnailSizes []int
+}
+
+// FitImage scales width by height down to fit a square of size pixels, keeping the aspect ratio.
+func FitImage(width int, height int, size int) (int, int) {
+	if width >= height {
+		return size, max(1, height*size/width)
+	}
+	return max(1, width*size/height), size
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrVariantSelection is a variant missing from a product with variants enabled, unknown,
+	// or given to a product without variants.
+	ErrVariantSelection = errors.New("invalid variant selection")
+	// ErrModifierSelection is an option or a group the product does not offer, or an option picked twice.
+	ErrModifierSelection = errors.New("invalid modifier selection")
+	// ErrModifierCount is a group with fewer options than its MinSelections or more than its MaxSelections.
+	ErrModifierCount = errors.New("invalid number of modifiers")
+)
+
+// ModifierSelection is an option picked in a modifier group of the product.
+type ModifierSelection struct {
+	GroupId  string
+	OptionId string
+}
+
+// ProductPrice is the price of the product with the variant and the modifiers picked.
+type ProductPrice struct {
+	// Base is the amount of the variant, or of the product when it has no variants
+	Base      float64
+	Variant   *ProductVariant
+	Modifiers []PricedModifier
+	Total     float64
+}
+
+type PricedModifier struct {
+	Group  ModifierGroup
+	Option ModifierOption
+}
+
+// Price checks the choices of the customer against the product and sums them up,
+// rounding the total to cents.
+func (product Product) Price(variantId string, selections []ModifierSelection) (ProductPrice, error) {
+	price := ProductPrice{Base: product.Amount, Modifiers: []PricedModifier{}}
+
+	switch {
+	case product.VariantsEnabled:
+		for _, variant := range product.Variants {
+			if variant.ID == variantId {
+				price.Base = variant.Amount
+				price.Variant = &variant
+			}
+		}
+		if price.Variant == nil {
+			return ProductPrice{}, ErrVariantSelection
+		}
+	case variantId != "":
+		return ProductPrice{}, ErrVariantSelection
+	}
+
+	total := price.Base
+	counts := map[string]int{}
+	picked := map[ModifierSelection]bool{}
+
+	for _, selection := range selections {
+		group, ok := product.ModifierGroup(selection.GroupId)
+		if !ok || picked[selection] {
+			return ProductPrice{}, ErrModifierSelection
+		}
+		option, ok := group.Option(selection.OptionId)
+		if !ok {
+			return ProductPrice{}, ErrModifierSelection
+		}
+
+		picked[selection] = true
+		counts[group.ID]++
+		total += option.Amount
+		price.Modifiers = append(price.Modifiers, PricedModifier{Group: group, Option: option})
+	}
+
+	for _, group := range product.ModifierGroups {
+		if counts[group.ID] < group.MinSelections || counts[group.ID] > group.MaxSelections {
+			return ProductPrice{}, ErrModifierCount
+		}
+	}
+
+	price.Total = math.Round(total*100) / 100
+
+	return price, nil
+}
+
+func (product Product) ModifierGroup(id string) (ModifierGroup, bool) {
+	for _, group := range product.ModifierGroups {
+		if group.ID == id {
+			return group, true
+		}
+	}
+	return ModifierGroup{}, false
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_Price(t *testing.T) {
+	product := Product{
+		Amount:          20,
+		VariantsEnabled: true,
+		Variants:        []ProductVariant{{ID: "small", Amount: 18.9}, {ID: "large", Amount: 24.9}},
+		ModifierGroups: []ModifierGroup{
+			{ID: "sauce", Required: true, MinSelections: 1, MaxSelections: 1,
+				Options: []ModifierOption{{ID: "bbq", Amount: 0}, {ID: "mustard", Amount: 0.5}}},
+			{ID: "extras", MaxSelections: 2,
+				Options: []ModifierOption{{ID: "bacon", Amount: 4.1}, {ID: "cheese", Amount: 3.2}, {ID: "egg", Amount: 2}}},
+		},
+	}
+
+	price, err := product.Price("large", []ModifierSelection{
+		{GroupId: "sauce", OptionId: "mustard"},
+		{GroupId: "extras", OptionId: "bacon"},
+		{GroupId: "extras", OptionId: "cheese"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 24.9, price.Base)
+	assert.Equal(t, "large", price.Variant.ID)
+	assert.Len(t, price.Modifiers, 3)
+	assert.Equal(t, 32.7, price.Total)
+
+	tests := []struct {
+		name       string
+		variantId  string
+		selections []ModifierSelection
+		err        error
+	}{
+		{"variant missing", "", []ModifierSelection{{GroupId: "sauce", OptionId: "bbq"}}, ErrVariantSelection},
+		{"variant unknown", "medium", []ModifierSelection{{GroupId: "sauce", OptionId: "bbq"}}, ErrVariantSelection},
+		{"required group without a pick", "small", nil, ErrModifierCount},
+		{"too many picks", "small", []ModifierSelection{{GroupId: "sauce", OptionId: "bbq"},
+			{GroupId: "extras", OptionId: "bacon"}, {GroupId: "extras", OptionId: "cheese"}, {GroupId: "extras", OptionId: "egg"}}, ErrModifierCount},
+		{"option picked twice", "small", []ModifierSelection{{GroupId: "sauce", OptionId: "bbq"}, {GroupId: "sauce", OptionId: "bbq"}}, ErrModifierSelection},
+		{"option of another group", "small", []ModifierSelection{{GroupId: "sauce", OptionId: "bacon"}}, ErrModifierSelection},
+		{"group not attached", "small", []ModifierSelection{{GroupId: "drinks", OptionId: "cola"}}, ErrModifierSelection},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := product.Price(test.variantId, test.selections)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+
+	_, err = Product{Amount: 10}.Price("small", nil)
+	assert.ErrorIs(t, err, ErrVariantSelection)
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// ProductStock is the stock of a product whose quantity is tracked. Reserved units are still on
+// hand but promised to orders, only the rest can be sold.
+type ProductStock struct {
+	OnHand   int
+	Reserved int
+	// LowStockThreshold is the number of units left for sale at which the stock is low
+	LowStockThreshold int
+	UpdatedAt         time.Time
+}
+
+// Free is the number of units left for sale.
+func (stock ProductStock) Free() int {
+	return stock.OnHand - stock.Reserved
+}
+
+func (stock ProductStock) Low() bool {
+	return stock.Free() <= stock.LowStockThreshold
+}
@@ -0,0 +1,10 @@
+package entity
+
+// ProductVariant is one of the ways the product is sold, as the sizes of a drink,
+// with its own SKU and price. The label is what the customer picks.
+type ProductVariant struct {
+	ID     string
+	Sku    string
+	Label  string
+	Amount float64
+}
@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+type ReservationStatus string
+
+const (
+	ReservationPending   ReservationStatus = "PENDING"
+	ReservationConfirmed ReservationStatus = "CONFIRMED"
+	ReservationReleased  ReservationStatus = "RELEASED"
+	// ReservationExpired is a reservation released by the sweeper once its time to live was over.
+	ReservationExpired ReservationStatus = "EXPIRED"
+)
+
+// ReservationItem is the quantity of a product held by a reservation. Held is false for the products
+// whose stock is not tracked, there are no units to hold for them.
+type ReservationItem struct {
+	ProductId string
+	Quantity  int
+	Held      bool
+}
+
+// Reservation holds units of several products for a cart until it is confirmed, released or expires.
+type Reservation struct {
+	ID        string
+	Items     []ReservationItem
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewReservation(id string, items []ReservationItem, ttl time.Duration, now time.Time) Reservation {
+	return Reservation{
+		ID:        id,
+		Items:     items,
+		Status:    ReservationPending,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Expired tells whether the reservation is still pending past its expiry, the sweeper releases it soon.
+func (reservation Reservation) Expired(now time.Time) bool {
+	return reservation.Status == ReservationPending && !now.Before(reservation.ExpiresAt)
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Webhook is a partner subscription to the product events of the given types.
+// Secret signs every delivery, see SignWebhookPayload.
+type Webhook struct {
+	ID         string
+	Url        string
+	EventTypes []ProductEventType
+	Secret     string
+	CreatedAt  time.Time
+}
+
+func (webhook Webhook) Subscribes(eventType ProductEventType) bool {
+	for _, subscribed := range webhook.EventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	// WebhookDeliveryDead is a delivery that exhausted its attempts, kept for inspection.
+	WebhookDeliveryDead WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDelivery is one event sent to one webhook, Payload is the CloudEvent posted.
+type WebhookDelivery struct {
+	ID             string
+	WebhookId      string
+	EventId        string
+	EventType      ProductEventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	LastStatusCode int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// NewWebhookDelivery schedules the event to the webhook right away. The id derives from both,
+// so scheduling the same event twice yields the same delivery.
+func NewWebhookDelivery(webhook Webhook, event OutboxEvent, payload []byte, now time.Time) WebhookDelivery {
+	return WebhookDelivery{
+		ID:            fmt.Sprintf("%s.%s", webhook.ID, event.ID),
+		WebhookId:     webhook.ID,
+		EventId:       event.ID,
+		EventType:     event.Type,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+func (delivery *WebhookDelivery) Succeed(statusCode int, now time.Time) {
+	delivery.Attempts++
+	delivery.Status = WebhookDeliveryDelivered
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = ""
+	delivery.DeliveredAt = &now
+}
+
+// Fail schedules the next attempt following the policy, or gives up on the delivery
+// once it made the policy's maximum attempts.
+func (delivery *WebhookDelivery) Fail(statusCode int, reason string, now time.Time, policy WebhookRetryPolicy) {
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = reason
+
+	if delivery.Attempts >= policy.MaxAttempts {
+		delivery.Status = WebhookDeliveryDead
+		return
+	}
+
+	delivery.NextAttemptAt = now.Add(policy.Delay(delivery.Attempts))
+}
+
+// WebhookRetryPolicy spaces the attempts of a delivery with an exponential backoff.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Delay is the wait after the given failed attempt: BaseDelay doubled for each previous
+// failure, capped at MaxDelay.
+func (policy WebhookRetryPolicy) Delay(attempts int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < attempts; i++ {
+		if delay >= policy.MaxDelay {
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, policy.MaxDelay)
+}
+
+// SignWebhookPayload is the value of the signature header: the HMAC-SHA256 of
+// "<unix timestamp>.<payload>" keyed by the webhook secret, hex encoded and prefixed by "sha256=".
+// Signing the timestamp lets the receiver reject replayed deliveries.
+func SignWebhookPayload(secret string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp.Unix())
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var (
+	ErrCategoryNotFound = xerrors.NewNotFoundError("TL-CATEGORY-001", "Category not found")
+	ErrCategoryInUse    = xerrors.NewBusinessError("TL-CATEGORY-002", "Category has products")
+)
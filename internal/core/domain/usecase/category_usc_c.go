@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscCreateCategory struct {
+	categoryGateway   *gateway.CategoryGateway
+	categoryPresenter *presenter.CategoryPresenter
+	clock             clock.Clock
+}
+
+func NewUseCaseCreateCategory(categoryGateway *gateway.CategoryGateway,
+	categoryPresenter *presenter.CategoryPresenter,
+	clock clock.Clock) *UscCreateCategory {
+	return &UscCreateCategory{
+		categoryGateway:   categoryGateway,
+		categoryPresenter: categoryPresenter,
+		clock:             clock,
+	}
+}
+
+func (usc *UscCreateCategory) Create(ctx context.Context, categoryDto dto.CreateCategory) (dto.Category, error) {
+
+	category := entity.Category{
+		Name:      categoryDto.Name,
+		CreatedAt: usc.clock.Now(),
+		UpdatedAt: usc.clock.Now(),
+	}
+
+	err := usc.categoryGateway.Create(ctx, &category)
+	if err != nil {
+		return dto.Category{}, err
+	}
+
+	return usc.categoryPresenter.BuildCategoryResponse(category), nil
+}
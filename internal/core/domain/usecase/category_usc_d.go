@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscDeleteCategory struct {
+	categoryGateway *gateway.CategoryGateway
+	productGateway  *gateway.ProductGateway
+}
+
+func NewUseCaseDeleteCategory(categoryGateway *gateway.CategoryGateway,
+	productGateway *gateway.ProductGateway) *UscDeleteCategory {
+	return &UscDeleteCategory{
+		categoryGateway: categoryGateway,
+		productGateway:  productGateway,
+	}
+}
+
+// DeleteById refuses to remove a category that still has products, so no product is left orphaned.
+func (usc *UscDeleteCategory) DeleteById(ctx context.Context, categoryId int) error {
+
+	category, err := usc.categoryGateway.FindById(ctx, categoryId)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return ErrCategoryNotFound
+	}
+
+	products, err := usc.productGateway.Count(ctx, dto.ProductQuery{CategoryIds: []int{categoryId}})
+	if err != nil {
+		return err
+	}
+	if products > 0 {
+		return ErrCategoryInUse
+	}
+
+	return usc.categoryGateway.DeleteById(ctx, categoryId)
+}
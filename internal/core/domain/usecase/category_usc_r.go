@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindCategory struct {
+	categoryGateway   *gateway.CategoryGateway
+	categoryPresenter *presenter.CategoryPresenter
+}
+
+func NewUseCaseFindCategory(categoryGateway *gateway.CategoryGateway,
+	categoryPresenter *presenter.CategoryPresenter) *UscFindCategory {
+	return &UscFindCategory{
+		categoryGateway:   categoryGateway,
+		categoryPresenter: categoryPresenter,
+	}
+}
+
+func (usc *UscFindCategory) FindAll(ctx context.Context) (dto.CategoryContent, error) {
+
+	categories, err := usc.categoryGateway.FindAll(ctx)
+	if err != nil {
+		return dto.CategoryContent{}, err
+	}
+
+	return usc.categoryPresenter.BuildCategoryContentResponse(categories), nil
+}
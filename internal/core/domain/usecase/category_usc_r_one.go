@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindOneCategory struct {
+	categoryGateway   *gateway.CategoryGateway
+	categoryPresenter *presenter.CategoryPresenter
+}
+
+func NewUseCaseFindOneCategory(categoryGateway *gateway.CategoryGateway,
+	categoryPresenter *presenter.CategoryPresenter) *UscFindOneCategory {
+	return &UscFindOneCategory{
+		categoryGateway:   categoryGateway,
+		categoryPresenter: categoryPresenter,
+	}
+}
+
+func (usc *UscFindOneCategory) FindById(ctx context.Context, categoryId int) (dto.Category, error) {
+
+	category, err := usc.categoryGateway.FindById(ctx, categoryId)
+	if err != nil {
+		return dto.Category{}, err
+	}
+	if category == nil {
+		return dto.Category{}, ErrCategoryNotFound
+	}
+
+	return usc.categoryPresenter.BuildCategoryResponse(*category), nil
+}
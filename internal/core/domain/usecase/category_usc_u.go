@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscUpdateCategory struct {
+	categoryGateway   *gateway.CategoryGateway
+	categoryPresenter *presenter.CategoryPresenter
+	clock             clock.Clock
+}
+
+func NewUseCaseUpdateCategory(categoryGateway *gateway.CategoryGateway,
+	categoryPresenter *presenter.CategoryPresenter,
+	clock clock.Clock) *UscUpdateCategory {
+	return &UscUpdateCategory{
+		categoryGateway:   categoryGateway,
+		categoryPresenter: categoryPresenter,
+		clock:             clock,
+	}
+}
+
+func (usc *UscUpdateCategory) UpdateById(ctx context.Context, command dto.UpdateCategory) (dto.Category, error) {
+
+	category, err := usc.categoryGateway.FindById(ctx, command.CategoryId)
+	if err != nil {
+		return dto.Category{}, err
+	}
+	if category == nil {
+		return dto.Category{}, ErrCategoryNotFound
+	}
+
+	category.Name = command.Name
+	category.UpdatedAt = usc.clock.Now()
+
+	err = usc.categoryGateway.UpdateById(ctx, category)
+	if err != nil {
+		return dto.Category{}, err
+	}
+
+	return usc.categoryPresenter.BuildCategoryResponse(*category), nil
+}
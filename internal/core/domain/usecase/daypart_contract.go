@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var (
+	ErrDaypartNotFound          = xerrors.NewNotFoundError("TL-DAYPART-001", "Daypart not found")
+	ErrDaypartProductNotExists  = xerrors.NewBusinessError("TL-DAYPART-002", "Daypart product does not exist")
+	ErrDaypartCategoryNotExists = xerrors.NewBusinessError("TL-DAYPART-003", "Daypart category does not exist")
+)
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type UscCreateDaypart struct {
+	daypartGateway   *gateway.DaypartGateway
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	daypartPresenter *presenter.DaypartPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseCreateDaypart(daypartGateway *gateway.DaypartGateway,
+	productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	daypartPresenter *presenter.DaypartPresenter,
+	clock clock.Clock) *UscCreateDaypart {
+	return &UscCreateDaypart{
+		daypartGateway:   daypartGateway,
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		daypartPresenter: daypartPresenter,
+		clock:            clock,
+	}
+}
+
+func (usc *UscCreateDaypart) Create(ctx context.Context, document dto.DaypartDocument) (dto.Daypart, error) {
+
+	now := usc.clock.Now()
+	daypart := entity.Daypart{
+		ID:        ulid.NewUlid().String(),
+		CreatedAt: now,
+	}
+
+	err := applyDaypartDocument(&daypart, document, now)
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+
+	err = checkDaypartAssignments(ctx, usc.productGateway, usc.categoryGateway, daypart)
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+
+	err = usc.daypartGateway.Create(ctx, &daypart)
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+
+	return usc.daypartPresenter.BuildDaypartResponse(daypart), nil
+}
+
+// applyDaypartDocument replaces the daypart attributes with the document ones. A schedule must
+// not start and end at the same time, "24:00" is only accepted as the end.
+func applyDaypartDocument(daypart *entity.Daypart, document dto.DaypartDocument, now time.Time) error {
+	vErr := xerrors.NewValidationError("Invalid Body")
+
+	schedules := []entity.DaypartSchedule{}
+	for _, scheduleDocument := range document.Schedules {
+		schedule := entity.DaypartSchedule{Weekdays: []time.Weekday{}}
+
+		for _, name := range scheduleDocument.Weekdays {
+			weekday, ok := parseWeekday(name)
+			if !ok {
+				vErr = vErr.AddField("schedules.weekdays", xerrors.ReasonTypeInvalidValue)
+				continue
+			}
+			if !slices.Contains(schedule.Weekdays, weekday) {
+				schedule.Weekdays = append(schedule.Weekdays, weekday)
+			}
+		}
+
+		start, ok := parseMinuteOfDay(scheduleDocument.Start)
+		if !ok || start == entity.MinutesPerDay {
+			vErr = vErr.AddField("schedules.start", xerrors.ReasonTypeInvalidValue)
+		}
+		schedule.Start = start
+
+		end, ok := parseMinuteOfDay(scheduleDocument.End)
+		if !ok || end == start {
+			vErr = vErr.AddField("schedules.end", xerrors.ReasonTypeInvalidValue)
+		}
+		schedule.End = end
+
+		schedules = append(schedules, schedule)
+	}
+
+	if len(vErr.Fields) > 0 {
+		return vErr
+	}
+
+	daypart.Name = document.Name
+	daypart.Schedules = schedules
+	daypart.ProductIds = uniqueValues(document.ProductIds)
+	daypart.CategoryIds = uniqueValues(document.CategoryIds)
+	daypart.UpdatedAt = now
+
+	return nil
+}
+
+// checkDaypartAssignments makes sure the products and categories of the daypart exist.
+func checkDaypartAssignments(ctx context.Context, productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway, daypart entity.Daypart) error {
+
+	for _, productId := range daypart.ProductIds {
+		product, err := productGateway.FindOne(ctx, productId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrDaypartProductNotExists
+		}
+	}
+
+	for _, categoryId := range daypart.CategoryIds {
+		category, err := categoryGateway.FindById(ctx, categoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrDaypartCategoryNotExists
+		}
+	}
+
+	return nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), name) {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
+
+// parseMinuteOfDay reads a "HH:MM" time as minutes after midnight, "24:00" being the last one.
+func parseMinuteOfDay(value string) (int, bool) {
+	if value == "24:00" {
+		return entity.MinutesPerDay, true
+	}
+
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, false
+	}
+	return parsed.Hour()*60 + parsed.Minute(), true
+}
+
+func uniqueValues[T comparable](values []T) []T {
+	unique := []T{}
+	for _, value := range values {
+		if !slices.Contains(unique, value) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+type UscDeleteDaypart struct {
+	daypartGateway *gateway.DaypartGateway
+}
+
+func NewUseCaseDeleteDaypart(daypartGateway *gateway.DaypartGateway) *UscDeleteDaypart {
+	return &UscDeleteDaypart{
+		daypartGateway: daypartGateway,
+	}
+}
+
+// DeleteById removes the daypart, its products are on the menu by their other dayparts, or always
+// when they have none left.
+func (usc *UscDeleteDaypart) DeleteById(ctx context.Context, daypartId string) error {
+
+	deleted, err := usc.daypartGateway.DeleteById(ctx, daypartId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrDaypartNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindDaypart struct {
+	daypartGateway   *gateway.DaypartGateway
+	daypartPresenter *presenter.DaypartPresenter
+}
+
+func NewUseCaseFindDaypart(daypartGateway *gateway.DaypartGateway,
+	daypartPresenter *presenter.DaypartPresenter) *UscFindDaypart {
+	return &UscFindDaypart{
+		daypartGateway:   daypartGateway,
+		daypartPresenter: daypartPresenter,
+	}
+}
+
+func (usc *UscFindDaypart) Find(ctx context.Context) (dto.DaypartContent, error) {
+
+	dayparts, err := usc.daypartGateway.FindAll(ctx)
+	if err != nil {
+		return dto.DaypartContent{}, err
+	}
+
+	return usc.daypartPresenter.BuildDaypartContentResponse(dayparts), nil
+}
+
+func (usc *UscFindDaypart) FindById(ctx context.Context, daypartId string) (dto.Daypart, error) {
+
+	daypart, err := usc.daypartGateway.FindById(ctx, daypartId)
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+	if daypart == nil {
+		return dto.Daypart{}, ErrDaypartNotFound
+	}
+
+	return usc.daypartPresenter.BuildDaypartResponse(*daypart), nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscUpdateDaypart struct {
+	daypartGateway   *gateway.DaypartGateway
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	daypartPresenter *presenter.DaypartPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseUpdateDaypart(daypartGateway *gateway.DaypartGateway,
+	productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	daypartPresenter *presenter.DaypartPresenter,
+	clock clock.Clock) *UscUpdateDaypart {
+	return &UscUpdateDaypart{
+		daypartGateway:   daypartGateway,
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		daypartPresenter: daypartPresenter,
+		clock:            clock,
+	}
+}
+
+// UpdateById replaces the schedules and the assignments of the daypart.
+func (usc *UscUpdateDaypart) UpdateById(ctx context.Context, command dto.UpdateDaypart) (dto.Daypart, error) {
+
+	daypart, err := usc.daypartGateway.FindById(ctx, command.DaypartId)
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+	if daypart == nil {
+		return dto.Daypart{}, ErrDaypartNotFound
+	}
+
+	err = applyDaypartDocument(daypart, command.DaypartDocument, usc.clock.Now())
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+
+	err = checkDaypartAssignments(ctx, usc.productGateway, usc.categoryGateway, *daypart)
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+
+	updated, err := usc.daypartGateway.UpdateById(ctx, daypart)
+	if err != nil {
+		return dto.Daypart{}, err
+	}
+	if !updated {
+		return dto.Daypart{}, ErrDaypartNotFound
+	}
+
+	return usc.daypartPresenter.BuildDaypartResponse(*daypart), nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscFindMenu struct {
+	productGateway  *gateway.ProductGateway
+	categoryGateway *gateway.CategoryGateway
+	daypartGateway  *gateway.DaypartGateway
+	stockGateway    *gateway.StockGateway
+	menuPresenter   *presenter.MenuPresenter
+	location        *time.Location
+	clock           clock.Clock
+}
+
+// NewUseCaseFindMenu reads the dayparts in the given time zone, UTC when it is nil.
+func NewUseCaseFindMenu(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	daypartGateway *gateway.DaypartGateway,
+	stockGateway *gateway.StockGateway,
+	menuPresenter *presenter.MenuPresenter,
+	location *time.Location,
+	clock clock.Clock) *UscFindMenu {
+	if location == nil {
+		location = time.UTC
+	}
+	return &UscFindMenu{
+		productGateway:  productGateway,
+		categoryGateway: categoryGateway,
+		daypartGateway:  daypartGateway,
+		stockGateway:    stockGateway,
+		menuPresenter:   menuPresenter,
+		location:        location,
+		clock:           clock,
+	}
+}
+
+// Find lists the products that can be ordered at the instant asked: scheduled by their dayparts,
+// not marked unavailable then and not sold out. The stock is the current one whatever the instant.
+func (usc *UscFindMenu) Find(ctx context.Context, query dto.MenuQuery) (dto.Menu, error) {
+
+	at := usc.clock.Now()
+	if query.At != nil {
+		at = query.At.UTC()
+	}
+
+	dayparts, err := usc.daypartGateway.FindAll(ctx)
+	if err != nil {
+		return dto.Menu{}, err
+	}
+
+	soldOut, err := usc.stockGateway.FindSoldOut(ctx)
+	if err != nil {
+		return dto.Menu{}, err
+	}
+
+	products := []entity.Product{}
+	err = usc.productGateway.Stream(ctx, dto.ProductQuery{}, func(product entity.Product) error {
+		if product.Scheduled(dayparts, at, usc.location) && !product.UnavailableAt(at) && !soldOut[product.ID] {
+			products = append(products, product)
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.Menu{}, err
+	}
+
+	categories := map[int]entity.Category{}
+	err = loadCategories(ctx, usc.categoryGateway, products, categories)
+	if err != nil {
+		return dto.Menu{}, err
+	}
+
+	components := map[string]entity.Product{}
+	err = loadComponents(ctx, usc.productGateway, products, components)
+	if err != nil {
+		return dto.Menu{}, err
+	}
+
+	return usc.menuPresenter.BuildMenuResponse(at, usc.location, products, categories, components), nil
+}
@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var (
+	ErrModifierGroupNotFound = xerrors.NewNotFoundError("TL-MODIFIER-001", "Modifier group not found")
+	ErrModifierGroupInUse    = xerrors.NewBusinessError("TL-MODIFIER-002", "Modifier group is attached to products")
+)
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type UscCreateModifierGroup struct {
+	modifierGroupGateway   *gateway.ModifierGroupGateway
+	modifierGroupPresenter *presenter.ModifierGroupPresenter
+	clock                  clock.Clock
+}
+
+func NewUseCaseCreateModifierGroup(modifierGroupGateway *gateway.ModifierGroupGateway,
+	modifierGroupPresenter *presenter.ModifierGroupPresenter,
+	clock clock.Clock) *UscCreateModifierGroup {
+	return &UscCreateModifierGroup{
+		modifierGroupGateway:   modifierGroupGateway,
+		modifierGroupPresenter: modifierGroupPresenter,
+		clock:                  clock,
+	}
+}
+
+func (usc *UscCreateModifierGroup) Create(ctx context.Context, document dto.ModifierGroupDocument) (dto.ModifierGroup, error) {
+
+	now := usc.clock.Now()
+	group := entity.ModifierGroup{
+		ID:        ulid.NewUlid().String(),
+		CreatedAt: now,
+	}
+
+	err := applyModifierGroupDocument(&group, document, now)
+	if err != nil {
+		return dto.ModifierGroup{}, err
+	}
+
+	err = usc.modifierGroupGateway.Create(ctx, &group)
+	if err != nil {
+		return dto.ModifierGroup{}, err
+	}
+
+	return usc.modifierGroupPresenter.BuildModifierGroupResponse(group), nil
+}
+
+// applyModifierGroupDocument replaces the group attributes with the document ones. The selection
+// bounds must fit the options, and a required group asks for at least one option, an optional none.
+func applyModifierGroupDocument(group *entity.ModifierGroup, document dto.ModifierGroupDocument, now time.Time) error {
+	vErr := xerrors.NewValidationError("Invalid Body")
+
+	if document.Required != (document.MinSelections > 0) {
+		vErr = vErr.AddField("minSelections", xerrors.ReasonTypeInvalidValue)
+	}
+	if document.MaxSelections < document.MinSelections || document.MaxSelections > len(document.Options) {
+		vErr = vErr.AddField("maxSelections", xerrors.ReasonTypeInvalidValue)
+	}
+
+	options := []entity.ModifierOption{}
+	kept := map[string]bool{}
+	for _, option := range document.Options {
+		id := option.OptionId
+		if id == "" {
+			id = ulid.NewUlid().String()
+		} else if _, ok := group.Option(id); !ok || kept[id] {
+			vErr = vErr.AddField("options.id", xerrors.ReasonTypeInvalidValue)
+		}
+		kept[id] = true
+
+		options = append(options, entity.ModifierOption{ID: id, Name: option.Name, Amount: *option.Amount})
+	}
+
+	if len(vErr.Fields) > 0 {
+		return vErr
+	}
+
+	group.Name = document.Name
+	group.Required = document.Required
+	group.MinSelections = document.MinSelections
+	group.MaxSelections = document.MaxSelections
+	group.Options = options
+	group.UpdatedAt = now
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+type UscDeleteModifierGroup struct {
+	modifierGroupGateway *gateway.ModifierGroupGateway
+	productGateway       *gateway.ProductGateway
+}
+
+func NewUseCaseDeleteModifierGroup(modifierGroupGateway *gateway.ModifierGroupGateway,
+	productGateway *gateway.ProductGateway) *UscDeleteModifierGroup {
+	return &UscDeleteModifierGroup{
+		modifierGroupGateway: modifierGroupGateway,
+		productGateway:       productGateway,
+	}
+}
+
+// DeleteById refuses to remove a group still attached to products, detach it from them first.
+func (usc *UscDeleteModifierGroup) DeleteById(ctx context.Context, groupId string) error {
+
+	products, err := productsWithModifierGroup(ctx, usc.productGateway, groupId)
+	if err != nil {
+		return err
+	}
+	if len(products) > 0 {
+		return ErrModifierGroupInUse
+	}
+
+	deleted, err := usc.modifierGroupGateway.DeleteById(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrModifierGroupNotFound
+	}
+
+	return nil
+}
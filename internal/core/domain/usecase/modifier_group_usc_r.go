@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindModifierGroup struct {
+	modifierGroupGateway   *gateway.ModifierGroupGateway
+	modifierGroupPresenter *presenter.ModifierGroupPresenter
+}
+
+func NewUseCaseFindModifierGroup(modifierGroupGateway *gateway.ModifierGroupGateway,
+	modifierGroupPresenter *presenter.ModifierGroupPresenter) *UscFindModifierGroup {
+	return &UscFindModifierGroup{
+		modifierGroupGateway:   modifierGroupGateway,
+		modifierGroupPresenter: modifierGroupPresenter,
+	}
+}
+
+func (usc *UscFindModifierGroup) Find(ctx context.Context) (dto.ModifierGroupContent, error) {
+
+	groups, err := usc.modifierGroupGateway.FindAll(ctx)
+	if err != nil {
+		return dto.ModifierGroupContent{}, err
+	}
+
+	return usc.modifierGroupPresenter.BuildModifierGroupContentResponse(groups), nil
+}
+
+func (usc *UscFindModifierGroup) FindById(ctx context.Context, groupId string) (dto.ModifierGroup, error) {
+
+	group, err := usc.modifierGroupGateway.FindById(ctx, groupId)
+	if err != nil {
+		return dto.ModifierGroup{}, err
+	}
+	if group == nil {
+		return dto.ModifierGroup{}, ErrModifierGroupNotFound
+	}
+
+	return usc.modifierGroupPresenter.BuildModifierGroupResponse(*group), nil
+}
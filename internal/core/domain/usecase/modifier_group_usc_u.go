@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscUpdateModifierGroup struct {
+	modifierGroupGateway   *gateway.ModifierGroupGateway
+	productGateway         *gateway.ProductGateway
+	categoryGateway        *gateway.CategoryGateway
+	eventGateway           *gateway.ProductEventGateway
+	modifierGroupPresenter *presenter.ModifierGroupPresenter
+	clock                  clock.Clock
+}
+
+func NewUseCaseUpdateModifierGroup(modifierGroupGateway *gateway.ModifierGroupGateway,
+	productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	modifierGroupPresenter *presenter.ModifierGroupPresenter,
+	clock clock.Clock) *UscUpdateModifierGroup {
+	return &UscUpdateModifierGroup{
+		modifierGroupGateway:   modifierGroupGateway,
+		productGateway:         productGateway,
+		categoryGateway:        categoryGateway,
+		eventGateway:           eventGateway,
+		modifierGroupPresenter: modifierGroupPresenter,
+		clock:                  clock,
+	}
+}
+
+// UpdateById replaces the group and, in the same transaction, its copy in every product it is
+// attached to, each recording a product update.
+func (usc *UscUpdateModifierGroup) UpdateById(ctx context.Context, command dto.UpdateModifierGroup) (dto.ModifierGroup, error) {
+
+	var group *entity.ModifierGroup
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		group, err = usc.modifierGroupGateway.FindById(ctx, command.GroupId)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return ErrModifierGroupNotFound
+		}
+
+		err = applyModifierGroupDocument(group, command.ModifierGroupDocument, usc.clock.Now())
+		if err != nil {
+			return err
+		}
+
+		if _, err := usc.modifierGroupGateway.UpdateById(ctx, group); err != nil {
+			return err
+		}
+
+		products, err := productsWithModifierGroup(ctx, usc.productGateway, group.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, product := range products {
+			groups := slices.Clone(product.ModifierGroups)
+			for i := range groups {
+				if groups[i].ID == group.ID {
+					groups[i] = *group
+				}
+			}
+
+			previous, updated, err := usc.productGateway.ReplaceModifierGroups(ctx, product.ID, product.Version, groups)
+			if err != nil {
+				return productChanged(err)
+			}
+			if updated == nil {
+				continue
+			}
+
+			category, err := usc.categoryGateway.FindById(ctx, updated.CategoryId)
+			if err != nil {
+				return err
+			}
+			if category == nil {
+				return ErrCategoryNotExists
+			}
+
+			if err := usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now())); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dto.ModifierGroup{}, err
+	}
+
+	return usc.modifierGroupPresenter.BuildModifierGroupResponse(*group), nil
+}
+
+// productsWithModifierGroup reads the live products the group is attached to. The whole catalog is
+// read, there is no index on the copies of the groups.
+func productsWithModifierGroup(ctx context.Context, productGateway *gateway.ProductGateway, groupId string) ([]entity.Product, error) {
+	products := []entity.Product{}
+
+	err := productGateway.Stream(ctx, dto.ProductQuery{}, func(product entity.Product) error {
+		if _, ok := product.ModifierGroup(groupId); ok {
+			products = append(products, product)
+		}
+		return nil
+	})
+
+	return products, err
+}
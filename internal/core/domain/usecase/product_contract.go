@@ -1,18 +1,40 @@
 package usecase
 
 import (
+	"errors"
 	"time"
 
 	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/types/ulid"
 	"github.com/tbtec/tremligeiro/internal/types/xerrors"
 )
 
 var (
 	ErrCategoryNotExists = xerrors.NewBusinessError("TL-PRODUCT-001", "Category not exists")
-	ErrProductNotFound   = xerrors.NewBusinessError("TL-PRODUCT-002", "Product not found")
+	ErrProductNotFound   = xerrors.NewNotFoundError("TL-PRODUCT-002", "Product not found")
+	// ErrProductChanged is a product no longer at the version expected, see productChanged.
+	ErrProductChanged   = xerrors.NewConflictError("TL-PRODUCT-003", "Product was changed by another request")
+	ErrImageNotFound    = xerrors.NewNotFoundError("TL-PRODUCT-004", "Image not found")
+	ErrTooManyImages    = xerrors.NewBusinessError("TL-PRODUCT-005", "Product has too many images")
+	ErrVariantNotFound  = xerrors.NewNotFoundError("TL-PRODUCT-006", "Variant not found")
+	ErrVariantSkuExists = xerrors.NewBusinessError("TL-PRODUCT-007", "Variant SKU already exists in the product")
+	// ErrVariantRequired keeps the last variant, deleting every variant disables them instead.
+	ErrVariantRequired        = xerrors.NewBusinessError("TL-PRODUCT-008", "Product with variants enabled needs at least one variant")
+	ErrModifierGroupNotExists = xerrors.NewBusinessError("TL-PRODUCT-009", "Modifier group not exists")
+	ErrComponentNotExists     = xerrors.NewBusinessError("TL-PRODUCT-010", "Bundle component not exists")
+	// ErrNestedBundle keeps bundles one level deep, a bundle is neither a component nor made of bundles.
+	ErrNestedBundle = xerrors.NewBusinessError("TL-PRODUCT-011", "Bundle cannot contain another bundle")
 )
 
+// productChanged reports the version conflicts of the gateway writes as ErrProductChanged.
+func productChanged(err error) error {
+	if errors.Is(err, gateway.ErrVersionConflict) {
+		return ErrProductChanged
+	}
+	return err
+}
+
 type CmdCreateProduct struct {
 	Name        string
 	Description string
@@ -29,19 +51,19 @@ type CmdUpdateProduct struct {
 	CreatedAt   time.Time
 }
 
-func (command *CmdCreateProduct) ToNewEntity() entity.Product {
+func (command *CmdCreateProduct) ToNewEntity(now time.Time) entity.Product {
 	return entity.Product{
 		ID:          ulid.NewUlid().String(),
 		Name:        command.Name,
 		Description: command.Description,
 		CategoryId:  command.CategoryId,
 		Amount:      command.Amount,
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 }
 
-func (command *CmdUpdateProduct) ToUpdateEntity() entity.Product {
+func (command *CmdUpdateProduct) ToUpdateEntity(now time.Time) entity.Product {
 	return entity.Product{
 		ID:          command.ProductId,
 		Name:        command.Name,
@@ -49,7 +71,7 @@ func (command *CmdUpdateProduct) ToUpdateEntity() entity.Product {
 		CategoryId:  command.CategoryId,
 		Amount:      command.Amount,
 		CreatedAt:   command.CreatedAt,
-		UpdatedAt:   time.Now().UTC(),
+		UpdatedAt:   now,
 	}
 }
 
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type UscChangeProductAvailability struct {
+	productGateway      *gateway.ProductGateway
+	categoryGateway     *gateway.CategoryGateway
+	availabilityGateway *gateway.AvailabilityGateway
+	eventGateway        *gateway.ProductEventGateway
+	productPresenter    *presenter.ProductPresenter
+	clock               clock.Clock
+}
+
+func NewUseCaseChangeProductAvailability(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	availabilityGateway *gateway.AvailabilityGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscChangeProductAvailability {
+	return &UscChangeProductAvailability{
+		productGateway:      productGateway,
+		categoryGateway:     categoryGateway,
+		availabilityGateway: availabilityGateway,
+		eventGateway:        eventGateway,
+		productPresenter:    productPresenter,
+		clock:               clock,
+	}
+}
+
+// Change marks the product unavailable, whatever its stock, or available again, keeping who did
+// it in the audit log. An unavailable product needs a reason and comes back by itself at the
+// restore time when one is given.
+func (usc *UscChangeProductAvailability) Change(ctx context.Context, command dto.ChangeProductAvailability) (dto.Product, error) {
+
+	now := usc.clock.Now()
+	available := *command.Available
+	reason := strings.TrimSpace(command.Reason)
+
+	vErr := xerrors.NewValidationError("Invalid Body")
+	if !available && reason == "" {
+		vErr = vErr.AddField("reason", xerrors.ReasonTypeInvalidValue)
+	}
+	if command.RestoreAt != nil && (available || !command.RestoreAt.After(now)) {
+		vErr = vErr.AddField("restoreAt", xerrors.ReasonTypeInvalidValue)
+	}
+	if len(vErr.Fields) > 0 {
+		return dto.Product{}, vErr
+	}
+
+	var unavailability *entity.ProductUnavailability
+	if !available {
+		unavailability = &entity.ProductUnavailability{
+			Reason:    reason,
+			Since:     now,
+			RestoreAt: command.RestoreAt,
+			ChangedBy: command.ChangedBy,
+		}
+	}
+
+	var updated *entity.Product
+	var category *entity.Category
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		product, err := usc.productGateway.FindOne(ctx, command.ProductId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrProductNotFound
+		}
+		if command.Version != 0 && command.Version != product.Version {
+			return ErrProductChanged
+		}
+
+		var previous *entity.Product
+		previous, updated, err = usc.productGateway.SetAvailability(ctx, product.ID, product.Version, unavailability)
+		if err != nil {
+			return productChanged(err)
+		}
+		if updated == nil {
+			return ErrProductNotFound
+		}
+
+		err = usc.availabilityGateway.AddChange(ctx, entity.ProductAvailabilityChange{
+			ID:        ulid.NewUlid().String(),
+			ProductId: product.ID,
+			Available: available,
+			Reason:    reason,
+			RestoreAt: command.RestoreAt,
+			ChangedBy: command.ChangedBy,
+			ChangedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		category, err = usc.categoryGateway.FindById(ctx, updated.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildOneProductContentResponse(*updated, *category, nil), nil
+}
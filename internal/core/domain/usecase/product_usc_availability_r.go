@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+// availabilityHistorySize is how many of the last availability changes are listed.
+const availabilityHistorySize = 50
+
+type UscFindProductAvailability struct {
+	productGateway      *gateway.ProductGateway
+	availabilityGateway *gateway.AvailabilityGateway
+	productPresenter    *presenter.ProductPresenter
+}
+
+func NewUseCaseFindProductAvailability(productGateway *gateway.ProductGateway,
+	availabilityGateway *gateway.AvailabilityGateway,
+	productPresenter *presenter.ProductPresenter) *UscFindProductAvailability {
+	return &UscFindProductAvailability{
+		productGateway:      productGateway,
+		availabilityGateway: availabilityGateway,
+		productPresenter:    productPresenter,
+	}
+}
+
+func (usc *UscFindProductAvailability) Find(ctx context.Context, productId string) (dto.ProductAvailability, error) {
+
+	product, err := usc.productGateway.FindOne(ctx, productId)
+	if err != nil {
+		return dto.ProductAvailability{}, err
+	}
+	if product == nil {
+		return dto.ProductAvailability{}, ErrProductNotFound
+	}
+
+	changes, err := usc.availabilityGateway.FindChanges(ctx, product.ID, availabilityHistorySize)
+	if err != nil {
+		return dto.ProductAvailability{}, err
+	}
+
+	return usc.productPresenter.BuildProductAvailabilityResponse(*product, changes), nil
+}
@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+// maxBulkWrites bounds the products a bulk writes once the categories are expanded.
+const maxBulkWrites = 1000
+
+// errBulkRejected rolls an atomic bulk back when one of its writes fails.
+var errBulkRejected = errors.New("bulk rejected")
+
+type UscBulkProduct struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseBulkProduct(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscBulkProduct {
+	return &UscBulkProduct{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+// bulkItem is a product an operation writes, planned as the write for the gateway.
+type bulkItem struct {
+	result dto.BulkResult
+	write  dto.ProductWrite
+	err    error
+}
+
+// Bulk turns the operations into product writes, sent to the gateway at once with the rules
+// of PUT and DELETE. An atomic bulk writes nothing unless every write succeeds, so it is
+// refused when the transactions cannot roll back.
+func (usc *UscBulkProduct) Bulk(ctx context.Context, command dto.BulkProducts) (dto.BulkReport, error) {
+
+	if command.Atomic && !usc.eventGateway.RollsBack() {
+		return dto.BulkReport{}, xerrors.NewValidationError("Invalid Body").
+			AddField("atomic", xerrors.ReasonTypeInvalidValue)
+	}
+
+	categories, err := usc.categoryGateway.FindAll(ctx)
+	if err != nil {
+		return dto.BulkReport{}, err
+	}
+
+	catalog := map[int]entity.Category{}
+	for _, category := range categories {
+		catalog[category.ID] = category
+	}
+
+	items := []bulkItem{}
+	for i, operation := range command.Operations {
+		planned, err := usc.plan(ctx, i, operation, catalog)
+		if err != nil {
+			return dto.BulkReport{}, err
+		}
+		items = append(items, planned...)
+	}
+
+	if len(items) > maxBulkWrites {
+		return dto.BulkReport{}, xerrors.NewValidationError("Invalid Body").
+			AddField("operations", xerrors.ReasonTypeInvalidValue)
+	}
+
+	rejectDuplicates(items)
+
+	report := dto.BulkReport{Atomic: command.Atomic}
+
+	if !command.Atomic || firstFailedItem(items) < 0 {
+		err = usc.write(ctx, items, command.Atomic, catalog)
+		if err != nil && !errors.Is(err, errBulkRejected) {
+			return dto.BulkReport{}, err
+		}
+	}
+
+	if command.Atomic && firstFailedItem(items) >= 0 {
+		for i := range items {
+			if items[i].err == nil {
+				items[i].result.Status = dto.BulkItemSkipped
+				items[i].result.Product = nil
+			}
+		}
+	}
+
+	for _, item := range items {
+		if item.err != nil {
+			item.result.Status = dto.BulkItemFailed
+			item.result.Error = usc.productPresenter.BuildItemError(item.err)
+		}
+
+		switch item.result.Status {
+		case dto.BulkItemUpdated, dto.BulkItemDeleted:
+			report.Succeeded++
+		case dto.BulkItemFailed:
+			report.Failed++
+		}
+		report.Results = append(report.Results, item.result)
+	}
+
+	report.Applied = report.Succeeded > 0
+
+	return report, nil
+}
+
+// plan expands the operation into the writes of its products. The operation errors are
+// reported as a single failed item.
+func (usc *UscBulkProduct) plan(ctx context.Context, position int, operation dto.BulkOperation,
+	catalog map[int]entity.Category) ([]bulkItem, error) {
+
+	item := bulkItem{result: dto.BulkResult{Operation: position, Type: operation.Type, ProductId: operation.ProductId}}
+
+	if err := checkBulkOperation(operation); err != nil {
+		item.err = err
+		return []bulkItem{item}, nil
+	}
+
+	if operation.CategoryId != nil {
+		if _, ok := catalog[*operation.CategoryId]; !ok {
+			item.err = ErrCategoryNotExists
+			return []bulkItem{item}, nil
+		}
+	}
+
+	if operation.Type == dto.BulkAdjustPrice {
+		products, err := usc.productGateway.Find(ctx, dto.ProductQuery{CategoryIds: []int{*operation.CategoryId}})
+		if err != nil {
+			return nil, err
+		}
+
+		items := []bulkItem{}
+		for _, product := range products {
+			adjusted := item
+			adjusted.result.ProductId = product.ID
+			adjusted.write = productWrite(product, product.Version)
+			adjusted.write.Amount = adjustAmount(product.Amount, operation)
+			if adjusted.write.Amount < 0 {
+				adjusted.err = xerrors.NewValidationError("Invalid Body").AddField("amount", xerrors.ReasonTypeInvalidValue)
+			}
+			items = append(items, adjusted)
+		}
+		return items, nil
+	}
+
+	product, err := usc.productGateway.FindOne(ctx, operation.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if product == nil {
+		item.err = ErrProductNotFound
+		return []bulkItem{item}, nil
+	}
+	if operation.Version != 0 && operation.Version != product.Version {
+		item.err = ErrProductChanged
+		return []bulkItem{item}, nil
+	}
+
+	item.write = productWrite(*product, operation.Version)
+	if operation.Type == dto.BulkDelete {
+		item.write.Delete = true
+		return []bulkItem{item}, nil
+	}
+
+	if operation.Name != nil {
+		item.write.Name = *operation.Name
+	}
+	if operation.Description != nil {
+		item.write.Description = *operation.Description
+	}
+	if operation.CategoryId != nil {
+		item.write.CategoryId = *operation.CategoryId
+	}
+	if operation.Amount != nil {
+		item.write.Amount = *operation.Amount
+	}
+
+	return []bulkItem{item}, nil
+}
+
+// write sends the planned writes to the gateway and records their events in one transaction.
+// An atomic bulk returns errBulkRejected, rolling back, when a write fails.
+func (usc *UscBulkProduct) write(ctx context.Context, items []bulkItem, atomic bool, catalog map[int]entity.Category) error {
+
+	planned := []int{}
+	writes := []dto.ProductWrite{}
+	for i, item := range items {
+		if item.err == nil {
+			planned = append(planned, i)
+			writes = append(writes, item.write)
+		}
+	}
+
+	if len(writes) == 0 {
+		return nil
+	}
+
+	return usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		results, err := usc.productGateway.BulkWrite(ctx, writes)
+		if err != nil {
+			return err
+		}
+
+		events := []entity.ProductEvent{}
+		for j, i := range planned {
+			item := &items[i]
+			item.err = productChanged(results[j].Err)
+			if item.err == nil && results[j].Product == nil {
+				item.err = ErrProductNotFound
+			}
+			if item.err != nil {
+				continue
+			}
+
+			previous, product := *results[j].Previous, *results[j].Product
+			category := catalog[product.CategoryId]
+			presented := usc.productPresenter.BuildProductCreateResponse(product, category)
+			item.result.Product = &presented
+
+			if item.write.Delete {
+				item.result.Status = dto.BulkItemDeleted
+				events = append(events, entity.NewProductDeleted(product, usc.clock.Now())...)
+				continue
+			}
+
+			item.result.Status = dto.BulkItemUpdated
+			events = append(events, entity.NewProductUpdated(previous, product, category, usc.clock.Now())...)
+		}
+
+		if atomic && firstFailedItem(items) >= 0 {
+			return errBulkRejected
+		}
+
+		return usc.eventGateway.Record(ctx, events)
+	})
+}
+
+// checkBulkOperation rejects the attributes the operation type does not read, and the updates
+// and adjustments that would change nothing.
+func checkBulkOperation(operation dto.BulkOperation) error {
+	vErr := xerrors.NewValidationError("Invalid Body")
+
+	adjustment := operation.Percentage != nil || operation.Delta != nil
+	fields := operation.Name != nil || operation.Description != nil || operation.Amount != nil
+
+	switch operation.Type {
+	case dto.BulkUpdate:
+		if adjustment {
+			vErr = vErr.AddField("percentage", xerrors.ReasonTypeInvalidValue)
+		}
+		if !fields && operation.CategoryId == nil {
+			vErr = vErr.AddField("attributes", xerrors.ReasonRequiredAttributeMissing)
+		}
+	case dto.BulkAdjustPrice:
+		if fields || operation.ProductId != "" {
+			vErr = vErr.AddField("attributes", xerrors.ReasonTypeInvalidValue)
+		}
+		if (operation.Percentage == nil) == (operation.Delta == nil) {
+			vErr = vErr.AddField("percentage", xerrors.ReasonTypeInvalidValue)
+		}
+	default:
+		if adjustment || fields || operation.CategoryId != nil {
+			vErr = vErr.AddField("attributes", xerrors.ReasonTypeInvalidValue)
+		}
+	}
+
+	if len(vErr.Fields) > 0 {
+		return vErr
+	}
+
+	return nil
+}
+
+// rejectDuplicates fails the writes of a product written by an earlier item, the second
+// would only meet a version conflict.
+func rejectDuplicates(items []bulkItem) {
+	seen := map[string]bool{}
+	for i := range items {
+		if items[i].err != nil {
+			continue
+		}
+		if seen[items[i].write.ProductId] {
+			items[i].err = xerrors.NewValidationError("Invalid Body").AddField("id", xerrors.ReasonTypeInvalidValue)
+			continue
+		}
+		seen[items[i].write.ProductId] = true
+	}
+}
+
+// firstFailedItem returns the position of the first failed item, -1 when there is none.
+func firstFailedItem(items []bulkItem) int {
+	for i, item := range items {
+		if item.err != nil {
+			return i
+		}
+	}
+	return -1
+}
+
+func productWrite(product entity.Product, version int64) dto.ProductWrite {
+	return dto.ProductWrite{UpdateProduct: dto.UpdateProduct{
+		ProductId:   product.ID,
+		Name:        product.Name,
+		Description: product.Description,
+		CategoryId:  product.CategoryId,
+		Amount:      product.Amount,
+		Version:     version,
+	}}
+}
+
+// adjustAmount applies the percentage or the delta, rounding to cents.
+func adjustAmount(amount float64, operation dto.BulkOperation) float64 {
+	if operation.Percentage != nil {
+		amount = amount * (1 + *operation.Percentage/100)
+	} else {
+		amount = amount + *operation.Delta
+	}
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type UscReplaceBundleComponents struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseReplaceBundleComponents(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscReplaceBundleComponents {
+	return &UscReplaceBundleComponents{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+// Replace makes the product a bundle of the components given, or a single product again when
+// there are none. A product that is a component of some bundle cannot become a bundle itself.
+func (usc *UscReplaceBundleComponents) Replace(ctx context.Context, command dto.ReplaceBundleComponents) (dto.Product, error) {
+
+	var updated *entity.Product
+	var category *entity.Category
+	var found map[string]entity.Product
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		product, err := usc.productGateway.FindOne(ctx, command.ProductId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrProductNotFound
+		}
+		if command.Version != 0 && command.Version != product.Version {
+			return ErrProductChanged
+		}
+
+		var components []entity.BundleComponent
+		components, found, err = newBundleComponents(ctx, usc.productGateway, product.ID, command.Components)
+		if err != nil {
+			return err
+		}
+
+		if len(components) > 0 && !product.IsBundle() {
+			bundles, err := bundlesWithComponent(ctx, usc.productGateway, product.ID)
+			if err != nil {
+				return err
+			}
+			if len(bundles) > 0 {
+				return ErrNestedBundle
+			}
+		}
+
+		var previous *entity.Product
+		previous, updated, err = usc.productGateway.ReplaceComponents(ctx, product.ID, product.Version, components)
+		if err != nil {
+			return productChanged(err)
+		}
+		if updated == nil {
+			return ErrProductNotFound
+		}
+
+		category, err = usc.categoryGateway.FindById(ctx, updated.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildOneProductContentResponse(*updated, *category, found), nil
+}
+
+// newBundleComponents checks that every component is an existing single product, other than the
+// bundle itself and listed once, returning the components along with the products found by id.
+func newBundleComponents(ctx context.Context, productGateway *gateway.ProductGateway, bundleId string,
+	documents []dto.BundleComponentDocument) ([]entity.BundleComponent, map[string]entity.Product, error) {
+
+	components := []entity.BundleComponent{}
+	found := map[string]entity.Product{}
+
+	for _, document := range documents {
+		if _, ok := found[document.ProductId]; ok || document.ProductId == bundleId {
+			return nil, nil, xerrors.NewValidationError("Invalid Body").
+				AddField("components.productId", xerrors.ReasonTypeInvalidValue)
+		}
+
+		product, err := productGateway.FindOne(ctx, document.ProductId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if product == nil {
+			return nil, nil, ErrComponentNotExists
+		}
+		if product.IsBundle() {
+			return nil, nil, ErrNestedBundle
+		}
+		found[product.ID] = *product
+
+		components = append(components, entity.BundleComponent{
+			ProductId: document.ProductId,
+			Quantity:  document.Quantity,
+			Swappable: document.Swappable,
+		})
+	}
+
+	return components, found, nil
+}
+
+// loadComponents adds the components of the bundles to the map, by id, the components no longer
+// found are left out.
+func loadComponents(ctx context.Context, productGateway *gateway.ProductGateway,
+	products []entity.Product, components map[string]entity.Product) error {
+
+	for _, product := range products {
+		for _, component := range product.Components {
+			if _, ok := components[component.ProductId]; ok {
+				continue
+			}
+
+			found, err := productGateway.FindOne(ctx, component.ProductId)
+			if err != nil {
+				return err
+			}
+			if found != nil {
+				components[found.ID] = *found
+			}
+		}
+	}
+
+	return nil
+}
+
+// bundlesWithComponent reads the live bundles the product is a component of, going through the
+// whole catalog like productsWithModifierGroup.
+func bundlesWithComponent(ctx context.Context, productGateway *gateway.ProductGateway, productId string) ([]entity.Product, error) {
+	bundles := []entity.Product{}
+
+	err := productGateway.Stream(ctx, dto.ProductQuery{}, func(product entity.Product) error {
+		for _, component := range product.Components {
+			if component.ProductId == productId {
+				bundles = append(bundles, product)
+				break
+			}
+		}
+		return nil
+	})
+
+	return bundles, err
+}
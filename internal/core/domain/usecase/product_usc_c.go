@@ -2,53 +2,108 @@ package usecase
 
 import (
 	"context"
-	"time"
 
 	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/core/presenter"
 	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 	"github.com/tbtec/tremligeiro/internal/types/ulid"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
 )
 
 type UscCreateProduct struct {
 	productGateway   *gateway.ProductGateway
 	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
 	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
 }
 
 func NewUseCaseCreateProduct(productGateway *gateway.ProductGateway,
 	categoryGateway *gateway.CategoryGateway,
-	productPresenter *presenter.ProductPresenter) *UscCreateProduct {
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscCreateProduct {
 	return &UscCreateProduct{
 		productGateway:   productGateway,
 		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
 		productPresenter: productPresenter,
+		clock:            clock,
 	}
 }
 
 func (usc *UscCreateProduct) Create(ctx context.Context, productDto dto.CreateProduct) (dto.Product, error) {
 
-	category := usc.categoryGateway.FindById(productDto.CategoryId)
+	variants, err := newProductVariants(productDto)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	category, err := usc.categoryGateway.FindById(ctx, productDto.CategoryId)
+	if err != nil {
+		return dto.Product{}, err
+	}
 	if category == nil {
 		return dto.Product{}, ErrCategoryNotExists
 	}
 
+	id := ulid.NewUlid().String()
+
+	components, found, err := newBundleComponents(ctx, usc.productGateway, id, productDto.Components)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
 	//p:= entity.NewProduct(DTO)
 	product := entity.Product{
-		ID:          ulid.NewUlid().String(),
-		Name:        productDto.Name,
-		Description: productDto.Description,
-		CategoryId:  productDto.CategoryId,
-		Amount:      productDto.Amount,
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
+		ID:              id,
+		Name:            productDto.Name,
+		Description:     productDto.Description,
+		CategoryId:      productDto.CategoryId,
+		Amount:          productDto.Amount,
+		VariantsEnabled: productDto.VariantsEnabled,
+		Variants:        variants,
+		Components:      components,
+		Version:         1,
+		CreatedAt:       usc.clock.Now(),
+		UpdatedAt:       usc.clock.Now(),
 	}
 
-	err := usc.productGateway.Create(ctx, &product)
+	err = usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		if err := usc.productGateway.Create(ctx, &product); err != nil {
+			return err
+		}
+		return usc.eventGateway.Record(ctx, entity.NewProductCreated(product, *category, usc.clock.Now()))
+	})
 	if err != nil {
 		return dto.Product{}, err
 	}
 
-	return usc.productPresenter.BuildProductCreateResponse(product, *category), nil
+	return usc.productPresenter.BuildOneProductContentResponse(product, *category, found), nil
+}
+
+// newProductVariants requires the variants when they are enabled, and only then.
+func newProductVariants(productDto dto.CreateProduct) ([]entity.ProductVariant, error) {
+	if productDto.VariantsEnabled && len(productDto.Variants) == 0 {
+		return nil, xerrors.NewValidationError("Invalid Body").
+			AddField("variants", xerrors.ReasonRequiredAttributeMissing)
+	}
+	if !productDto.VariantsEnabled && len(productDto.Variants) > 0 {
+		return nil, xerrors.NewValidationError("Invalid Body").
+			AddField("variantsEnabled", xerrors.ReasonTypeInvalidValue)
+	}
+
+	variants := []entity.ProductVariant{}
+	for _, document := range productDto.Variants {
+		variants = append(variants, entity.ProductVariant{
+			ID:     ulid.NewUlid().String(),
+			Sku:    document.Sku,
+			Label:  document.Label,
+			Amount: *document.Amount,
+		})
+	}
+
+	return variants, checkVariantSkus(variants)
 }
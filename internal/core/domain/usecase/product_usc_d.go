@@ -3,26 +3,48 @@ package usecase
 import (
 	"context"
 
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 )
 
 type UscDeleteProduct struct {
 	productGateway   *gateway.ProductGateway
+	eventGateway     *gateway.ProductEventGateway
 	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
 }
 
 func NewUseCaseDeleteProduct(productGateway *gateway.ProductGateway,
-	productPresenter *presenter.ProductPresenter) *UscDeleteProduct {
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscDeleteProduct {
 	return &UscDeleteProduct{
 		productGateway:   productGateway,
+		eventGateway:     eventGateway,
 		productPresenter: productPresenter,
+		clock:            clock,
 	}
 }
 
-func (usc *UscDeleteProduct) DeleteById(ctx context.Context, id string) (string, error) {
+func (usc *UscDeleteProduct) DeleteById(ctx context.Context, command dto.DeleteProduct) (string, error) {
 
-	_, err := usc.productGateway.DeleteById(ctx, id)
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		deleted, err := usc.productGateway.DeleteById(ctx, command)
+		if err != nil {
+			return productChanged(err)
+		}
+		if deleted == nil {
+			return ErrProductNotFound
+		}
 
-	return id, err
+		return usc.eventGateway.Record(ctx, entity.NewProductDeleted(*deleted, usc.clock.Now()))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return command.ProductId, nil
 }
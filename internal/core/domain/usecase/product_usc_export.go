@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscExportProduct struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	productPresenter *presenter.ProductPresenter
+}
+
+func NewUseCaseExportProduct(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	productPresenter *presenter.ProductPresenter) *UscExportProduct {
+	return &UscExportProduct{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		productPresenter: productPresenter,
+	}
+}
+
+// Export loads the categories and checks the products can be read, so these errors come before
+// the response starts. The function it returns writes the products to w as they are read, so the
+// catalog size does not matter. A JSON export is a single array, an NDJSON one a product per line.
+func (usc *UscExportProduct) Export(ctx context.Context, command dto.ExportProducts) (func(w io.Writer) error, error) {
+
+	categories, err := usc.categoryGateway.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := map[int]entity.Category{}
+	for _, category := range categories {
+		catalog[category.ID] = category
+	}
+
+	query := dto.ProductQuery{CategoryIds: command.CategoryIds}
+
+	_, err = usc.productGateway.Count(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w io.Writer) error {
+		encode := usc.encoder(command.Format, w)
+
+		err := usc.productGateway.Stream(ctx, query, func(product entity.Product) error {
+			category, ok := catalog[product.CategoryId]
+			if !ok {
+				category = entity.Category{ID: product.CategoryId}
+			}
+			exported := usc.productPresenter.BuildProductCreateResponse(product, category)
+			return encode(&exported)
+		})
+		if err != nil {
+			return err
+		}
+
+		return encode(nil)
+	}, nil
+}
+
+// encoder returns the function writing each product in the format, called with nil once the
+// products are over.
+func (usc *UscExportProduct) encoder(format string, w io.Writer) func(product *dto.Product) error {
+	switch format {
+	case dto.ExportFormatCSV:
+		writer := csv.NewWriter(w)
+		header := true
+
+		return func(product *dto.Product) error {
+			if header {
+				header = false
+				if err := writer.Write(dto.ProductExportColumns); err != nil {
+					return err
+				}
+			}
+			if product != nil {
+				if err := writer.Write(usc.productPresenter.BuildProductExportRecord(*product)); err != nil {
+					return err
+				}
+			}
+			writer.Flush()
+			return writer.Error()
+		}
+
+	case dto.ExportFormatNDJSON:
+		encoder := json.NewEncoder(w)
+
+		return func(product *dto.Product) error {
+			if product == nil {
+				return nil
+			}
+			return encoder.Encode(product)
+		}
+
+	default:
+		separator := "["
+
+		return func(product *dto.Product) error {
+			if product == nil {
+				if separator == "[" {
+					_, err := io.WriteString(w, "[]")
+					return err
+				}
+				_, err := io.WriteString(w, "]")
+				return err
+			}
+
+			content, err := json.Marshal(product)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, separator); err != nil {
+				return err
+			}
+			separator = ","
+			_, err = w.Write(content)
+			return err
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/imaging"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+type UscUploadProductImage struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
+	productPresenter *presenter.ProductPresenter
+	imageStorage     gateway.IImageStorage
+	policy           entity.ProductImagePolicy
+	clock            clock.Clock
+}
+
+func NewUseCaseUploadProductImage(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	imageStorage gateway.IImageStorage,
+	policy entity.ProductImagePolicy,
+	clock clock.Clock) *UscUploadProductImage {
+	return &UscUploadProductImage{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
+		productPresenter: productPresenter,
+		imageStorage:     imageStorage,
+		policy:           policy,
+		clock:            clock,
+	}
+}
+
+// Upload keeps the image as it was sent along with its thumbnails, then adds it to the product.
+// The objects are stored first, so they are deleted again when the product could not be saved.
+func (usc *UscUploadProductImage) Upload(ctx context.Context, command dto.UploadProductImage) (dto.Product, error) {
+
+	img, contentType, err := usc.check(command)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	product, err := usc.productGateway.FindOne(ctx, command.ProductId)
+	if err != nil {
+		return dto.Product{}, err
+	}
+	if product == nil {
+		return dto.Product{}, ErrProductNotFound
+	}
+	if usc.policy.MaxImages > 0 && len(product.Images) >= usc.policy.MaxImages {
+		return dto.Product{}, ErrTooManyImages
+	}
+
+	image, err := usc.store(ctx, product.ID, img, contentType, command.Content)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	var updated *entity.Product
+	var category *entity.Category
+
+	err = usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		images := append(append([]entity.ProductImage{}, product.Images...), image)
+
+		var previous *entity.Product
+		previous, updated, err = usc.productGateway.ReplaceImages(ctx, product.ID, product.Version, images)
+		if err != nil {
+			return productChanged(err)
+		}
+		if updated == nil {
+			return ErrProductNotFound
+		}
+
+		category, err = usc.categoryGateway.FindById(ctx, updated.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		deleteImageObjects(ctx, usc.imageStorage, image)
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildProductCreateResponse(*updated, *category), nil
+}
+
+// check decodes the image, which must be of the declared type and within the size limit.
+func (usc *UscUploadProductImage) check(command dto.UploadProductImage) (imaging.Image, string, error) {
+	if usc.policy.MaxBytes > 0 && int64(len(command.Content)) > usc.policy.MaxBytes {
+		return nil, "", xerrors.NewValidationError("Invalid Body").
+			AddField("size", xerrors.ReasonTypeInvalidValue)
+	}
+
+	if _, ok := imageExtensions[command.ContentType]; !ok {
+		return nil, "", xerrors.NewValidationError("Invalid Body").
+			AddField("contentType", xerrors.ReasonTypeInvalidValue)
+	}
+
+	img, contentType, err := imaging.Decode(command.Content)
+	if err != nil {
+		return nil, "", xerrors.NewValidationError("Invalid Body").
+			AddField("content", xerrors.ReasonTypeInvalidValue)
+	}
+
+	if contentType != command.ContentType {
+		return nil, "", xerrors.NewValidationError("Invalid Body").
+			AddField("contentType", xerrors.ReasonTypeInvalidValue)
+	}
+
+	return img, contentType, nil
+}
+
+// store saves the image and a thumbnail for each size smaller than it,
+// under products/<productId>/<imageId>/.
+func (usc *UscUploadProductImage) store(ctx context.Context, productId string, img imaging.Image, contentType string, content []byte) (entity.ProductImage, error) {
+	bounds := img.Bounds()
+	imageId := ulid.NewUlid().String()
+	prefix := fmt.Sprintf("products/%s/%s/", productId, imageId)
+
+	image := entity.ProductImage{
+		ID:          imageId,
+		ContentType: contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Size:        int64(len(content)),
+		Key:         prefix + "original." + imageExtensions[contentType],
+		Thumbnails:  []entity.ProductThumbnail{},
+		CreatedAt:   usc.clock.Now(),
+	}
+	image.Url = usc.imageStorage.URL(image.Key)
+
+	if err := usc.imageStorage.Put(ctx, image.Key, contentType, content); err != nil {
+		return entity.ProductImage{}, err
+	}
+
+	for _, size := range usc.policy.ThumbnailSizes {
+		if size <= 0 || size >= max(image.Width, image.Height) {
+			continue
+		}
+
+		width, height := entity.FitImage(image.Width, image.Height, size)
+		encoded, thumbnailType, err := imaging.Encode(imaging.Scale(img, width, height), contentType)
+		if err != nil {
+			deleteImageObjects(ctx, usc.imageStorage, image)
+			return entity.ProductImage{}, err
+		}
+
+		thumbnail := entity.ProductThumbnail{
+			Size:   size,
+			Width:  width,
+			Height: height,
+			Key:    fmt.Sprintf("%s%d.%s", prefix, size, imageExtensions[thumbnailType]),
+		}
+		thumbnail.Url = usc.imageStorage.URL(thumbnail.Key)
+		image.Thumbnails = append(image.Thumbnails, thumbnail)
+
+		if err := usc.imageStorage.Put(ctx, thumbnail.Key, thumbnailType, encoded); err != nil {
+			deleteImageObjects(ctx, usc.imageStorage, image)
+			return entity.ProductImage{}, err
+		}
+	}
+
+	return image, nil
+}
+
+// deleteImageObjects removes the objects of the image, only logging the failures: the product
+// no longer refers to them, at worst they are left behind in the storage.
+func deleteImageObjects(ctx context.Context, imageStorage gateway.IImageStorage, image entity.ProductImage) {
+	for _, key := range image.Keys() {
+		if err := imageStorage.Delete(ctx, key); err != nil {
+			slog.ErrorContext(ctx, "Error deleting image object "+key+": "+err.Error())
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscDeleteProductImage struct {
+	productGateway  *gateway.ProductGateway
+	categoryGateway *gateway.CategoryGateway
+	eventGateway    *gateway.ProductEventGateway
+	imageStorage    gateway.IImageStorage
+	clock           clock.Clock
+}
+
+func NewUseCaseDeleteProductImage(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	imageStorage gateway.IImageStorage,
+	clock clock.Clock) *UscDeleteProductImage {
+	return &UscDeleteProductImage{
+		productGateway:  productGateway,
+		categoryGateway: categoryGateway,
+		eventGateway:    eventGateway,
+		imageStorage:    imageStorage,
+		clock:           clock,
+	}
+}
+
+// DeleteById takes the image off the product, then deletes its objects from the storage.
+func (usc *UscDeleteProductImage) DeleteById(ctx context.Context, command dto.DeleteProductImage) error {
+
+	var image entity.ProductImage
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		product, err := usc.productGateway.FindOne(ctx, command.ProductId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrProductNotFound
+		}
+
+		index := slices.IndexFunc(product.Images, func(image entity.ProductImage) bool {
+			return image.ID == command.ImageId
+		})
+		if index < 0 {
+			return ErrImageNotFound
+		}
+		image = product.Images[index]
+
+		images := slices.Delete(slices.Clone(product.Images), index, index+1)
+		previous, updated, err := usc.productGateway.ReplaceImages(ctx, product.ID, product.Version, images)
+		if err != nil {
+			return productChanged(err)
+		}
+		if updated == nil {
+			return ErrProductNotFound
+		}
+
+		category, err := usc.categoryGateway.FindById(ctx, updated.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		return err
+	}
+
+	deleteImageObjects(ctx, usc.imageStorage, image)
+
+	return nil
+}
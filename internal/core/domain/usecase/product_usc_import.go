@@ -0,0 +1,450 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+// maxImportRows bounds an import, so a single transaction stays reasonable.
+const maxImportRows = 1000
+
+var importColumns = map[string]bool{"id": true, "name": true, "description": true, "categoryId": true, "amount": true}
+
+type UscImportProduct struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseImportProduct(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscImportProduct {
+	return &UscImportProduct{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+// importRow is a decoded row along with what saving it takes: the product it replaces,
+// if any, and its category.
+type importRow struct {
+	report   dto.ImportRowReport
+	product  dto.ImportProduct
+	category entity.Category
+	err      error
+}
+
+// Import checks every row before saving any. An all-or-nothing import saves the rows in a single
+// transaction and only when all of them are valid, a best-effort one saves each valid row on its own.
+// Errors about the file itself fail the whole import, the row errors go to the report.
+// All-or-nothing imports are refused when the transactions cannot roll back.
+func (usc *UscImportProduct) Import(ctx context.Context, command dto.ImportProducts) (dto.ImportReport, error) {
+
+	if command.Mode == dto.ImportAllOrNothing && !command.DryRun && !usc.eventGateway.RollsBack() {
+		return dto.ImportReport{}, xerrors.NewValidationError("Invalid Body").
+			AddField("mode", xerrors.ReasonTypeInvalidValue)
+	}
+
+	var rows []importRow
+	var err error
+
+	if command.Format == dto.ImportFormatCSV {
+		rows, err = decodeImportCSV(command.Content)
+	} else {
+		rows, err = decodeImportJSON(command.Content)
+	}
+	if err != nil {
+		return dto.ImportReport{}, err
+	}
+
+	if len(rows) == 0 || len(rows) > maxImportRows {
+		return dto.ImportReport{}, xerrors.NewValidationError("Invalid Body").
+			AddField("rows", xerrors.ReasonTypeInvalidValue)
+	}
+
+	err = usc.check(ctx, rows)
+	if err != nil {
+		return dto.ImportReport{}, err
+	}
+
+	report := dto.ImportReport{Mode: command.Mode, DryRun: command.DryRun}
+
+	switch {
+	case command.DryRun:
+	case command.Mode == dto.ImportAllOrNothing:
+		usc.saveAll(ctx, rows)
+	default:
+		usc.saveEach(ctx, rows)
+	}
+
+	for _, row := range rows {
+		if row.err != nil {
+			row.report.Status = dto.ImportRowFailed
+			row.report.Error = usc.productPresenter.BuildItemError(row.err)
+		}
+
+		switch row.report.Status {
+		case dto.ImportRowCreated:
+			report.Created++
+		case dto.ImportRowUpdated:
+			report.Updated++
+		case dto.ImportRowFailed:
+			report.Failed++
+		}
+		report.Rows = append(report.Rows, row.report)
+	}
+
+	report.Applied = !command.DryRun && report.Created+report.Updated > 0
+
+	return report, nil
+}
+
+// check validates the rows and plans each valid one as a creation or an update.
+func (usc *UscImportProduct) check(ctx context.Context, rows []importRow) error {
+
+	categories, err := usc.categoryGateway.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	catalog := map[int]entity.Category{}
+	for _, category := range categories {
+		catalog[category.ID] = category
+	}
+
+	seen := map[string]bool{}
+
+	for i := range rows {
+		row := &rows[i]
+		if row.err != nil {
+			continue
+		}
+
+		if err := validator.Validate(row.product); err != nil {
+			row.err = err
+			continue
+		}
+
+		category, ok := catalog[row.product.CategoryId]
+		if !ok {
+			row.err = ErrCategoryNotExists
+			continue
+		}
+		row.category = category
+
+		if row.product.ProductId == "" {
+			row.report.Status = dto.ImportRowCreated
+			continue
+		}
+
+		if seen[row.product.ProductId] {
+			row.err = xerrors.NewValidationError("Invalid Body").AddField("id", xerrors.ReasonTypeInvalidValue)
+			continue
+		}
+		seen[row.product.ProductId] = true
+
+		product, err := usc.productGateway.FindOne(ctx, row.product.ProductId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			row.err = ErrProductNotFound
+			continue
+		}
+
+		row.report.ProductId = product.ID
+		row.report.Status = dto.ImportRowUpdated
+	}
+
+	return nil
+}
+
+// saveAll saves every row in one transaction, unless some is invalid. The rows left unsaved
+// are reported as skipped, all of them fail when the transaction cannot commit.
+func (usc *UscImportProduct) saveAll(ctx context.Context, rows []importRow) {
+
+	failed := -1
+	for i, row := range rows {
+		if row.err != nil {
+			failed = i
+			break
+		}
+	}
+
+	if failed < 0 {
+		err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+			failed = -1
+			for i := range rows {
+				if err := usc.save(ctx, &rows[i]); err != nil {
+					failed = i
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "Error importing products: "+err.Error())
+			if failed < 0 {
+				for i := range rows {
+					rows[i].err = err
+				}
+				return
+			}
+			rows[failed].err = err
+		}
+	}
+
+	if failed < 0 {
+		return
+	}
+
+	for i := range rows {
+		if rows[i].err == nil {
+			rows[i].report.Status = dto.ImportRowSkipped
+			rows[i].report.ProductId = rows[i].product.ProductId
+		}
+	}
+}
+
+func (usc *UscImportProduct) saveEach(ctx context.Context, rows []importRow) {
+	for i := range rows {
+		if rows[i].err != nil {
+			continue
+		}
+
+		err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+			return usc.save(ctx, &rows[i])
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "Error importing product: "+err.Error())
+			rows[i].err = err
+		}
+	}
+}
+
+// save writes the row and records its event, it must run inside a transaction.
+func (usc *UscImportProduct) save(ctx context.Context, row *importRow) error {
+
+	if row.report.Status == dto.ImportRowCreated {
+		product := entity.Product{
+			ID:          ulid.NewUlid().String(),
+			Name:        row.product.Name,
+			Description: row.product.Description,
+			CategoryId:  row.product.CategoryId,
+			Amount:      *row.product.Amount,
+			Version:     1,
+			CreatedAt:   usc.clock.Now(),
+			UpdatedAt:   usc.clock.Now(),
+		}
+
+		if err := usc.productGateway.Create(ctx, &product); err != nil {
+			return err
+		}
+
+		row.report.ProductId = product.ID
+		return usc.eventGateway.Record(ctx, entity.NewProductCreated(product, row.category, usc.clock.Now()))
+	}
+
+	previous, updated, err := usc.productGateway.UpdateById(ctx, dto.UpdateProduct{
+		ProductId:   row.product.ProductId,
+		Name:        row.product.Name,
+		Description: row.product.Description,
+		CategoryId:  row.product.CategoryId,
+		Amount:      *row.product.Amount,
+	})
+	if err != nil {
+		return productChanged(err)
+	}
+	if updated == nil {
+		return ErrProductNotFound
+	}
+
+	return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, row.category, usc.clock.Now()))
+}
+
+// decodeImportCSV reads a CSV with a header naming the columns, in any order. Files separated
+// by semicolons, as spreadsheets export them in locales using the decimal comma, are accepted too.
+func decodeImportCSV(content []byte) ([]importRow, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	vErr := xerrors.NewValidationError("Invalid Body")
+
+	header, _, _ := bytes.Cut(content, []byte("\n"))
+	semicolon := bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(","))
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	if semicolon {
+		reader.Comma = ';'
+	}
+
+	columns, err := reader.Read()
+	if err != nil {
+		return nil, vErr.AddField("header", xerrors.ReasonRequiredAttributeMissing)
+	}
+
+	for i, column := range columns {
+		columns[i] = strings.TrimSpace(column)
+		if !importColumns[columns[i]] || slices.Contains(columns[:i], columns[i]) {
+			vErr = vErr.AddField(columns[i], xerrors.ReasonTypeInvalidValue)
+		}
+	}
+	if len(vErr.Fields) > 0 {
+		return nil, vErr
+	}
+
+	rows := []importRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		row := importRow{report: dto.ImportRowReport{Row: len(rows) + 1}}
+		rows = append(rows, row)
+		if len(rows) > maxImportRows {
+			return rows, nil
+		}
+
+		if errors.Is(err, csv.ErrFieldCount) {
+			rows[len(rows)-1].err = xerrors.NewValidationError("Invalid Body").
+				AddField("columns", xerrors.ReasonTypeInvalidValue)
+			continue
+		}
+		if err != nil {
+			return nil, vErr.AddField("content", xerrors.ReasonTypeInvalidValue)
+		}
+
+		rows[len(rows)-1].product, rows[len(rows)-1].err = decodeImportRecord(columns, record, semicolon)
+	}
+
+	return rows, nil
+}
+
+func decodeImportRecord(columns []string, record []string, decimalComma bool) (dto.ImportProduct, error) {
+	product := dto.ImportProduct{}
+	vErr := xerrors.NewValidationError("Invalid Body")
+
+	for i, column := range columns {
+		value := strings.TrimSpace(record[i])
+
+		switch column {
+		case "id":
+			product.ProductId = value
+		case "name":
+			product.Name = value
+		case "description":
+			product.Description = value
+		case "categoryId":
+			if value == "" {
+				continue
+			}
+			categoryId, err := strconv.Atoi(value)
+			if err != nil {
+				vErr = vErr.AddField(column, xerrors.ReasonTypeInvalidValue)
+				continue
+			}
+			product.CategoryId = categoryId
+		case "amount":
+			if value == "" {
+				continue
+			}
+			if decimalComma {
+				value = strings.Replace(value, ",", ".", 1)
+			}
+			amount, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				vErr = vErr.AddField(column, xerrors.ReasonTypeInvalidValue)
+				continue
+			}
+			product.Amount = &amount
+		}
+	}
+
+	if len(vErr.Fields) > 0 {
+		return product, vErr
+	}
+
+	return product, nil
+}
+
+// decodeImportJSON reads an array of products, rejecting the members a product does not have.
+func decodeImportJSON(content []byte) ([]importRow, error) {
+	elements := []json.RawMessage{}
+	if err := json.Unmarshal(content, &elements); err != nil {
+		return nil, xerrors.NewValidationError("Invalid Body")
+	}
+
+	rows := make([]importRow, 0, len(elements))
+	for i, element := range elements {
+		row := importRow{report: dto.ImportRowReport{Row: i + 1}}
+		row.product, row.err = decodeImportProduct(element)
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func decodeImportProduct(element []byte) (dto.ImportProduct, error) {
+	product := dto.ImportProduct{}
+	vErr := xerrors.NewValidationError("Invalid Body")
+
+	members := map[string]json.RawMessage{}
+	if err := json.Unmarshal(element, &members); err != nil {
+		return product, vErr
+	}
+
+	for name, value := range members {
+		if !importColumns[name] {
+			vErr = vErr.AddField(name, xerrors.ReasonTypeInvalidValue)
+			continue
+		}
+		if err := json.Unmarshal(value, importField(&product, name)); err != nil {
+			vErr = vErr.AddField(name, xerrors.ReasonTypeInvalidValue)
+		}
+	}
+
+	if len(vErr.Fields) > 0 {
+		return product, vErr
+	}
+
+	return product, nil
+}
+
+func importField(product *dto.ImportProduct, name string) any {
+	switch name {
+	case "id":
+		return &product.ProductId
+	case "name":
+		return &product.Name
+	case "description":
+		return &product.Description
+	case "categoryId":
+		return &product.CategoryId
+	default:
+		return &product.Amount
+	}
+}
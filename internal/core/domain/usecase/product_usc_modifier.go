@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type UscAttachModifierGroups struct {
+	productGateway       *gateway.ProductGateway
+	categoryGateway      *gateway.CategoryGateway
+	modifierGroupGateway *gateway.ModifierGroupGateway
+	eventGateway         *gateway.ProductEventGateway
+	productPresenter     *presenter.ProductPresenter
+	clock                clock.Clock
+}
+
+func NewUseCaseAttachModifierGroups(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	modifierGroupGateway *gateway.ModifierGroupGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscAttachModifierGroups {
+	return &UscAttachModifierGroups{
+		productGateway:       productGateway,
+		categoryGateway:      categoryGateway,
+		modifierGroupGateway: modifierGroupGateway,
+		eventGateway:         eventGateway,
+		productPresenter:     productPresenter,
+		clock:                clock,
+	}
+}
+
+// Attach replaces the modifier groups of the product with copies of the groups given, an empty
+// list detaches them all.
+func (usc *UscAttachModifierGroups) Attach(ctx context.Context, command dto.AttachModifierGroups) (dto.Product, error) {
+
+	var updated *entity.Product
+	var category *entity.Category
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		product, err := usc.productGateway.FindOne(ctx, command.ProductId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrProductNotFound
+		}
+		if command.Version != 0 && command.Version != product.Version {
+			return ErrProductChanged
+		}
+
+		groups := []entity.ModifierGroup{}
+		seen := map[string]bool{}
+		for _, groupId := range command.GroupIds {
+			if seen[groupId] {
+				return xerrors.NewValidationError("Invalid Body").
+					AddField("groupIds", xerrors.ReasonTypeInvalidValue)
+			}
+			seen[groupId] = true
+
+			group, err := usc.modifierGroupGateway.FindById(ctx, groupId)
+			if err != nil {
+				return err
+			}
+			if group == nil {
+				return ErrModifierGroupNotExists
+			}
+			groups = append(groups, *group)
+		}
+
+		var previous *entity.Product
+		previous, updated, err = usc.productGateway.ReplaceModifierGroups(ctx, product.ID, product.Version, groups)
+		if err != nil {
+			return productChanged(err)
+		}
+		if updated == nil {
+			return ErrProductNotFound
+		}
+
+		category, err = usc.categoryGateway.FindById(ctx, updated.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildProductCreateResponse(*updated, *category), nil
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/mergepatch"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type UscPatchProduct struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCasePatchProduct(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscPatchProduct {
+	return &UscPatchProduct{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+// PatchById merges the patch into the current product document and saves the result
+// as a full replacement, so it must satisfy the same rules as a PUT.
+func (usc *UscPatchProduct) PatchById(ctx context.Context, command dto.PatchProduct) (dto.Product, error) {
+
+	product, err := usc.productGateway.FindOne(ctx, command.ProductId)
+	if err != nil {
+		return dto.Product{}, err
+	}
+	if product == nil {
+		return dto.Product{}, ErrProductNotFound
+	}
+
+	current, err := json.Marshal(dto.ProductDocument{
+		Name:        product.Name,
+		Description: product.Description,
+		CategoryId:  product.CategoryId,
+		Amount:      &product.Amount,
+	})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	merged, err := mergepatch.Apply(current, command.Patch)
+	if errors.Is(err, mergepatch.ErrInvalidPatch) {
+		return dto.Product{}, xerrors.NewValidationError("Invalid Body")
+	}
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	document, err := decodeProductDocument(merged)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	category, err := usc.categoryGateway.FindById(ctx, document.CategoryId)
+	if err != nil {
+		return dto.Product{}, err
+	}
+	if category == nil {
+		return dto.Product{}, ErrCategoryNotExists
+	}
+
+	var updated *entity.Product
+
+	err = usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		previous, current, err := usc.productGateway.UpdateById(ctx, dto.UpdateProduct{
+			ProductId:   command.ProductId,
+			Name:        document.Name,
+			Description: document.Description,
+			CategoryId:  document.CategoryId,
+			Amount:      *document.Amount,
+			Version:     command.Version,
+		})
+		if err != nil {
+			return productChanged(err)
+		}
+		if current == nil {
+			return ErrProductNotFound
+		}
+
+		updated = current
+		return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildProductCreateResponse(*updated, *category), nil
+}
+
+// decodeProductDocument rejects members that are not part of the document or have the wrong type.
+func decodeProductDocument(merged []byte) (dto.ProductDocument, error) {
+	document := dto.ProductDocument{}
+	vErr := xerrors.NewValidationError("Invalid Body")
+
+	members := map[string]json.RawMessage{}
+	if err := json.Unmarshal(merged, &members); err != nil {
+		return document, vErr
+	}
+
+	known := map[string]bool{"name": true, "description": true, "categoryId": true, "amount": true}
+	for name, value := range members {
+		if !known[name] {
+			vErr = vErr.AddField(name, xerrors.ReasonTypeInvalidValue)
+			continue
+		}
+		if err := json.Unmarshal(value, documentField(&document, name)); err != nil {
+			vErr = vErr.AddField(name, xerrors.ReasonTypeInvalidValue)
+		}
+	}
+
+	if len(vErr.Fields) > 0 {
+		return document, vErr
+	}
+
+	return document, nil
+}
+
+func documentField(document *dto.ProductDocument, name string) any {
+	switch name {
+	case "name":
+		return &document.Name
+	case "description":
+		return &document.Description
+	case "categoryId":
+		return &document.CategoryId
+	default:
+		return &document.Amount
+	}
+}
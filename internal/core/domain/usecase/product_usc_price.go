@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type UscPriceProduct struct {
+	productGateway   *gateway.ProductGateway
+	productPresenter *presenter.ProductPresenter
+}
+
+func NewUseCasePriceProduct(productGateway *gateway.ProductGateway,
+	productPresenter *presenter.ProductPresenter) *UscPriceProduct {
+	return &UscPriceProduct{
+		productGateway:   productGateway,
+		productPresenter: productPresenter,
+	}
+}
+
+// Price quotes the product with the variant and modifiers picked, without saving anything.
+func (usc *UscPriceProduct) Price(ctx context.Context, command dto.PriceProduct) (dto.ProductPrice, error) {
+
+	product, err := usc.productGateway.FindOne(ctx, command.ProductId)
+	if err != nil {
+		return dto.ProductPrice{}, err
+	}
+	if product == nil {
+		return dto.ProductPrice{}, ErrProductNotFound
+	}
+
+	selections := make([]entity.ModifierSelection, 0, len(command.Modifiers))
+	for _, modifier := range command.Modifiers {
+		selections = append(selections, entity.ModifierSelection{
+			GroupId:  modifier.GroupId,
+			OptionId: modifier.OptionId,
+		})
+	}
+
+	price, err := product.Price(command.VariantId, selections)
+	switch {
+	case errors.Is(err, entity.ErrVariantSelection):
+		return dto.ProductPrice{}, xerrors.NewValidationError("Invalid Body").
+			AddField("variantId", xerrors.ReasonTypeInvalidValue)
+	case errors.Is(err, entity.ErrModifierSelection), errors.Is(err, entity.ErrModifierCount):
+		return dto.ProductPrice{}, xerrors.NewValidationError("Invalid Body").
+			AddField("modifiers", xerrors.ReasonTypeInvalidValue)
+	case err != nil:
+		return dto.ProductPrice{}, err
+	}
+
+	return usc.productPresenter.BuildProductPriceResponse(*product, price), nil
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscPurgeProduct struct {
+	productGateway *gateway.ProductGateway
+	clock          clock.Clock
+}
+
+func NewUseCasePurgeProduct(productGateway *gateway.ProductGateway,
+	clock clock.Clock) *UscPurgeProduct {
+	return &UscPurgeProduct{
+		productGateway: productGateway,
+		clock:          clock,
+	}
+}
+
+// PurgeDeleted permanently removes the products kept in the trash for longer than the retention.
+func (usc *UscPurgeProduct) PurgeDeleted(ctx context.Context, retention time.Duration) (int64, error) {
+	return usc.productGateway.PurgeDeleted(ctx, usc.clock.Now().Add(-retention))
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/core/presenter"
 	"github.com/tbtec/tremligeiro/internal/dto"
@@ -24,17 +25,62 @@ func NewUseCaseFindProduct(productGateway *gateway.ProductGateway,
 	}
 }
 
-func (usc *UscFindProduct) FindByCategory(ctx context.Context, categoryId int) (dto.ProductContent, error) {
+func (usc *UscFindProduct) Find(ctx context.Context, query dto.ProductQuery) (dto.ProductContent, error) {
 
-	category := usc.categoryGateway.FindById(categoryId)
-	if category == nil {
-		return dto.ProductContent{}, ErrCategoryNotExists
+	categories := map[int]entity.Category{}
+
+	for _, categoryId := range query.CategoryIds {
+		category, err := usc.categoryGateway.FindById(ctx, categoryId)
+		if err != nil {
+			return dto.ProductContent{}, err
+		}
+		if category == nil {
+			return dto.ProductContent{}, ErrCategoryNotExists
+		}
+		categories[category.ID] = *category
 	}
 
-	products, error := usc.productGateway.FindByCategory(ctx, categoryId)
+	products, error := usc.productGateway.Find(ctx, query)
 	if error != nil {
 		return dto.ProductContent{}, error
 	}
 
-	return usc.productPresenter.BuildProductContentResponse(products, *category), nil
+	total, err := usc.productGateway.Count(ctx, query)
+	if err != nil {
+		return dto.ProductContent{}, err
+	}
+
+	err = loadCategories(ctx, usc.categoryGateway, products, categories)
+	if err != nil {
+		return dto.ProductContent{}, err
+	}
+
+	components := map[string]entity.Product{}
+	err = loadComponents(ctx, usc.productGateway, products, components)
+	if err != nil {
+		return dto.ProductContent{}, err
+	}
+
+	return usc.productPresenter.BuildProductContentResponse(products, categories, components, query, total), nil
+}
+
+// loadCategories adds to the map the categories of the products that are not there yet.
+func loadCategories(ctx context.Context, categoryGateway *gateway.CategoryGateway,
+	products []entity.Product, categories map[int]entity.Category) error {
+
+	for _, product := range products {
+		if _, ok := categories[product.CategoryId]; ok {
+			continue
+		}
+
+		category, err := categoryGateway.FindById(ctx, product.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category != nil {
+			categories[category.ID] = *category
+		}
+	}
+
+	return nil
 }
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/core/presenter"
 	"github.com/tbtec/tremligeiro/internal/dto"
@@ -31,12 +32,24 @@ func (usc *UscFindOneProduct) FindByProductId(ctx context.Context, productId str
 	if error != nil {
 		return dto.Product{}, error
 	}
+	if product == nil {
+		return dto.Product{}, ErrProductNotFound
+	}
 
 	categoryId := product.CategoryId
-	category := usc.categoryGateway.FindById(categoryId)
+	category, err := usc.categoryGateway.FindById(ctx, categoryId)
+	if err != nil {
+		return dto.Product{}, err
+	}
 	if category == nil {
 		return dto.Product{}, ErrCategoryNotExists
 	}
 
-	return usc.productPresenter.BuildOneProductContentResponse(*product, *category), nil
+	components := map[string]entity.Product{}
+	err = loadComponents(ctx, usc.productGateway, []entity.Product{*product}, components)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildOneProductContentResponse(*product, *category, components), nil
 }
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscRelayProductEvents struct {
+	eventGateway   *gateway.ProductEventGateway
+	webhookGateway *gateway.WebhookGateway
+	eventPublisher gateway.IEventPublisher
+	eventPresenter *presenter.EventPresenter
+	clock          clock.Clock
+}
+
+func NewUseCaseRelayProductEvents(eventGateway *gateway.ProductEventGateway,
+	webhookGateway *gateway.WebhookGateway,
+	eventPublisher gateway.IEventPublisher,
+	eventPresenter *presenter.EventPresenter,
+	clock clock.Clock) *UscRelayProductEvents {
+	return &UscRelayProductEvents{
+		eventGateway:   eventGateway,
+		webhookGateway: webhookGateway,
+		eventPublisher: eventPublisher,
+		eventPresenter: eventPresenter,
+		clock:          clock,
+	}
+}
+
+// Relay publishes up to limit pending events, oldest first, as CloudEvents, and schedules
+// their delivery to the subscribed webhooks. It returns how many were relayed and how many failed.
+// An event is only marked as published after both steps succeed, so a crash in between
+// relays it again. When an event fails, the later events of the same product wait for the
+// next call, keeping each product's events in order.
+func (usc *UscRelayProductEvents) Relay(ctx context.Context, limit int) (int, int, error) {
+	events, err := usc.eventGateway.FindPending(ctx, limit)
+	if err != nil || len(events) == 0 {
+		return 0, 0, err
+	}
+
+	webhooks, err := usc.webhookGateway.FindAll(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	published := 0
+	blocked := map[string]bool{}
+
+	for _, event := range events {
+		if blocked[event.AggregateId] {
+			continue
+		}
+
+		if err := usc.relay(ctx, event, webhooks); err != nil {
+			blocked[event.AggregateId] = true
+			slog.WarnContext(ctx, "Event not published: "+event.ID, slog.Any("error", err))
+			if err := usc.eventGateway.MarkFailed(ctx, event.ID, err.Error()); err != nil {
+				return published, len(blocked), err
+			}
+			continue
+		}
+
+		if err := usc.eventGateway.MarkPublished(ctx, event.ID, usc.clock.Now()); err != nil {
+			return published, len(blocked), err
+		}
+		published++
+	}
+
+	return published, len(blocked), nil
+}
+
+func (usc *UscRelayProductEvents) relay(ctx context.Context, event entity.OutboxEvent, webhooks []entity.Webhook) error {
+	cloudEvent := usc.eventPresenter.BuildCloudEvent(event)
+
+	if err := usc.eventPublisher.Publish(ctx, cloudEvent); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(cloudEvent)
+	if err != nil {
+		return err
+	}
+
+	now := usc.clock.Now()
+	deliveries := []entity.WebhookDelivery{}
+	for _, webhook := range webhooks {
+		if webhook.Subscribes(event.Type) {
+			deliveries = append(deliveries, entity.NewWebhookDelivery(webhook, event, payload, now))
+		}
+	}
+
+	return usc.webhookGateway.AddDeliveries(ctx, deliveries)
+}
+
+// PurgePublished removes the events published for longer than the retention.
+func (usc *UscRelayProductEvents) PurgePublished(ctx context.Context, retention time.Duration) (int64, error) {
+	return usc.eventGateway.PurgePublished(ctx, usc.clock.Now().Add(-retention))
+}
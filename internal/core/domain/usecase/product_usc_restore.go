@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscRestoreProduct struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseRestoreProduct(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscRestoreProduct {
+	return &UscRestoreProduct{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+func (usc *UscRestoreProduct) RestoreById(ctx context.Context, productId string) (dto.Product, error) {
+
+	var product *entity.Product
+	var category *entity.Category
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = usc.productGateway.Restore(ctx, productId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrProductNotFound
+		}
+
+		category, err = usc.categoryGateway.FindById(ctx, product.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		return usc.eventGateway.Record(ctx, entity.NewProductRestored(*product, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	components := map[string]entity.Product{}
+	err = loadComponents(ctx, usc.productGateway, []entity.Product{*product}, components)
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildOneProductContentResponse(*product, *category, components), nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/xstrings"
+)
+
+type UscSearchProduct struct {
+	productGateway   *gateway.ProductGateway
+	categoryGateway  *gateway.CategoryGateway
+	productPresenter *presenter.ProductPresenter
+}
+
+func NewUseCaseSearchProduct(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	productPresenter *presenter.ProductPresenter) *UscSearchProduct {
+	return &UscSearchProduct{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		productPresenter: productPresenter,
+	}
+}
+
+func (usc *UscSearchProduct) Search(ctx context.Context, search dto.ProductSearch) (dto.ProductSearchContent, error) {
+
+	matches, err := usc.productGateway.Search(ctx, search)
+	if err != nil {
+		return dto.ProductSearchContent{}, err
+	}
+
+	products := []entity.Product{}
+	for _, match := range matches {
+		products = append(products, match.Product)
+	}
+
+	categories := map[int]entity.Category{}
+	err = loadCategories(ctx, usc.categoryGateway, products, categories)
+	if err != nil {
+		return dto.ProductSearchContent{}, err
+	}
+
+	return usc.productPresenter.BuildProductSearchResponse(matches, categories, xstrings.Terms(search.Query)), nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+// stockChange changes the stock, returning it before and after the change, nils when it is not tracked.
+type stockChange func(ctx context.Context, product entity.Product) (*entity.ProductStock, *entity.ProductStock, error)
+
+// stockWriter saves the changes of the stock use cases, reporting the stock falling to its threshold.
+type stockWriter struct {
+	productGateway  *gateway.ProductGateway
+	categoryGateway *gateway.CategoryGateway
+	stockGateway    *gateway.StockGateway
+	eventGateway    *gateway.ProductEventGateway
+	clock           clock.Clock
+}
+
+// write applies change to the stock of the product, returning the product with the stock changed.
+func (writer stockWriter) write(ctx context.Context, productId string, change stockChange) (*entity.Product, error) {
+
+	var product *entity.Product
+
+	err := writer.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = writer.productGateway.FindOne(ctx, productId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrProductNotFound
+		}
+
+		return writer.apply(ctx, product, change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// apply changes the stock of the product within the caller's transaction. A ProductLowStock is
+// recorded along with the change when the stock was not low before it.
+func (writer stockWriter) apply(ctx context.Context, product *entity.Product, change stockChange) error {
+	previous, stock, err := change(ctx, *product)
+	if err != nil {
+		return err
+	}
+	if stock == nil {
+		return ErrStockNotFound
+	}
+	product.Stock = stock
+
+	if !stock.Low() || (previous != nil && previous.Low()) {
+		return nil
+	}
+
+	category, err := writer.categoryGateway.FindById(ctx, product.CategoryId)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return ErrCategoryNotExists
+	}
+
+	return writer.eventGateway.Record(ctx, entity.NewProductLowStock(*product, *category, writer.clock.Now()))
+}
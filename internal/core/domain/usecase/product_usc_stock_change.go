@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscChangeProductStock struct {
+	writer           stockWriter
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseChangeProductStock(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	stockGateway *gateway.StockGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscChangeProductStock {
+	return &UscChangeProductStock{
+		writer:           stockWriter{productGateway, categoryGateway, stockGateway, eventGateway, clock},
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+// Change adds units to or removes units from the stock, on hand or reserved, in a single
+// conditional update so that concurrent sales never take more units than there are.
+func (usc *UscChangeProductStock) Change(ctx context.Context, command dto.ChangeProductStock) (dto.ProductStock, error) {
+
+	product, err := usc.writer.write(ctx, command.ProductId,
+		func(ctx context.Context, product entity.Product) (*entity.ProductStock, *entity.ProductStock, error) {
+			change := usc.writer.stockGateway.Adjust
+			if command.Reserve {
+				change = usc.writer.stockGateway.Reserve
+			}
+
+			stock, err := change(ctx, product.ID, command.Delta)
+			if stock == nil || err != nil {
+				return nil, nil, err
+			}
+
+			previous := *stock
+			if command.Reserve {
+				previous.Reserved -= command.Delta
+			} else {
+				previous.OnHand -= command.Delta
+			}
+
+			return &previous, stock, nil
+		})
+	if err != nil {
+		return dto.ProductStock{}, err
+	}
+
+	return usc.productPresenter.BuildProductStockResponse(product.ID, *product.Stock), nil
+}
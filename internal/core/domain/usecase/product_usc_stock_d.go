@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+type UscDeleteProductStock struct {
+	productGateway *gateway.ProductGateway
+	stockGateway   *gateway.StockGateway
+}
+
+func NewUseCaseDeleteProductStock(productGateway *gateway.ProductGateway,
+	stockGateway *gateway.StockGateway) *UscDeleteProductStock {
+	return &UscDeleteProductStock{
+		productGateway: productGateway,
+		stockGateway:   stockGateway,
+	}
+}
+
+// Delete stops tracking the stock of the product, which is then always available.
+func (usc *UscDeleteProductStock) Delete(ctx context.Context, productId string) error {
+
+	product, err := usc.productGateway.FindOne(ctx, productId)
+	if err != nil {
+		return err
+	}
+	if product == nil {
+		return ErrProductNotFound
+	}
+
+	deleted, err := usc.stockGateway.DeleteByProductId(ctx, productId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrStockNotFound
+	}
+
+	return nil
+}
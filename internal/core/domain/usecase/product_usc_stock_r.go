@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindProductStock struct {
+	productGateway   *gateway.ProductGateway
+	productPresenter *presenter.ProductPresenter
+}
+
+func NewUseCaseFindProductStock(productGateway *gateway.ProductGateway,
+	productPresenter *presenter.ProductPresenter) *UscFindProductStock {
+	return &UscFindProductStock{
+		productGateway:   productGateway,
+		productPresenter: productPresenter,
+	}
+}
+
+func (usc *UscFindProductStock) Find(ctx context.Context, productId string) (dto.ProductStock, error) {
+
+	product, err := usc.productGateway.FindOne(ctx, productId)
+	if err != nil {
+		return dto.ProductStock{}, err
+	}
+	if product == nil {
+		return dto.ProductStock{}, ErrProductNotFound
+	}
+	if product.Stock == nil {
+		return dto.ProductStock{}, ErrStockNotFound
+	}
+
+	return usc.productPresenter.BuildProductStockResponse(product.ID, *product.Stock), nil
+}
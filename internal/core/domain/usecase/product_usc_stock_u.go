@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscSaveProductStock struct {
+	writer           stockWriter
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseSaveProductStock(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	stockGateway *gateway.StockGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscSaveProductStock {
+	return &UscSaveProductStock{
+		writer:           stockWriter{productGateway, categoryGateway, stockGateway, eventGateway, clock},
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+// Save sets the units on hand after a count, starting to track the stock of the product.
+// The units reserved are kept, so there cannot be fewer units on hand than them.
+func (usc *UscSaveProductStock) Save(ctx context.Context, command dto.SaveProductStock) (dto.ProductStock, error) {
+
+	product, err := usc.writer.write(ctx, command.ProductId,
+		func(ctx context.Context, product entity.Product) (*entity.ProductStock, *entity.ProductStock, error) {
+			stock, err := usc.writer.stockGateway.Save(ctx, product.ID, *command.OnHand, command.LowStockThreshold)
+			return product.Stock, stock, err
+		})
+	if err != nil {
+		return dto.ProductStock{}, err
+	}
+
+	return usc.productPresenter.BuildProductStockResponse(product.ID, *product.Stock), nil
+}
@@ -3,38 +3,73 @@ package usecase
 import (
 	"context"
 
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/core/presenter"
 	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 )
 
 type UscUpdateProduct struct {
 	productGateway   *gateway.ProductGateway
 	categoryGateway  *gateway.CategoryGateway
+	eventGateway     *gateway.ProductEventGateway
 	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
 }
 
 func NewUseCaseUpdateProduct(productGateway *gateway.ProductGateway,
 	categoryGateway *gateway.CategoryGateway,
-	productPresenter *presenter.ProductPresenter) *UscUpdateProduct {
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscUpdateProduct {
 	return &UscUpdateProduct{
 		productGateway:   productGateway,
 		categoryGateway:  categoryGateway,
+		eventGateway:     eventGateway,
 		productPresenter: productPresenter,
+		clock:            clock,
 	}
 }
 
 func (usc *UscUpdateProduct) UpdateById(ctx context.Context, command dto.UpdateProduct) (dto.Product, error) {
 
-	product, error := usc.productGateway.UpdateById(ctx, command)
-	if error != nil {
-		return dto.Product{}, error
+	if command.CategoryId != 0 {
+		category, err := usc.categoryGateway.FindById(ctx, command.CategoryId)
+		if err != nil {
+			return dto.Product{}, err
+		}
+		if category == nil {
+			return dto.Product{}, ErrCategoryNotExists
+		}
 	}
 
-	category := usc.categoryGateway.FindById(product.CategoryId)
-	if category == nil {
-		return dto.Product{}, ErrCategoryNotExists
+	var product *entity.Product
+	var category *entity.Category
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		previous, updated, err := usc.productGateway.UpdateById(ctx, command)
+		if err != nil {
+			return productChanged(err)
+		}
+		if updated == nil {
+			return ErrProductNotFound
+		}
+
+		category, err = usc.categoryGateway.FindById(ctx, updated.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		product = updated
+		return usc.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, usc.clock.Now()))
+	})
+	if err != nil {
+		return dto.Product{}, err
 	}
 
-	return usc.productPresenter.BuildProductCreateResponse(product, *category), nil
+	return usc.productPresenter.BuildProductCreateResponse(*product, *category), nil
 }
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+// variantChange edits the variants of the product, returning whether they are enabled along with them.
+type variantChange func(product entity.Product) (bool, []entity.ProductVariant, error)
+
+// variantWriter saves the changes of the variant use cases as product updates.
+type variantWriter struct {
+	productGateway  *gateway.ProductGateway
+	categoryGateway *gateway.CategoryGateway
+	eventGateway    *gateway.ProductEventGateway
+	clock           clock.Clock
+}
+
+// write applies change to the product while it is at version, zero skips the check,
+// recording the update. It returns the product changed along with its category.
+func (writer variantWriter) write(ctx context.Context, productId string, version int64, change variantChange) (*entity.Product, *entity.Category, error) {
+
+	var updated *entity.Product
+	var category *entity.Category
+
+	err := writer.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		product, err := writer.productGateway.FindOne(ctx, productId)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return ErrProductNotFound
+		}
+		if version != 0 && version != product.Version {
+			return ErrProductChanged
+		}
+
+		enabled, variants, err := change(*product)
+		if err != nil {
+			return err
+		}
+		if enabled && len(variants) == 0 {
+			return ErrVariantRequired
+		}
+
+		var previous *entity.Product
+		previous, updated, err = writer.productGateway.ReplaceVariants(ctx, productId, product.Version, enabled, variants)
+		if err != nil {
+			return productChanged(err)
+		}
+		if updated == nil {
+			return ErrProductNotFound
+		}
+
+		category, err = writer.categoryGateway.FindById(ctx, updated.CategoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotExists
+		}
+
+		return writer.eventGateway.Record(ctx, entity.NewProductUpdated(*previous, *updated, *category, writer.clock.Now()))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return updated, category, nil
+}
+
+// checkVariantSkus rejects the variants sharing a SKU.
+func checkVariantSkus(variants []entity.ProductVariant) error {
+	skus := map[string]bool{}
+	for _, variant := range variants {
+		if skus[variant.Sku] {
+			return ErrVariantSkuExists
+		}
+		skus[variant.Sku] = true
+	}
+	return nil
+}
+
+func indexVariant(variants []entity.ProductVariant, variantId string) int {
+	for i, variant := range variants {
+		if variant.ID == variantId {
+			return i
+		}
+	}
+	return -1
+}
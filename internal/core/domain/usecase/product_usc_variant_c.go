@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+)
+
+type UscCreateProductVariant struct {
+	writer           variantWriter
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseCreateProductVariant(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscCreateProductVariant {
+	return &UscCreateProductVariant{
+		writer:           variantWriter{productGateway, categoryGateway, eventGateway, clock},
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+// Create adds the variant, enabling the variants of the product when it is the first.
+func (usc *UscCreateProductVariant) Create(ctx context.Context, command dto.CreateProductVariant) (dto.Product, error) {
+
+	product, category, err := usc.writer.write(ctx, command.ProductId, command.Version,
+		func(product entity.Product) (bool, []entity.ProductVariant, error) {
+			variants := append(append([]entity.ProductVariant{}, product.Variants...), entity.ProductVariant{
+				ID:     ulid.NewUlid().String(),
+				Sku:    command.Sku,
+				Label:  command.Label,
+				Amount: command.Amount,
+			})
+			return true, variants, checkVariantSkus(variants)
+		})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildProductCreateResponse(*product, *category), nil
+}
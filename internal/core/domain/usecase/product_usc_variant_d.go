@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscDeleteProductVariant struct {
+	writer variantWriter
+	clock  clock.Clock
+}
+
+func NewUseCaseDeleteProductVariant(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	clock clock.Clock) *UscDeleteProductVariant {
+	return &UscDeleteProductVariant{
+		writer: variantWriter{productGateway, categoryGateway, eventGateway, clock},
+		clock:  clock,
+	}
+}
+
+// DeleteById removes the variant, unless it is the last one: a product with variants enabled
+// always has one. Without a VariantId every variant is removed, which disables them.
+func (usc *UscDeleteProductVariant) DeleteById(ctx context.Context, command dto.DeleteProductVariant) error {
+
+	_, _, err := usc.writer.write(ctx, command.ProductId, command.Version,
+		func(product entity.Product) (bool, []entity.ProductVariant, error) {
+			if command.VariantId == "" {
+				return false, nil, nil
+			}
+
+			index := indexVariant(product.Variants, command.VariantId)
+			if index < 0 {
+				return false, nil, ErrVariantNotFound
+			}
+
+			variants := slices.Delete(slices.Clone(product.Variants), index, index+1)
+			return product.VariantsEnabled, variants, nil
+		})
+
+	return err
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindProductVariant struct {
+	productGateway   *gateway.ProductGateway
+	productPresenter *presenter.ProductPresenter
+}
+
+func NewUseCaseFindProductVariant(productGateway *gateway.ProductGateway,
+	productPresenter *presenter.ProductPresenter) *UscFindProductVariant {
+	return &UscFindProductVariant{
+		productGateway:   productGateway,
+		productPresenter: productPresenter,
+	}
+}
+
+func (usc *UscFindProductVariant) Find(ctx context.Context, productId string) (dto.ProductVariantContent, error) {
+
+	product, err := usc.productGateway.FindOne(ctx, productId)
+	if err != nil {
+		return dto.ProductVariantContent{}, err
+	}
+	if product == nil {
+		return dto.ProductVariantContent{}, ErrProductNotFound
+	}
+
+	return dto.ProductVariantContent{
+		Content: usc.productPresenter.BuildProductVariantsResponse(product.Variants),
+	}, nil
+}
+
+func (usc *UscFindProductVariant) FindById(ctx context.Context, productId string, variantId string) (dto.ProductVariant, error) {
+
+	product, err := usc.productGateway.FindOne(ctx, productId)
+	if err != nil {
+		return dto.ProductVariant{}, err
+	}
+	if product == nil {
+		return dto.ProductVariant{}, ErrProductNotFound
+	}
+
+	index := indexVariant(product.Variants, variantId)
+	if index < 0 {
+		return dto.ProductVariant{}, ErrVariantNotFound
+	}
+
+	return usc.productPresenter.BuildProductVariantResponse(product.Variants[index]), nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscUpdateProductVariant struct {
+	writer           variantWriter
+	productPresenter *presenter.ProductPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseUpdateProductVariant(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	eventGateway *gateway.ProductEventGateway,
+	productPresenter *presenter.ProductPresenter,
+	clock clock.Clock) *UscUpdateProductVariant {
+	return &UscUpdateProductVariant{
+		writer:           variantWriter{productGateway, categoryGateway, eventGateway, clock},
+		productPresenter: productPresenter,
+		clock:            clock,
+	}
+}
+
+func (usc *UscUpdateProductVariant) UpdateById(ctx context.Context, command dto.UpdateProductVariant) (dto.Product, error) {
+
+	product, category, err := usc.writer.write(ctx, command.ProductId, command.Version,
+		func(product entity.Product) (bool, []entity.ProductVariant, error) {
+			index := indexVariant(product.Variants, command.VariantId)
+			if index < 0 {
+				return false, nil, ErrVariantNotFound
+			}
+
+			variants := slices.Clone(product.Variants)
+			variants[index] = entity.ProductVariant{
+				ID:     command.VariantId,
+				Sku:    command.Sku,
+				Label:  command.Label,
+				Amount: command.Amount,
+			}
+			return product.VariantsEnabled, variants, checkVariantSkus(variants)
+		})
+	if err != nil {
+		return dto.Product{}, err
+	}
+
+	return usc.productPresenter.BuildProductCreateResponse(*product, *category), nil
+}
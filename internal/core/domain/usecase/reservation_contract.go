@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var (
+	ErrReservationNotFound = xerrors.NewNotFoundError("TL-RESERVATION-001", "Reservation not found")
+	// ErrReservationNotPending rejects confirming or releasing a reservation twice.
+	ErrReservationNotPending       = xerrors.NewBusinessError("TL-RESERVATION-002", "Reservation is no longer pending")
+	ErrReservationExpired          = xerrors.NewBusinessError("TL-RESERVATION-003", "Reservation expired")
+	ErrReservationProductNotExists = xerrors.NewBusinessError("TL-RESERVATION-004", "Reserved product not exists")
+)
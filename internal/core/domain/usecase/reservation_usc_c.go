@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type UscCreateReservation struct {
+	writer               stockWriter
+	reservationGateway   *gateway.ReservationGateway
+	reservationPresenter *presenter.ReservationPresenter
+	ttl                  time.Duration
+	clock                clock.Clock
+}
+
+func NewUseCaseCreateReservation(productGateway *gateway.ProductGateway,
+	categoryGateway *gateway.CategoryGateway,
+	stockGateway *gateway.StockGateway,
+	eventGateway *gateway.ProductEventGateway,
+	reservationGateway *gateway.ReservationGateway,
+	reservationPresenter *presenter.ReservationPresenter,
+	ttl time.Duration,
+	clock clock.Clock) *UscCreateReservation {
+	return &UscCreateReservation{
+		writer:               stockWriter{productGateway, categoryGateway, stockGateway, eventGateway, clock},
+		reservationGateway:   reservationGateway,
+		reservationPresenter: reservationPresenter,
+		ttl:                  ttl,
+		clock:                clock,
+	}
+}
+
+// Create reserves every item or none of them: a single product short of stock fails the whole
+// reservation. The units stay reserved until the reservation is confirmed, released or expires.
+func (usc *UscCreateReservation) Create(ctx context.Context, command dto.CreateReservation) (dto.Reservation, error) {
+
+	found := map[string]bool{}
+	for _, item := range command.Items {
+		if found[item.ProductId] {
+			return dto.Reservation{}, xerrors.NewValidationError("Invalid Body").
+				AddField("items.productId", xerrors.ReasonTypeInvalidValue)
+		}
+		found[item.ProductId] = true
+	}
+
+	ttl := usc.ttl
+	if command.TtlSeconds != nil {
+		ttl = time.Duration(*command.TtlSeconds) * time.Second
+	}
+
+	var reservation entity.Reservation
+
+	err := usc.writer.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		items := []entity.ReservationItem{}
+
+		for _, document := range command.Items {
+			item, err := usc.reserve(ctx, document)
+			if err != nil {
+				// the memory driver cannot roll back, the transactional ones undo this along with the rest
+				releaseItems(ctx, usc.writer.stockGateway, items)
+				return err
+			}
+			items = append(items, item)
+		}
+
+		reservation = entity.NewReservation(ulid.NewUlid().String(), items, ttl, usc.clock.Now())
+
+		err := usc.reservationGateway.Create(ctx, reservation)
+		if err != nil {
+			releaseItems(ctx, usc.writer.stockGateway, items)
+		}
+
+		return err
+	})
+	if err != nil {
+		return dto.Reservation{}, err
+	}
+
+	return usc.reservationPresenter.BuildReservationResponse(reservation), nil
+}
+
+// reserve holds the units of the item, or nothing when the stock of the product is not tracked.
+func (usc *UscCreateReservation) reserve(ctx context.Context, document dto.ReservationItemDocument) (entity.ReservationItem, error) {
+	item := entity.ReservationItem{ProductId: document.ProductId, Quantity: document.Quantity}
+
+	product, err := usc.writer.productGateway.FindOne(ctx, document.ProductId)
+	if err != nil {
+		return item, err
+	}
+	if product == nil {
+		return item, ErrReservationProductNotExists
+	}
+	if product.Stock == nil {
+		return item, nil
+	}
+
+	err = usc.writer.apply(ctx, product,
+		func(ctx context.Context, product entity.Product) (*entity.ProductStock, *entity.ProductStock, error) {
+			stock, err := usc.writer.stockGateway.Reserve(ctx, product.ID, document.Quantity)
+			return product.Stock, stock, err
+		})
+	if errors.Is(err, ErrStockNotFound) {
+		return item, nil
+	}
+
+	item.Held = err == nil
+
+	return item, err
+}
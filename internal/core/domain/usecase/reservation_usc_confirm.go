@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscConfirmReservation struct {
+	reservationGateway   *gateway.ReservationGateway
+	stockGateway         *gateway.StockGateway
+	eventGateway         *gateway.ProductEventGateway
+	reservationPresenter *presenter.ReservationPresenter
+	clock                clock.Clock
+}
+
+func NewUseCaseConfirmReservation(reservationGateway *gateway.ReservationGateway,
+	stockGateway *gateway.StockGateway,
+	eventGateway *gateway.ProductEventGateway,
+	reservationPresenter *presenter.ReservationPresenter,
+	clock clock.Clock) *UscConfirmReservation {
+	return &UscConfirmReservation{
+		reservationGateway:   reservationGateway,
+		stockGateway:         stockGateway,
+		eventGateway:         eventGateway,
+		reservationPresenter: reservationPresenter,
+		clock:                clock,
+	}
+}
+
+// Confirm sells the units held, taking them off hand. The units for sale do not change, they were
+// already set aside when the reservation was made.
+func (usc *UscConfirmReservation) Confirm(ctx context.Context, reservationId string) (dto.Reservation, error) {
+
+	now := usc.clock.Now()
+
+	reservation, err := findPendingReservation(ctx, usc.reservationGateway, reservationId, now)
+	if err != nil {
+		return dto.Reservation{}, err
+	}
+
+	err = usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		moved, err := usc.reservationGateway.UpdateStatus(ctx, reservation.ID, entity.ReservationPending, entity.ReservationConfirmed, now)
+		if err != nil {
+			return err
+		}
+		if !moved {
+			return ErrReservationNotPending
+		}
+
+		for _, item := range reservation.Items {
+			if !item.Held {
+				continue
+			}
+			if _, err := usc.stockGateway.Consume(ctx, item.ProductId, item.Quantity); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dto.Reservation{}, err
+	}
+
+	reservation.Status = entity.ReservationConfirmed
+	reservation.UpdatedAt = now
+
+	return usc.reservationPresenter.BuildReservationResponse(*reservation), nil
+}
+
+// findPendingReservation returns the reservation while it can still be confirmed or released at now.
+func findPendingReservation(ctx context.Context, reservationGateway *gateway.ReservationGateway, reservationId string, now time.Time) (*entity.Reservation, error) {
+	reservation, err := reservationGateway.FindById(ctx, reservationId)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, ErrReservationNotFound
+	}
+	if reservation.Expired(now) {
+		return nil, ErrReservationExpired
+	}
+	if reservation.Status != entity.ReservationPending {
+		return nil, ErrReservationNotPending
+	}
+
+	return reservation, nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscFindOneReservation struct {
+	reservationGateway   *gateway.ReservationGateway
+	reservationPresenter *presenter.ReservationPresenter
+	clock                clock.Clock
+}
+
+func NewUseCaseFindOneReservation(reservationGateway *gateway.ReservationGateway,
+	reservationPresenter *presenter.ReservationPresenter,
+	clock clock.Clock) *UscFindOneReservation {
+	return &UscFindOneReservation{
+		reservationGateway:   reservationGateway,
+		reservationPresenter: reservationPresenter,
+		clock:                clock,
+	}
+}
+
+// FindOne shows a pending reservation past its expiry as expired, even before the sweeper releases it.
+func (usc *UscFindOneReservation) FindOne(ctx context.Context, reservationId string) (dto.Reservation, error) {
+
+	reservation, err := usc.reservationGateway.FindById(ctx, reservationId)
+	if err != nil {
+		return dto.Reservation{}, err
+	}
+	if reservation == nil {
+		return dto.Reservation{}, ErrReservationNotFound
+	}
+
+	if reservation.Expired(usc.clock.Now()) {
+		reservation.Status = entity.ReservationExpired
+	}
+
+	return usc.reservationPresenter.BuildReservationResponse(*reservation), nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscReleaseReservation struct {
+	reservationGateway   *gateway.ReservationGateway
+	stockGateway         *gateway.StockGateway
+	eventGateway         *gateway.ProductEventGateway
+	reservationPresenter *presenter.ReservationPresenter
+	clock                clock.Clock
+}
+
+func NewUseCaseReleaseReservation(reservationGateway *gateway.ReservationGateway,
+	stockGateway *gateway.StockGateway,
+	eventGateway *gateway.ProductEventGateway,
+	reservationPresenter *presenter.ReservationPresenter,
+	clock clock.Clock) *UscReleaseReservation {
+	return &UscReleaseReservation{
+		reservationGateway:   reservationGateway,
+		stockGateway:         stockGateway,
+		eventGateway:         eventGateway,
+		reservationPresenter: reservationPresenter,
+		clock:                clock,
+	}
+}
+
+// Release gives the units held back to the stock for sale, as when the cart is abandoned.
+func (usc *UscReleaseReservation) Release(ctx context.Context, reservationId string) (dto.Reservation, error) {
+
+	now := usc.clock.Now()
+
+	reservation, err := findPendingReservation(ctx, usc.reservationGateway, reservationId, now)
+	if err != nil {
+		return dto.Reservation{}, err
+	}
+
+	released, err := usc.release(ctx, *reservation, entity.ReservationReleased, now)
+	if err != nil {
+		return dto.Reservation{}, err
+	}
+	if !released {
+		return dto.Reservation{}, ErrReservationNotPending
+	}
+
+	reservation.Status = entity.ReservationReleased
+	reservation.UpdatedAt = now
+
+	return usc.reservationPresenter.BuildReservationResponse(*reservation), nil
+}
+
+// Expire releases up to limit reservations past their expiry, returning how many it released.
+func (usc *UscReleaseReservation) Expire(ctx context.Context, limit int) (int, error) {
+	now := usc.clock.Now()
+
+	reservations, err := usc.reservationGateway.FindExpired(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, reservation := range reservations {
+		released, err := usc.release(ctx, reservation, entity.ReservationExpired, now)
+		if err != nil {
+			return expired, err
+		}
+		if released {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// release moves the pending reservation to status along with releasing its units, reporting
+// false when it was confirmed or released meanwhile.
+func (usc *UscReleaseReservation) release(ctx context.Context, reservation entity.Reservation, status entity.ReservationStatus, now time.Time) (bool, error) {
+	moved := false
+
+	err := usc.eventGateway.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		moved, err = usc.reservationGateway.UpdateStatus(ctx, reservation.ID, entity.ReservationPending, status, now)
+		if err != nil || !moved {
+			return err
+		}
+
+		return releaseItems(ctx, usc.stockGateway, reservation.Items)
+	})
+
+	return moved, err
+}
+
+// releaseItems gives the units held by the items back. Units no longer reserved, released by hand
+// or whose stock stopped being tracked, are skipped so that a reservation can always be released.
+func releaseItems(ctx context.Context, stockGateway *gateway.StockGateway, items []entity.ReservationItem) error {
+	for _, item := range items {
+		if !item.Held {
+			continue
+		}
+
+		released, err := stockGateway.Release(ctx, item.ProductId, item.Quantity)
+		if err != nil {
+			return err
+		}
+		if !released {
+			slog.WarnContext(ctx, "Reserved units no longer held: "+item.ProductId)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package usecase
+
+import (
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var (
+	ErrStockNotFound = xerrors.NewNotFoundError("TL-STOCK-001", "Stock of the product is not tracked")
+)
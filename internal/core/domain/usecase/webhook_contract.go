@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var (
+	ErrWebhookNotFound = xerrors.NewNotFoundError("TL-WEBHOOK-001", "Webhook not found")
+)
+
+// Headers of every webhook delivery, besides the CloudEvent content type.
+const (
+	WebhookSignatureHeader = "X-Tremligeiro-Signature"
+	WebhookTimestampHeader = "X-Tremligeiro-Timestamp"
+	WebhookDeliveryHeader  = "X-Tremligeiro-Delivery"
+	WebhookEventHeader     = "X-Tremligeiro-Event"
+)
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"github.com/tbtec/tremligeiro/internal/types/ulid"
+)
+
+type UscCreateWebhook struct {
+	webhookGateway   *gateway.WebhookGateway
+	webhookPresenter *presenter.WebhookPresenter
+	clock            clock.Clock
+}
+
+func NewUseCaseCreateWebhook(webhookGateway *gateway.WebhookGateway,
+	webhookPresenter *presenter.WebhookPresenter,
+	clock clock.Clock) *UscCreateWebhook {
+	return &UscCreateWebhook{
+		webhookGateway:   webhookGateway,
+		webhookPresenter: webhookPresenter,
+		clock:            clock,
+	}
+}
+
+// Create subscribes the url to the given event types, listed once each.
+// Only the events published after the subscription are delivered.
+func (usc *UscCreateWebhook) Create(ctx context.Context, webhookDto dto.CreateWebhook) (dto.Webhook, error) {
+
+	webhook := entity.Webhook{
+		ID:        ulid.NewUlid().String(),
+		Url:       webhookDto.Url,
+		Secret:    webhookDto.Secret,
+		CreatedAt: usc.clock.Now(),
+	}
+
+	for _, eventType := range webhookDto.EventTypes {
+		if !webhook.Subscribes(entity.ProductEventType(eventType)) {
+			webhook.EventTypes = append(webhook.EventTypes, entity.ProductEventType(eventType))
+		}
+	}
+
+	err := usc.webhookGateway.Create(ctx, &webhook)
+	if err != nil {
+		return dto.Webhook{}, err
+	}
+
+	return usc.webhookPresenter.BuildWebhookResponse(webhook), nil
+}
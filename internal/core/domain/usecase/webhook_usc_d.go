@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+type UscDeleteWebhook struct {
+	webhookGateway *gateway.WebhookGateway
+}
+
+func NewUseCaseDeleteWebhook(webhookGateway *gateway.WebhookGateway) *UscDeleteWebhook {
+	return &UscDeleteWebhook{
+		webhookGateway: webhookGateway,
+	}
+}
+
+// DeleteById unsubscribes the webhook, dropping its pending deliveries and its delivery log.
+func (usc *UscDeleteWebhook) DeleteById(ctx context.Context, webhookId string) error {
+
+	deleted, err := usc.webhookGateway.DeleteById(ctx, webhookId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
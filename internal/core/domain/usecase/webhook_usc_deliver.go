@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+type UscDeliverWebhooks struct {
+	webhookGateway *gateway.WebhookGateway
+	webhookSender  gateway.IWebhookSender
+	retryPolicy    entity.WebhookRetryPolicy
+	clock          clock.Clock
+}
+
+func NewUseCaseDeliverWebhooks(webhookGateway *gateway.WebhookGateway,
+	webhookSender gateway.IWebhookSender,
+	retryPolicy entity.WebhookRetryPolicy,
+	clock clock.Clock) *UscDeliverWebhooks {
+	return &UscDeliverWebhooks{
+		webhookGateway: webhookGateway,
+		webhookSender:  webhookSender,
+		retryPolicy:    retryPolicy,
+		clock:          clock,
+	}
+}
+
+// Deliver sends up to limit due deliveries, returning how many succeeded and how many failed.
+// A failed delivery is retried following the retry policy, until it is given up as dead.
+// Deliveries are at least once and may arrive out of order, receivers tell them apart
+// by the delivery header and order them by the product version.
+func (usc *UscDeliverWebhooks) Deliver(ctx context.Context, limit int) (int, int, error) {
+	deliveries, err := usc.webhookGateway.FindDueDeliveries(ctx, usc.clock.Now(), limit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	delivered, failed := 0, 0
+	webhooks := map[string]*entity.Webhook{}
+
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.WebhookId]
+		if !ok {
+			webhook, err = usc.webhookGateway.FindById(ctx, delivery.WebhookId)
+			if err != nil {
+				return delivered, failed, err
+			}
+			webhooks[delivery.WebhookId] = webhook
+		}
+		if webhook == nil {
+			// left behind by an unsubscription that did not finish, given up at once
+			delivery.Fail(0, "webhook not found", usc.clock.Now(), entity.WebhookRetryPolicy{})
+		} else {
+			usc.send(ctx, *webhook, &delivery)
+		}
+
+		if delivery.Status == entity.WebhookDeliveryDelivered {
+			delivered++
+		} else {
+			failed++
+		}
+
+		if err := usc.webhookGateway.UpdateDelivery(ctx, delivery); err != nil {
+			return delivered, failed, err
+		}
+	}
+
+	return delivered, failed, nil
+}
+
+// PurgeDelivered removes the deliveries that succeeded longer than the retention ago,
+// dead deliveries are kept for inspection.
+func (usc *UscDeliverWebhooks) PurgeDelivered(ctx context.Context, retention time.Duration) (int64, error) {
+	return usc.webhookGateway.PurgeDelivered(ctx, usc.clock.Now().Add(-retention))
+}
+
+func (usc *UscDeliverWebhooks) send(ctx context.Context, webhook entity.Webhook, delivery *entity.WebhookDelivery) {
+	timestamp := usc.clock.Now()
+
+	headers := map[string]string{
+		"Content-Type":         dto.CloudEventContentType,
+		WebhookSignatureHeader: entity.SignWebhookPayload(webhook.Secret, timestamp, delivery.Payload),
+		WebhookTimestampHeader: fmt.Sprint(timestamp.Unix()),
+		WebhookDeliveryHeader:  delivery.ID,
+		WebhookEventHeader:     string(delivery.EventType),
+	}
+
+	statusCode, err := usc.webhookSender.Send(ctx, webhook.Url, headers, delivery.Payload)
+	if err != nil {
+		slog.WarnContext(ctx, "Webhook not delivered: "+delivery.ID, slog.Any("error", err))
+		delivery.Fail(statusCode, err.Error(), usc.clock.Now(), usc.retryPolicy)
+		return
+	}
+
+	delivery.Succeed(statusCode, usc.clock.Now())
+}
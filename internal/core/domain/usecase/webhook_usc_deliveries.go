@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindWebhookDeliveries struct {
+	webhookGateway   *gateway.WebhookGateway
+	webhookPresenter *presenter.WebhookPresenter
+}
+
+func NewUseCaseFindWebhookDeliveries(webhookGateway *gateway.WebhookGateway,
+	webhookPresenter *presenter.WebhookPresenter) *UscFindWebhookDeliveries {
+	return &UscFindWebhookDeliveries{
+		webhookGateway:   webhookGateway,
+		webhookPresenter: webhookPresenter,
+	}
+}
+
+// FindByWebhook returns the latest deliveries of the webhook, whatever their status, newest first.
+func (usc *UscFindWebhookDeliveries) FindByWebhook(ctx context.Context, query dto.WebhookDeliveryQuery) (dto.WebhookDeliveryContent, error) {
+
+	webhook, err := usc.webhookGateway.FindById(ctx, query.WebhookId)
+	if err != nil {
+		return dto.WebhookDeliveryContent{}, err
+	}
+	if webhook == nil {
+		return dto.WebhookDeliveryContent{}, ErrWebhookNotFound
+	}
+
+	deliveries, err := usc.webhookGateway.FindDeliveries(ctx, webhook.ID, "", query.Size)
+	if err != nil {
+		return dto.WebhookDeliveryContent{}, err
+	}
+
+	return usc.webhookPresenter.BuildWebhookDeliveryContentResponse(deliveries), nil
+}
+
+// FindDead returns the latest deliveries that exhausted their attempts, of every webhook, newest first.
+func (usc *UscFindWebhookDeliveries) FindDead(ctx context.Context, query dto.WebhookDeliveryQuery) (dto.WebhookDeliveryContent, error) {
+
+	deliveries, err := usc.webhookGateway.FindDeliveries(ctx, "", entity.WebhookDeliveryDead, query.Size)
+	if err != nil {
+		return dto.WebhookDeliveryContent{}, err
+	}
+
+	return usc.webhookPresenter.BuildWebhookDeliveryContentResponse(deliveries), nil
+}
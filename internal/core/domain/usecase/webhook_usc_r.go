@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type UscFindWebhook struct {
+	webhookGateway   *gateway.WebhookGateway
+	webhookPresenter *presenter.WebhookPresenter
+}
+
+func NewUseCaseFindWebhook(webhookGateway *gateway.WebhookGateway,
+	webhookPresenter *presenter.WebhookPresenter) *UscFindWebhook {
+	return &UscFindWebhook{
+		webhookGateway:   webhookGateway,
+		webhookPresenter: webhookPresenter,
+	}
+}
+
+func (usc *UscFindWebhook) FindAll(ctx context.Context) (dto.WebhookContent, error) {
+
+	webhooks, err := usc.webhookGateway.FindAll(ctx)
+	if err != nil {
+		return dto.WebhookContent{}, err
+	}
+
+	return usc.webhookPresenter.BuildWebhookContentResponse(webhooks), nil
+}
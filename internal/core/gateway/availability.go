@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+)
+
+type AvailabilityGateway struct {
+	availabilityRepository repository.IAvailabilityRepository
+}
+
+func NewAvailabilityGateway(availabilityRepository repository.IAvailabilityRepository) *AvailabilityGateway {
+	return &AvailabilityGateway{
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+func (gtw *AvailabilityGateway) AddChange(ctx context.Context, change entity.ProductAvailabilityChange) error {
+	changeModel := model.ProductAvailabilityChange(change)
+
+	return gtw.availabilityRepository.AddChange(ctx, &changeModel)
+}
+
+// FindChanges returns the last changes of the availability of the product, newest first.
+func (gtw *AvailabilityGateway) FindChanges(ctx context.Context, productId string, limit int) ([]entity.ProductAvailabilityChange, error) {
+	changeModels, err := gtw.availabilityRepository.FindChanges(ctx, productId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []entity.ProductAvailabilityChange{}
+	for _, changeModel := range *changeModels {
+		changes = append(changes, entity.ProductAvailabilityChange(changeModel))
+	}
+
+	return changes, nil
+}
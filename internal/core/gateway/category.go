@@ -1,7 +1,10 @@
 package gateway
 
 import (
+	"context"
+
 	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
 	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
 )
 
@@ -16,7 +19,76 @@ func NewCategoryGateway(categoryRepository repository.ICategoryRepository) *Cate
 	}
 }
 
-func (gw *CategoryGateway) FindById(id int) *entity.Category {
+func (gw *CategoryGateway) Create(ctx context.Context, category *entity.Category) error {
+
+	categoryModel := model.Category{
+		ID:        category.ID,
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+
+	err := gw.categoryRepository.Create(ctx, &categoryModel)
+	if err != nil {
+		return err
+	}
+
+	category.ID = categoryModel.ID
+
+	return nil
+}
+
+// FindById returns nil when the category does not exist.
+func (gw *CategoryGateway) FindById(ctx context.Context, id int) (*entity.Category, error) {
+
+	categoryModel, err := gw.categoryRepository.FindById(ctx, id)
+	if categoryModel == nil {
+		return nil, err
+	}
+
+	category := toCategoryEntity(*categoryModel)
+
+	return &category, nil
+}
+
+func (gw *CategoryGateway) FindAll(ctx context.Context) ([]entity.Category, error) {
 
-	return gw.categoryRepository.FindById(id)
+	categoryModels, err := gw.categoryRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := []entity.Category{}
+
+	for _, categoryModel := range *categoryModels {
+		categories = append(categories, toCategoryEntity(categoryModel))
+	}
+
+	return categories, nil
+}
+
+func (gw *CategoryGateway) UpdateById(ctx context.Context, category *entity.Category) error {
+
+	categoryModel := model.Category{
+		ID:        category.ID,
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+
+	return gw.categoryRepository.UpdateById(ctx, &categoryModel)
+}
+
+func (gw *CategoryGateway) DeleteById(ctx context.Context, id int) error {
+
+	return gw.categoryRepository.DeleteById(ctx, id)
+}
+
+func toCategoryEntity(categoryModel model.Category) entity.Category {
+	return entity.Category{
+		ID:        categoryModel.ID,
+		Name:      categoryModel.Name,
+		CreatedAt: categoryModel.CreatedAt,
+		UpdatedAt: categoryModel.UpdatedAt,
+	}
 }
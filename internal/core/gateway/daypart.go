@@ -0,0 +1,117 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+)
+
+type DaypartGateway struct {
+	daypartRepository repository.IDaypartRepository
+}
+
+func NewDaypartGateway(daypartRepository repository.IDaypartRepository) *DaypartGateway {
+	return &DaypartGateway{
+		daypartRepository: daypartRepository,
+	}
+}
+
+func (gtw *DaypartGateway) Create(ctx context.Context, daypart *entity.Daypart) error {
+	daypartModel := toDaypartModel(*daypart)
+
+	return gtw.daypartRepository.Create(ctx, &daypartModel)
+}
+
+func (gtw *DaypartGateway) FindAll(ctx context.Context) ([]entity.Daypart, error) {
+	daypartModels, err := gtw.daypartRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dayparts := []entity.Daypart{}
+	for _, daypartModel := range *daypartModels {
+		dayparts = append(dayparts, toDaypartEntity(daypartModel))
+	}
+
+	return dayparts, nil
+}
+
+// FindById returns nil when the daypart does not exist.
+func (gtw *DaypartGateway) FindById(ctx context.Context, id string) (*entity.Daypart, error) {
+	daypartModel, err := gtw.daypartRepository.FindById(ctx, id)
+	if daypartModel == nil {
+		return nil, err
+	}
+
+	daypart := toDaypartEntity(*daypartModel)
+
+	return &daypart, nil
+}
+
+// UpdateById reports whether the daypart existed.
+func (gtw *DaypartGateway) UpdateById(ctx context.Context, daypart *entity.Daypart) (bool, error) {
+	daypartModel := toDaypartModel(*daypart)
+
+	err := gtw.daypartRepository.UpdateById(ctx, &daypartModel)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// DeleteById reports whether the daypart existed.
+func (gtw *DaypartGateway) DeleteById(ctx context.Context, id string) (bool, error) {
+	err := gtw.daypartRepository.DeleteById(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func toDaypartEntity(daypartModel model.Daypart) entity.Daypart {
+	schedules := []entity.DaypartSchedule{}
+	for _, schedule := range daypartModel.Schedules {
+		weekdays := []time.Weekday{}
+		for _, weekday := range schedule.Weekdays {
+			weekdays = append(weekdays, time.Weekday(weekday))
+		}
+		schedules = append(schedules, entity.DaypartSchedule{Weekdays: weekdays, Start: schedule.Start, End: schedule.End})
+	}
+
+	return entity.Daypart{
+		ID:          daypartModel.ID,
+		Name:        daypartModel.Name,
+		Schedules:   schedules,
+		ProductIds:  append([]string{}, daypartModel.ProductIds...),
+		CategoryIds: append([]int{}, daypartModel.CategoryIds...),
+		CreatedAt:   daypartModel.CreatedAt,
+		UpdatedAt:   daypartModel.UpdatedAt,
+	}
+}
+
+func toDaypartModel(daypart entity.Daypart) model.Daypart {
+	schedules := model.DaypartSchedules{}
+	for _, schedule := range daypart.Schedules {
+		weekdays := []int{}
+		for _, weekday := range schedule.Weekdays {
+			weekdays = append(weekdays, int(weekday))
+		}
+		schedules = append(schedules, model.DaypartSchedule{Weekdays: weekdays, Start: schedule.Start, End: schedule.End})
+	}
+
+	return model.Daypart{
+		ID:          daypart.ID,
+		Name:        daypart.Name,
+		Schedules:   schedules,
+		ProductIds:  append(model.DaypartProducts{}, daypart.ProductIds...),
+		CategoryIds: append(model.DaypartCategories{}, daypart.CategoryIds...),
+		CreatedAt:   daypart.CreatedAt,
+		UpdatedAt:   daypart.UpdatedAt,
+	}
+}
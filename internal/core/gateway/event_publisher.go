@@ -0,0 +1,13 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+// IEventPublisher delivers the events to the message broker, see the adapters in infra/publisher.
+// Delivery is at least once, consumers must ignore the event ids they have already seen.
+type IEventPublisher interface {
+	Publish(ctx context.Context, event dto.CloudEvent) error
+}
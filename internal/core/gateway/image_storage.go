@@ -0,0 +1,11 @@
+package gateway
+
+import "context"
+
+// IImageStorage keeps the product images in an object storage, see the adapters in infra/storage.
+// URL is where the clients download the object saved under key.
+type IImageStorage interface {
+	Put(ctx context.Context, key string, contentType string, content []byte) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
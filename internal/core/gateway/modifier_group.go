@@ -0,0 +1,121 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+)
+
+type ModifierGroupGateway struct {
+	modifierGroupRepository repository.IModifierGroupRepository
+}
+
+func NewModifierGroupGateway(modifierGroupRepository repository.IModifierGroupRepository) *ModifierGroupGateway {
+	return &ModifierGroupGateway{
+		modifierGroupRepository: modifierGroupRepository,
+	}
+}
+
+func (gtw *ModifierGroupGateway) Create(ctx context.Context, group *entity.ModifierGroup) error {
+	groupModel := toModifierGroupModel(*group)
+
+	return gtw.modifierGroupRepository.Create(ctx, &groupModel)
+}
+
+func (gtw *ModifierGroupGateway) FindAll(ctx context.Context) ([]entity.ModifierGroup, error) {
+	groupModels, err := gtw.modifierGroupRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModifierGroupEntities(*groupModels), nil
+}
+
+// FindById returns nil when the group does not exist.
+func (gtw *ModifierGroupGateway) FindById(ctx context.Context, id string) (*entity.ModifierGroup, error) {
+	groupModel, err := gtw.modifierGroupRepository.FindById(ctx, id)
+	if groupModel == nil {
+		return nil, err
+	}
+
+	group := toModifierGroupEntity(*groupModel)
+
+	return &group, nil
+}
+
+// UpdateById reports whether the group existed.
+func (gtw *ModifierGroupGateway) UpdateById(ctx context.Context, group *entity.ModifierGroup) (bool, error) {
+	groupModel := toModifierGroupModel(*group)
+
+	err := gtw.modifierGroupRepository.UpdateById(ctx, &groupModel)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// DeleteById reports whether the group existed.
+func (gtw *ModifierGroupGateway) DeleteById(ctx context.Context, id string) (bool, error) {
+	err := gtw.modifierGroupRepository.DeleteById(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func toModifierGroupEntity(groupModel model.ModifierGroup) entity.ModifierGroup {
+	options := []entity.ModifierOption{}
+	for _, option := range groupModel.Options {
+		options = append(options, entity.ModifierOption(option))
+	}
+
+	return entity.ModifierGroup{
+		ID:            groupModel.ID,
+		Name:          groupModel.Name,
+		Required:      groupModel.Required,
+		MinSelections: groupModel.MinSelections,
+		MaxSelections: groupModel.MaxSelections,
+		Options:       options,
+		CreatedAt:     groupModel.CreatedAt,
+		UpdatedAt:     groupModel.UpdatedAt,
+	}
+}
+
+func toModifierGroupModel(group entity.ModifierGroup) model.ModifierGroup {
+	options := model.ModifierOptions{}
+	for _, option := range group.Options {
+		options = append(options, model.ModifierOption(option))
+	}
+
+	return model.ModifierGroup{
+		ID:            group.ID,
+		Name:          group.Name,
+		Required:      group.Required,
+		MinSelections: group.MinSelections,
+		MaxSelections: group.MaxSelections,
+		Options:       options,
+		CreatedAt:     group.CreatedAt,
+		UpdatedAt:     group.UpdatedAt,
+	}
+}
+
+func toModifierGroupEntities(groupModels []model.ModifierGroup) []entity.ModifierGroup {
+	groups := []entity.ModifierGroup{}
+	for _, groupModel := range groupModels {
+		groups = append(groups, toModifierGroupEntity(groupModel))
+	}
+	return groups
+}
+
+func toModifierGroupModels(groups []entity.ModifierGroup) model.ModifierGroups {
+	groupModels := model.ModifierGroups{}
+	for _, group := range groups {
+		groupModels = append(groupModels, toModifierGroupModel(group))
+	}
+	return groupModels
+}
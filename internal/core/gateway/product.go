@@ -2,34 +2,54 @@ package gateway
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
 	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 )
 
+// ErrVersionConflict rejects a write expecting a version the product no longer has.
+var ErrVersionConflict = repository.ErrVersionConflict
+
+// ProductGateway reads the products along with their stock, except Stream and BulkWrite which
+// leave it out. Without a stock repository, as in the purge job, no stock is tracked.
+// The clock stamps the changes and tells which products are unavailable.
 type ProductGateway struct {
 	productRepository  repository.IProductRepository
 	categoryRepository repository.ICategoryRepository
+	stockRepository    repository.IStockRepository
+	clock              clock.Clock
 }
 
-func NewProductGateway(productRepository repository.IProductRepository) *ProductGateway {
+func NewProductGateway(productRepository repository.IProductRepository, stockRepository repository.IStockRepository,
+	clock clock.Clock) *ProductGateway {
 	return &ProductGateway{
 		productRepository: productRepository,
+		stockRepository:   stockRepository,
+		clock:             clock,
 	}
 }
 
 func (gtw *ProductGateway) Create(ctx context.Context, product *entity.Product) error {
 
 	productModel := model.Product{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		CategoryId:  product.CategoryId,
-		Amount:      product.Amount,
-		CreatedAt:   product.CreatedAt,
-		UpdatedAt:   product.UpdatedAt,
+		ID:              product.ID,
+		Name:            product.Name,
+		Description:     product.Description,
+		CategoryId:      product.CategoryId,
+		Amount:          product.Amount,
+		Images:          toProductImageModels(product.Images),
+		VariantsEnabled: product.VariantsEnabled,
+		Variants:        toProductVariantModels(product.Variants),
+		ModifierGroups:  toModifierGroupModels(product.ModifierGroups),
+		Components:      toBundleComponentModels(product.Components),
+		Version:         product.Version,
+		CreatedAt:       product.CreatedAt,
+		UpdatedAt:       product.UpdatedAt,
 	}
 
 	err := gtw.productRepository.Create(ctx, &productModel)
@@ -41,8 +61,13 @@ func (gtw *ProductGateway) Create(ctx context.Context, product *entity.Product)
 	return nil
 }
 
-func (gtw *ProductGateway) FindByCategory(ctx context.Context, id int) ([]entity.Product, error) {
-	productModels, err := gtw.productRepository.FindByCategory(ctx, id)
+func (gtw *ProductGateway) Find(ctx context.Context, query dto.ProductQuery) ([]entity.Product, error) {
+	productQuery, err := gtw.toProductQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	productModels, err := gtw.productRepository.Find(ctx, productQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -50,105 +75,567 @@ func (gtw *ProductGateway) FindByCategory(ctx context.Context, id int) ([]entity
 	products := []entity.Product{}
 
 	for _, productModel := range *productModels {
-		product := entity.Product{
-			ID:          productModel.ID,
-			Name:        productModel.Name,
-			Description: productModel.Description,
-			Amount:      productModel.Amount,
-			CategoryId:  productModel.CategoryId,
-			CreatedAt:   productModel.CreatedAt,
-			UpdatedAt:   productModel.UpdatedAt,
-		}
-		products = append(products, product)
+		products = append(products, gtw.toProductEntity(productModel))
+	}
+
+	if err := gtw.loadStocks(ctx, pointers(products)...); err != nil {
+		return nil, err
 	}
 
 	return products, nil
 }
 
-func (gtw *ProductGateway) DeleteById(ctx context.Context, id string) (string, error) {
+// Stream hands every product matching the query to fn, ignoring the page.
+func (gtw *ProductGateway) Stream(ctx context.Context, query dto.ProductQuery, fn func(product entity.Product) error) error {
+	productQuery, err := gtw.toProductQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	return gtw.productRepository.Stream(ctx, productQuery, func(productModel model.Product) error {
+		return fn(gtw.toProductEntity(productModel))
+	})
+}
 
-	_, err := gtw.productRepository.DeleteById(ctx, id)
+func (gtw *ProductGateway) Count(ctx context.Context, query dto.ProductQuery) (int64, error) {
+	productQuery, err := gtw.toProductQuery(ctx, query)
+	if err != nil {
+		return 0, err
+	}
 
-	return id, err
+	return gtw.productRepository.Count(ctx, productQuery)
 }
 
-func (gtw *ProductGateway) UpdateById(ctx context.Context, command dto.UpdateProduct) (entity.Product, error) {
+// Search ranks the products matching the text, leaving out the ones that cannot be sold when
+// asked, before the limit applies.
+func (gtw *ProductGateway) Search(ctx context.Context, search dto.ProductSearch) ([]entity.ProductMatch, error) {
+	productQuery, err := gtw.toProductQuery(ctx, dto.ProductQuery{HideUnavailable: search.HideUnavailable})
+	if err != nil {
+		return nil, err
+	}
 
-	old_product, errProduct := gtw.productRepository.FindOne(ctx, command.ProductId)
+	matchModels, err := gtw.productRepository.Search(ctx, search.Query, productQuery, search.Limit)
+	if err != nil {
+		return nil, err
+	}
 
-	if old_product == nil {
-		return entity.Product{}, errProduct
+	matches := []entity.ProductMatch{}
+
+	for _, matchModel := range *matchModels {
+		matches = append(matches, entity.ProductMatch{
+			Product: gtw.toProductEntity(matchModel.Product),
+			Score:   matchModel.Score,
+		})
+	}
+
+	products := make([]*entity.Product, len(matches))
+	for i := range matches {
+		products[i] = &matches[i].Product
+	}
+	if err := gtw.loadStocks(ctx, products...); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// DeleteById moves the product to the trash, it stays restorable until purged.
+// It returns the trashed product, or nil when there is no such product.
+func (gtw *ProductGateway) DeleteById(ctx context.Context, command dto.DeleteProduct) (*entity.Product, error) {
+
+	productModel, err := gtw.productRepository.DeleteById(ctx, command.ProductId, command.Version, gtw.clock.Now().UTC())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if productModel == nil {
+		return nil, err
+	}
+
+	product := gtw.toProductEntity(*productModel)
+
+	return &product, nil
+}
+
+// UpdateById replaces every writable attribute of the product, keeping only its creation date.
+// It returns the product before and after the change, or nils when there is no such product.
+// The write is rejected with ErrVersionConflict when the product is no longer at
+// command.Version or changes between the read and the write.
+func (gtw *ProductGateway) UpdateById(ctx context.Context, command dto.UpdateProduct) (*entity.Product, *entity.Product, error) {
+
+	old_product, errProduct := gtw.productRepository.FindOne(ctx, command.ProductId)
+	if errors.Is(errProduct, repository.ErrNotFound) {
+		return nil, nil, nil
 	}
 
 	if errProduct != nil {
-		return entity.Product{}, errProduct
+		return nil, nil, errProduct
 	}
 
-	buildUpdateProduct(&command, old_product)
+	if old_product == nil {
+		return nil, nil, nil
+	}
 
-	new_product := model.Product{
-		ID:          command.ProductId,
-		Name:        command.Name,
-		Description: command.Description,
-		CategoryId:  command.CategoryId,
-		Amount:      command.Amount,
-		CreatedAt:   command.CreatedAt,
+	if command.Version != 0 && command.Version != legacyVersion(old_product.Version) {
+		return nil, nil, repository.ErrVersionConflict
 	}
 
+	new_product := gtw.replacement(*old_product, command)
+
 	err := gtw.productRepository.UpdateById(ctx, &new_product)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil, nil
+	}
 	if err != nil {
-		return entity.Product{}, err
+		return nil, nil, err
 	}
 
-	output := entity.Product{
-		ID:          new_product.ID,
-		Name:        new_product.Name,
-		Description: new_product.Description,
-		CategoryId:  new_product.CategoryId,
-		Amount:      new_product.Amount,
-		CreatedAt:   new_product.CreatedAt,
-		UpdatedAt:   new_product.UpdatedAt,
+	previous := gtw.toProductEntity(*old_product)
+	product := gtw.toProductEntity(new_product)
+
+	if err := gtw.loadStocks(ctx, &previous, &product); err != nil {
+		return nil, nil, err
 	}
 
-	return output, nil
+	return &previous, &product, nil
 }
 
-func buildUpdateProduct(command *dto.UpdateProduct, old_product *model.Product) error {
+// ProductWriteResult is the outcome of one write of a bulk: the product before and after it,
+// nils when there is no such product, or the error that rejected it.
+type ProductWriteResult struct {
+	Previous *entity.Product
+	Product  *entity.Product
+	Err      error
+}
+
+// BulkWrite applies the writes in a single repository bulk write, each following the rules of
+// UpdateById or DeleteById. A write rejected does not stop the others, only a database failure does.
+func (gtw *ProductGateway) BulkWrite(ctx context.Context, commands []dto.ProductWrite) ([]ProductWriteResult, error) {
+
+	results := make([]ProductWriteResult, len(commands))
+	writes := []model.ProductWrite{}
+	previous := []model.Product{}
+	positions := []int{}
 
-	if command.Name == "" {
-		command.Name = old_product.Name
+	for i, command := range commands {
+		old_product, err := gtw.productRepository.FindOne(ctx, command.ProductId)
+		if errors.Is(err, repository.ErrNotFound) || (err == nil && old_product == nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if command.Version != 0 && command.Version != legacyVersion(old_product.Version) {
+			results[i].Err = repository.ErrVersionConflict
+			continue
+		}
+
+		write := model.ProductWrite{Product: *old_product, Delete: command.Delete}
+		write.Product.Version = legacyVersion(old_product.Version)
+		write.Product.UpdatedAt = gtw.clock.Now().UTC()
+		if !command.Delete {
+			write.Product = gtw.replacement(*old_product, command.UpdateProduct)
+		}
+
+		writes = append(writes, write)
+		previous = append(previous, *old_product)
+		positions = append(positions, i)
 	}
-	if command.Description == "" {
-		command.Description = old_product.Description
+
+	errs, err := gtw.productRepository.BulkWrite(ctx, writes)
+	if err != nil {
+		return nil, err
 	}
-	if command.CategoryId == 0 {
-		command.CategoryId = old_product.CategoryId
+
+	for j, i := range positions {
+		if errors.Is(errs[j], repository.ErrNotFound) {
+			continue
+		}
+		if errs[j] != nil {
+			results[i].Err = errs[j]
+			continue
+		}
+
+		before := gtw.toProductEntity(previous[j])
+		after := gtw.toProductEntity(writes[j].Product)
+		results[i].Previous = &before
+		results[i].Product = &after
 	}
-	if command.Amount == 0 {
-		command.Amount = old_product.Amount
+
+	return results, nil
+}
+
+// replacement is the product command turns old into, at the version expected to be replaced.
+func (gtw *ProductGateway) replacement(old model.Product, command dto.UpdateProduct) model.Product {
+	return model.Product{
+		ID:              command.ProductId,
+		Name:            command.Name,
+		Description:     command.Description,
+		CategoryId:      command.CategoryId,
+		Amount:          command.Amount,
+		Images:          old.Images,
+		VariantsEnabled: old.VariantsEnabled,
+		Variants:        old.Variants,
+		ModifierGroups:  old.ModifierGroups,
+		Components:      old.Components,
+		Version:         legacyVersion(old.Version),
+		CreatedAt:       old.CreatedAt,
+		UpdatedAt:       gtw.clock.Now().UTC(),
+
+		// the availability is changed on its own
+		UnavailableSince:  old.UnavailableSince,
+		UnavailableUntil:  old.UnavailableUntil,
+		UnavailableReason: old.UnavailableReason,
+		UnavailableBy:     old.UnavailableBy,
 	}
-	command.CreatedAt = old_product.CreatedAt
+}
 
-	return nil
+// ReplaceImages saves the images of the product, leaving the other attributes as they are.
+// Like UpdateById, it returns the product before and after the change, nils when there is no such
+// product, and ErrVersionConflict when the product is no longer at version.
+func (gtw *ProductGateway) ReplaceImages(ctx context.Context, id string, version int64, images []entity.ProductImage) (*entity.Product, *entity.Product, error) {
+	return gtw.change(ctx, id, version, func(product *model.Product) {
+		product.Images = toProductImageModels(images)
+	})
+}
+
+// ReplaceVariants saves the variants of the product the way ReplaceImages saves the images.
+func (gtw *ProductGateway) ReplaceVariants(ctx context.Context, id string, version int64, enabled bool, variants []entity.ProductVariant) (*entity.Product, *entity.Product, error) {
+	return gtw.change(ctx, id, version, func(product *model.Product) {
+		product.VariantsEnabled = enabled
+		product.Variants = toProductVariantModels(variants)
+	})
+}
+
+// ReplaceModifierGroups saves the copies of the groups attached to the product the way ReplaceImages
+// saves the images.
+func (gtw *ProductGateway) ReplaceModifierGroups(ctx context.Context, id string, version int64, groups []entity.ModifierGroup) (*entity.Product, *entity.Product, error) {
+	return gtw.change(ctx, id, version, func(product *model.Product) {
+		product.ModifierGroups = toModifierGroupModels(groups)
+	})
+}
+
+// ReplaceComponents saves the components of the bundle the way ReplaceImages saves the images,
+// an empty list makes it a single product again.
+func (gtw *ProductGateway) ReplaceComponents(ctx context.Context, id string, version int64, components []entity.BundleComponent) (*entity.Product, *entity.Product, error) {
+	return gtw.change(ctx, id, version, func(product *model.Product) {
+		product.Components = toBundleComponentModels(components)
+	})
+}
 
+// SetAvailability marks the product unavailable, or available again when unavailability is nil,
+// the way ReplaceImages saves the images.
+func (gtw *ProductGateway) SetAvailability(ctx context.Context, id string, version int64, unavailability *entity.ProductUnavailability) (*entity.Product, *entity.Product, error) {
+	return gtw.change(ctx, id, version, func(product *model.Product) {
+		product.UnavailableSince = nil
+		product.UnavailableUntil = nil
+		product.UnavailableReason = ""
+		product.UnavailableBy = ""
+		if unavailability != nil {
+			since := unavailability.Since
+			product.UnavailableSince = &since
+			product.UnavailableUntil = unavailability.RestoreAt
+			product.UnavailableReason = unavailability.Reason
+			product.UnavailableBy = unavailability.ChangedBy
+		}
+	})
 }
 
+// change applies fn to the product while it is at version, zero skips the check.
+func (gtw *ProductGateway) change(ctx context.Context, id string, version int64, fn func(product *model.Product)) (*entity.Product, *entity.Product, error) {
+
+	old_product, err := gtw.productRepository.FindOne(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) || (err == nil && old_product == nil) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if version != 0 && version != legacyVersion(old_product.Version) {
+		return nil, nil, repository.ErrVersionConflict
+	}
+
+	new_product := *old_product
+	new_product.Version = legacyVersion(old_product.Version)
+	fn(&new_product)
+	new_product.UpdatedAt = gtw.clock.Now().UTC()
+
+	err = gtw.productRepository.UpdateById(ctx, &new_product)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previous := gtw.toProductEntity(*old_product)
+	product := gtw.toProductEntity(new_product)
+
+	if err := gtw.loadStocks(ctx, &previous, &product); err != nil {
+		return nil, nil, err
+	}
+
+	return &previous, &product, nil
+}
+
+// Restore takes the product out of the trash, returning nil when it is not there.
+func (gtw *ProductGateway) Restore(ctx context.Context, id string) (*entity.Product, error) {
+
+	productModel, err := gtw.productRepository.Restore(ctx, id, gtw.clock.Now().UTC())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if productModel == nil {
+		return nil, err
+	}
+
+	product := gtw.toProductEntity(*productModel)
+
+	if err := gtw.loadStocks(ctx, &product); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// PurgeDeleted permanently removes the products deleted before the given instant.
+func (gtw *ProductGateway) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	return gtw.productRepository.PurgeDeleted(ctx, before)
+}
+
+// FindOne returns nil when there is no such product.
 func (gtw *ProductGateway) FindOne(ctx context.Context, id string) (*entity.Product, error) {
 
 	productModel, err := gtw.productRepository.FindOne(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
 	if productModel == nil {
 		return nil, err
 	}
 
-	product := entity.Product{
-		ID:          productModel.ID,
-		Name:        productModel.Name,
-		Description: productModel.Description,
-		Amount:      productModel.Amount,
-		CategoryId:  productModel.CategoryId,
-		CreatedAt:   productModel.CreatedAt,
-		UpdatedAt:   productModel.UpdatedAt,
+	product := gtw.toProductEntity(*productModel)
+
+	if err := gtw.loadStocks(ctx, &product); err != nil {
+		return nil, err
 	}
 
 	return &product, nil
 }
+
+// loadStocks sets the stock of the products whose stock is tracked.
+func (gtw *ProductGateway) loadStocks(ctx context.Context, products ...*entity.Product) error {
+	if gtw.stockRepository == nil || len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.ID)
+	}
+
+	stockModels, err := gtw.stockRepository.FindByProductIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	stocks := map[string]entity.ProductStock{}
+	for _, stockModel := range *stockModels {
+		stocks[stockModel.ProductId] = toProductStockEntity(stockModel)
+	}
+
+	for _, product := range products {
+		if stock, ok := stocks[product.ID]; ok {
+			product.Stock = &stock
+		}
+	}
+
+	return nil
+}
+
+func pointers(products []entity.Product) []*entity.Product {
+	result := make([]*entity.Product, len(products))
+	for i := range products {
+		result[i] = &products[i]
+	}
+	return result
+}
+
+func (gtw *ProductGateway) toProductEntity(productModel model.Product) entity.Product {
+	return entity.Product{
+		ID:              productModel.ID,
+		Name:            productModel.Name,
+		Description:     productModel.Description,
+		Amount:          productModel.Amount,
+		CategoryId:      productModel.CategoryId,
+		Version:         legacyVersion(productModel.Version),
+		CreatedAt:       productModel.CreatedAt,
+		UpdatedAt:       productModel.UpdatedAt,
+		DeletedAt:       productModel.DeletedAt,
+		Images:          toProductImageEntities(productModel.Images),
+		VariantsEnabled: productModel.VariantsEnabled,
+		Variants:        toProductVariantEntities(productModel.Variants),
+		ModifierGroups:  toModifierGroupEntities(productModel.ModifierGroups),
+		Components:      toBundleComponentEntities(productModel.Components),
+		Unavailability:  toProductUnavailabilityEntity(productModel, gtw.clock.Now().UTC()),
+	}
+}
+
+// legacyVersion reads the products written before versioning, stored at version zero, as version
+// one, so their ETag can be sent back in If-Match.
+func legacyVersion(version int64) int64 {
+	return max(version, 1)
+}
+
+// toProductUnavailabilityEntity returns nil unless the product is still marked unavailable at now,
+// so a product whose restore time has passed reads as available before anyone clears it.
+func toProductUnavailabilityEntity(productModel model.Product, now time.Time) *entity.ProductUnavailability {
+	if productModel.UnavailableSince == nil {
+		return nil
+	}
+
+	unavailability := entity.ProductUnavailability{
+		Reason:    productModel.UnavailableReason,
+		Since:     *productModel.UnavailableSince,
+		RestoreAt: productModel.UnavailableUntil,
+		ChangedBy: productModel.UnavailableBy,
+	}
+	if unavailability.Over(now) {
+		return nil
+	}
+
+	return &unavailability
+}
+
+// toProductQuery hides the products marked unavailable when asked, along with the sold out ones.
+func (gtw *ProductGateway) toProductQuery(ctx context.Context, query dto.ProductQuery) (model.ProductQuery, error) {
+	productQuery := toProductQuery(query)
+
+	now := gtw.clock.Now().UTC()
+	if query.Unavailable {
+		productQuery.UnavailableAt = &now
+	}
+	if query.HideUnavailable {
+		productQuery.AvailableAt = &now
+
+		if gtw.stockRepository != nil {
+			stockModels, err := gtw.stockRepository.FindSoldOut(ctx)
+			if err != nil {
+				return model.ProductQuery{}, err
+			}
+			for _, stockModel := range *stockModels {
+				productQuery.ExcludeIds = append(productQuery.ExcludeIds, stockModel.ProductId)
+			}
+		}
+	}
+
+	return productQuery, nil
+}
+
+func toProductQuery(query dto.ProductQuery) model.ProductQuery {
+	return model.ProductQuery{
+		CategoryIds:  query.CategoryIds,
+		MinAmount:    query.MinAmount,
+		MaxAmount:    query.MaxAmount,
+		CreatedAfter: query.CreatedAfter,
+		UpdatedAfter: query.UpdatedAfter,
+		NamePrefix:   query.Name,
+		Page:         query.Page,
+		Size:         query.Size,
+		Sort:         query.Sort,
+		Descending:   query.Direction == "desc",
+		Trashed:      query.Trashed,
+	}
+}
+
+func toProductImageEntities(imageModels model.ProductImages) []entity.ProductImage {
+	images := []entity.ProductImage{}
+
+	for _, imageModel := range imageModels {
+		thumbnails := []entity.ProductThumbnail{}
+		for _, thumbnail := range imageModel.Thumbnails {
+			thumbnails = append(thumbnails, entity.ProductThumbnail(thumbnail))
+		}
+
+		images = append(images, entity.ProductImage{
+			ID:          imageModel.ID,
+			ContentType: imageModel.ContentType,
+			Width:       imageModel.Width,
+			Height:      imageModel.Height,
+			Size:        imageModel.Size,
+			Key:         imageModel.Key,
+			Url:         imageModel.Url,
+			Thumbnails:  thumbnails,
+			CreatedAt:   imageModel.CreatedAt,
+		})
+	}
+
+	return images
+}
+
+func toProductImageModels(images []entity.ProductImage) model.ProductImages {
+	imageModels := model.ProductImages{}
+
+	for _, image := range images {
+		thumbnails := []model.ProductThumbnail{}
+		for _, thumbnail := range image.Thumbnails {
+			thumbnails = append(thumbnails, model.ProductThumbnail(thumbnail))
+		}
+
+		imageModels = append(imageModels, model.ProductImage{
+			ID:          image.ID,
+			ContentType: image.ContentType,
+			Width:       image.Width,
+			Height:      image.Height,
+			Size:        image.Size,
+			Key:         image.Key,
+			Url:         image.Url,
+			Thumbnails:  thumbnails,
+			CreatedAt:   image.CreatedAt,
+		})
+	}
+
+	return imageModels
+}
+
+func toProductVariantEntities(variantModels model.ProductVariants) []entity.ProductVariant {
+	variants := []entity.ProductVariant{}
+	for _, variantModel := range variantModels {
+		variants = append(variants, entity.ProductVariant(variantModel))
+	}
+	return variants
+}
+
+func toProductVariantModels(variants []entity.ProductVariant) model.ProductVariants {
+	variantModels := model.ProductVariants{}
+	for _, variant := range variants {
+		variantModels = append(variantModels, model.ProductVariant(variant))
+	}
+	return variantModels
+}
+
+func toBundleComponentEntities(componentModels model.BundleComponents) []entity.BundleComponent {
+	components := []entity.BundleComponent{}
+	for _, componentModel := range componentModels {
+		components = append(components, entity.BundleComponent(componentModel))
+	}
+	return components
+}
+
+func toBundleComponentModels(components []entity.BundleComponent) model.BundleComponents {
+	componentModels := model.BundleComponents{}
+	for _, component := range components {
+		componentModels = append(componentModels, model.BundleComponent(component))
+	}
+	return componentModels
+}
+
+func toProductStockEntity(stockModel model.Stock) entity.ProductStock {
+	return entity.ProductStock{
+		OnHand:            stockModel.OnHand,
+		Reserved:          stockModel.Reserved,
+		LowStockThreshold: stockModel.LowStockThreshold,
+		UpdatedAt:         stockModel.UpdatedAt,
+	}
+}
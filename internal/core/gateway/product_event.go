@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+)
+
+// ProductEventGateway saves the product events in the outbox, in the same transaction
+// as the product change, for the relay to publish them.
+// Without a transactor and an outbox, as in unit tests, changes run directly and events are dropped.
+type ProductEventGateway struct {
+	transactor       repository.ITransactor
+	outboxRepository repository.IOutboxRepository
+	productPresenter *presenter.ProductPresenter
+}
+
+func NewProductEventGateway(transactor repository.ITransactor, outboxRepository repository.IOutboxRepository) *ProductEventGateway {
+	return &ProductEventGateway{
+		transactor:       transactor,
+		outboxRepository: outboxRepository,
+		productPresenter: presenter.NewProductPresenter(),
+	}
+}
+
+// Transaction runs fn atomically, fn must use the context it receives and may run more than once.
+func (gtw *ProductEventGateway) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if gtw.transactor == nil {
+		return fn(ctx)
+	}
+	return gtw.transactor.Transaction(ctx, fn)
+}
+
+// RollsBack tells whether a failed Transaction undoes the writes it made.
+func (gtw *ProductEventGateway) RollsBack() bool {
+	return gtw.transactor != nil && gtw.transactor.RollsBack()
+}
+
+func (gtw *ProductEventGateway) Record(ctx context.Context, events []entity.ProductEvent) error {
+	if gtw.outboxRepository == nil {
+		return nil
+	}
+
+	eventModels := []model.OutboxEvent{}
+
+	for _, event := range events {
+		payload, err := json.Marshal(gtw.productPresenter.BuildProductEventResponse(event))
+		if err != nil {
+			return err
+		}
+
+		eventModels = append(eventModels, model.OutboxEvent{
+			ID:          event.ID,
+			Type:        string(event.Type),
+			AggregateId: event.Product.ID,
+			Payload:     string(payload),
+			OccurredAt:  event.OccurredAt,
+		})
+	}
+
+	return gtw.outboxRepository.Add(ctx, eventModels...)
+}
+
+// FindPending returns the events not published yet, oldest first.
+func (gtw *ProductEventGateway) FindPending(ctx context.Context, limit int) ([]entity.OutboxEvent, error) {
+	eventModels, err := gtw.outboxRepository.FindPending(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []entity.OutboxEvent{}
+
+	for _, eventModel := range *eventModels {
+		events = append(events, entity.OutboxEvent{
+			ID:          eventModel.ID,
+			Type:        entity.ProductEventType(eventModel.Type),
+			AggregateId: eventModel.AggregateId,
+			Payload:     []byte(eventModel.Payload),
+			OccurredAt:  eventModel.OccurredAt,
+			Attempts:    eventModel.Attempts,
+		})
+	}
+
+	return events, nil
+}
+
+func (gtw *ProductEventGateway) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	return gtw.outboxRepository.MarkPublished(ctx, id, at)
+}
+
+// MarkFailed keeps the event pending, recording why it could not be published.
+func (gtw *ProductEventGateway) MarkFailed(ctx context.Context, id string, reason string) error {
+	return gtw.outboxRepository.MarkFailed(ctx, id, reason)
+}
+
+// PurgePublished removes the events published before the given instant.
+func (gtw *ProductEventGateway) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	return gtw.outboxRepository.PurgePublished(ctx, before)
+}
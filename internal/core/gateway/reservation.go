@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+)
+
+type ReservationGateway struct {
+	reservationRepository repository.IReservationRepository
+}
+
+func NewReservationGateway(reservationRepository repository.IReservationRepository) *ReservationGateway {
+	return &ReservationGateway{
+		reservationRepository: reservationRepository,
+	}
+}
+
+func (gtw *ReservationGateway) Create(ctx context.Context, reservation entity.Reservation) error {
+	reservationModel := toReservationModel(reservation)
+
+	return gtw.reservationRepository.Create(ctx, &reservationModel)
+}
+
+// FindById returns nil when the reservation does not exist.
+func (gtw *ReservationGateway) FindById(ctx context.Context, id string) (*entity.Reservation, error) {
+	reservationModel, err := gtw.reservationRepository.FindById(ctx, id)
+	if reservationModel == nil {
+		return nil, err
+	}
+
+	reservation := toReservationEntity(*reservationModel)
+
+	return &reservation, nil
+}
+
+// UpdateStatus moves the reservation from status to another, reporting whether it was in the from status.
+func (gtw *ReservationGateway) UpdateStatus(ctx context.Context, id string, from entity.ReservationStatus, to entity.ReservationStatus, now time.Time) (bool, error) {
+	err := gtw.reservationRepository.UpdateStatus(ctx, id, string(from), string(to), now)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// FindExpired returns the pending reservations expired at the given instant, the oldest first.
+func (gtw *ReservationGateway) FindExpired(ctx context.Context, now time.Time, limit int) ([]entity.Reservation, error) {
+	reservationModels, err := gtw.reservationRepository.FindExpired(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := []entity.Reservation{}
+	for _, reservationModel := range *reservationModels {
+		reservations = append(reservations, toReservationEntity(reservationModel))
+	}
+
+	return reservations, nil
+}
+
+func toReservationModel(reservation entity.Reservation) model.Reservation {
+	items := model.ReservationItems{}
+	for _, item := range reservation.Items {
+		items = append(items, model.ReservationItem{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			Held:      item.Held,
+		})
+	}
+
+	return model.Reservation{
+		ID:        reservation.ID,
+		Items:     items,
+		Status:    string(reservation.Status),
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
+
+func toReservationEntity(reservationModel model.Reservation) entity.Reservation {
+	items := []entity.ReservationItem{}
+	for _, item := range reservationModel.Items {
+		items = append(items, entity.ReservationItem{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			Held:      item.Held,
+		})
+	}
+
+	return entity.Reservation{
+		ID:        reservationModel.ID,
+		Items:     items,
+		Status:    entity.ReservationStatus(reservationModel.Status),
+		ExpiresAt: reservationModel.ExpiresAt,
+		CreatedAt: reservationModel.CreatedAt,
+		UpdatedAt: reservationModel.UpdatedAt,
+	}
+}
@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+// StockGateway changes the stock of the products. The changes returning the stock return nil when
+// the stock of the product is not tracked, and repository.ErrInsufficientStock when they would
+// leave fewer units on hand than reserved. The clock stamps the changes.
+type StockGateway struct {
+	stockRepository repository.IStockRepository
+	clock           clock.Clock
+}
+
+func NewStockGateway(stockRepository repository.IStockRepository, clock clock.Clock) *StockGateway {
+	return &StockGateway{
+		stockRepository: stockRepository,
+		clock:           clock,
+	}
+}
+
+// Save sets the units on hand and the threshold of the stock, which starts being tracked
+// with nothing reserved when it was not.
+func (gtw *StockGateway) Save(ctx context.Context, productId string, onHand int, lowStockThreshold int) (*entity.ProductStock, error) {
+	stockModel := model.Stock{
+		ProductId:         productId,
+		OnHand:            onHand,
+		LowStockThreshold: lowStockThreshold,
+		UpdatedAt:         gtw.clock.Now().UTC(),
+	}
+
+	if err := gtw.stockRepository.Save(ctx, &stockModel); err != nil {
+		return nil, err
+	}
+
+	stock := toProductStockEntity(stockModel)
+
+	return &stock, nil
+}
+
+// DeleteByProductId stops tracking the stock, reporting whether it was tracked.
+func (gtw *StockGateway) DeleteByProductId(ctx context.Context, productId string) (bool, error) {
+	err := gtw.stockRepository.DeleteByProductId(ctx, productId)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// FindSoldOut returns the ids of the products without units left for sale.
+func (gtw *StockGateway) FindSoldOut(ctx context.Context) (map[string]bool, error) {
+	stockModels, err := gtw.stockRepository.FindSoldOut(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	soldOut := map[string]bool{}
+	for _, stockModel := range *stockModels {
+		soldOut[stockModel.ProductId] = true
+	}
+
+	return soldOut, nil
+}
+
+// Adjust adds delta, negative to remove units, to the units on hand.
+func (gtw *StockGateway) Adjust(ctx context.Context, productId string, delta int) (*entity.ProductStock, error) {
+	return toChangedStock(gtw.stockRepository.Adjust(ctx, productId, delta, gtw.clock.Now().UTC()))
+}
+
+// Reserve adds quantity, negative to release units, to the units reserved.
+func (gtw *StockGateway) Reserve(ctx context.Context, productId string, quantity int) (*entity.ProductStock, error) {
+	return toChangedStock(gtw.stockRepository.Reserve(ctx, productId, quantity, gtw.clock.Now().UTC()))
+}
+
+// Release gives quantity reserved units back, reporting false when the stock of the product is not
+// tracked or the units were already released.
+func (gtw *StockGateway) Release(ctx context.Context, productId string, quantity int) (bool, error) {
+	stock, err := gtw.Reserve(ctx, productId, -quantity)
+	if errors.Is(err, repository.ErrInsufficientStock) {
+		return false, nil
+	}
+
+	return stock != nil, err
+}
+
+// Consume removes quantity reserved units from the units on hand, once they are sold.
+func (gtw *StockGateway) Consume(ctx context.Context, productId string, quantity int) (*entity.ProductStock, error) {
+	return toChangedStock(gtw.stockRepository.Consume(ctx, productId, quantity, gtw.clock.Now().UTC()))
+}
+
+func toChangedStock(stockModel *model.Stock, err error) (*entity.ProductStock, error) {
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stock := toProductStockEntity(*stockModel)
+
+	return &stock, nil
+}
@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+)
+
+type WebhookGateway struct {
+	webhookRepository repository.IWebhookRepository
+}
+
+func NewWebhookGateway(webhookRepository repository.IWebhookRepository) *WebhookGateway {
+	return &WebhookGateway{
+		webhookRepository: webhookRepository,
+	}
+}
+
+func (gtw *WebhookGateway) Create(ctx context.Context, webhook *entity.Webhook) error {
+	eventTypes := []string{}
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return gtw.webhookRepository.Create(ctx, &model.Webhook{
+		ID:         webhook.ID,
+		Url:        webhook.Url,
+		EventTypes: eventTypes,
+		Secret:     webhook.Secret,
+		CreatedAt:  webhook.CreatedAt,
+	})
+}
+
+func (gtw *WebhookGateway) FindAll(ctx context.Context) ([]entity.Webhook, error) {
+	webhookModels, err := gtw.webhookRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := []entity.Webhook{}
+	for _, webhookModel := range *webhookModels {
+		webhooks = append(webhooks, toWebhookEntity(webhookModel))
+	}
+
+	return webhooks, nil
+}
+
+// FindById returns nil when the webhook does not exist.
+func (gtw *WebhookGateway) FindById(ctx context.Context, id string) (*entity.Webhook, error) {
+	webhookModel, err := gtw.webhookRepository.FindById(ctx, id)
+	if webhookModel == nil {
+		return nil, err
+	}
+
+	webhook := toWebhookEntity(*webhookModel)
+
+	return &webhook, nil
+}
+
+// DeleteById reports whether the webhook existed, its delivery log goes along with it.
+func (gtw *WebhookGateway) DeleteById(ctx context.Context, id string) (bool, error) {
+	err := gtw.webhookRepository.DeleteById(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// AddDeliveries ignores the deliveries already added.
+func (gtw *WebhookGateway) AddDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	deliveryModels := []model.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		deliveryModels = append(deliveryModels, toWebhookDeliveryModel(delivery))
+	}
+
+	return gtw.webhookRepository.AddDeliveries(ctx, deliveryModels...)
+}
+
+// FindDueDeliveries returns the pending deliveries due at the given instant, the most overdue first.
+func (gtw *WebhookGateway) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	deliveryModels, err := gtw.webhookRepository.FindDueDeliveries(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookDeliveryEntities(*deliveryModels), nil
+}
+
+// FindDeliveries returns the deliveries of the webhook, or of every webhook when webhookId is empty,
+// newest first. An empty status matches every delivery.
+func (gtw *WebhookGateway) FindDeliveries(ctx context.Context, webhookId string, status entity.WebhookDeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	deliveryModels, err := gtw.webhookRepository.FindDeliveries(ctx, model.WebhookDeliveryQuery{
+		WebhookId: webhookId,
+		Status:    string(status),
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookDeliveryEntities(*deliveryModels), nil
+}
+
+func (gtw *WebhookGateway) UpdateDelivery(ctx context.Context, delivery entity.WebhookDelivery) error {
+	deliveryModel := toWebhookDeliveryModel(delivery)
+
+	return gtw.webhookRepository.UpdateDelivery(ctx, &deliveryModel)
+}
+
+// PurgeDelivered removes the deliveries that succeeded before the given instant.
+func (gtw *WebhookGateway) PurgeDelivered(ctx context.Context, before time.Time) (int64, error) {
+	return gtw.webhookRepository.PurgeDelivered(ctx, before)
+}
+
+func toWebhookEntity(webhookModel model.Webhook) entity.Webhook {
+	eventTypes := []entity.ProductEventType{}
+	for _, eventType := range webhookModel.EventTypes {
+		eventTypes = append(eventTypes, entity.ProductEventType(eventType))
+	}
+
+	return entity.Webhook{
+		ID:         webhookModel.ID,
+		Url:        webhookModel.Url,
+		EventTypes: eventTypes,
+		Secret:     webhookModel.Secret,
+		CreatedAt:  webhookModel.CreatedAt,
+	}
+}
+
+func toWebhookDeliveryModel(delivery entity.WebhookDelivery) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		ID:             delivery.ID,
+		WebhookId:      delivery.WebhookId,
+		EventId:        delivery.EventId,
+		EventType:      string(delivery.EventType),
+		Payload:        string(delivery.Payload),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+func toWebhookDeliveryEntities(deliveryModels []model.WebhookDelivery) []entity.WebhookDelivery {
+	deliveries := []entity.WebhookDelivery{}
+
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, entity.WebhookDelivery{
+			ID:             deliveryModel.ID,
+			WebhookId:      deliveryModel.WebhookId,
+			EventId:        deliveryModel.EventId,
+			EventType:      entity.ProductEventType(deliveryModel.EventType),
+			Payload:        []byte(deliveryModel.Payload),
+			Status:         entity.WebhookDeliveryStatus(deliveryModel.Status),
+			Attempts:       deliveryModel.Attempts,
+			LastStatusCode: deliveryModel.LastStatusCode,
+			LastError:      deliveryModel.LastError,
+			NextAttemptAt:  deliveryModel.NextAttemptAt,
+			CreatedAt:      deliveryModel.CreatedAt,
+			DeliveredAt:    deliveryModel.DeliveredAt,
+		})
+	}
+
+	return deliveries
+}
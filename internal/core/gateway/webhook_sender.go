@@ -0,0 +1,9 @@
+package gateway
+
+import "context"
+
+// IWebhookSender posts a delivery to a webhook url, see the adapter in infra/httpclient.
+// It returns the status code answered, zero when none came, and fails unless it is a 2xx.
+type IWebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
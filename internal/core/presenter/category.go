@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type CategoryPresenter struct {
+}
+
+func NewCategoryPresenter() *CategoryPresenter {
+	return &CategoryPresenter{}
+}
+
+func (presenter *CategoryPresenter) BuildCategoryResponse(category entity.Category) dto.Category {
+	return dto.Category{
+		ID:   category.ID,
+		Name: category.Name,
+	}
+}
+
+func (presenter *CategoryPresenter) BuildCategoryContentResponse(categories []entity.Category) dto.CategoryContent {
+	response := []dto.Category{}
+
+	for _, category := range categories {
+		response = append(response, presenter.BuildCategoryResponse(category))
+	}
+
+	return dto.CategoryContent{Content: response}
+}
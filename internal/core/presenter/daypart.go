@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type DaypartPresenter struct {
+}
+
+func NewDaypartPresenter() *DaypartPresenter {
+	return &DaypartPresenter{}
+}
+
+func (presenter *DaypartPresenter) BuildDaypartResponse(daypart entity.Daypart) dto.Daypart {
+	schedules := []dto.DaypartSchedule{}
+	for _, schedule := range daypart.Schedules {
+		weekdays := []string{}
+		for _, weekday := range schedule.Weekdays {
+			weekdays = append(weekdays, strings.ToLower(weekday.String()))
+		}
+		schedules = append(schedules, dto.DaypartSchedule{
+			Weekdays: weekdays,
+			Start:    formatMinuteOfDay(schedule.Start),
+			End:      formatMinuteOfDay(schedule.End),
+		})
+	}
+
+	return dto.Daypart{
+		DaypartId:   daypart.ID,
+		Name:        daypart.Name,
+		Schedules:   schedules,
+		ProductIds:  append([]string{}, daypart.ProductIds...),
+		CategoryIds: append([]int{}, daypart.CategoryIds...),
+		CreatedAt:   daypart.CreatedAt,
+		UpdatedAt:   daypart.UpdatedAt,
+	}
+}
+
+func (presenter *DaypartPresenter) BuildDaypartContentResponse(dayparts []entity.Daypart) dto.DaypartContent {
+	response := []dto.Daypart{}
+
+	for _, daypart := range dayparts {
+		response = append(response, presenter.BuildDaypartResponse(daypart))
+	}
+
+	return dto.DaypartContent{Content: response}
+}
+
+func formatMinuteOfDay(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
@@ -0,0 +1,37 @@
+package presenter
+
+import (
+	"fmt"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+const (
+	cloudEventSpecVersion = "1.0"
+	productEventSource    = "/tremligeiro/product"
+	// productEventVersion versions dto.ProductEvent, bump it on incompatible changes only.
+	productEventVersion = "v1"
+)
+
+type EventPresenter struct {
+}
+
+func NewEventPresenter() *EventPresenter {
+	return &EventPresenter{}
+}
+
+// BuildCloudEvent wraps the event payload, a dto.ProductEvent, in a CloudEvent
+// whose type is like com.tbtec.tremligeiro.ProductPriceChanged.v1.
+func (presenter *EventPresenter) BuildCloudEvent(event entity.OutboxEvent) dto.CloudEvent {
+	return dto.CloudEvent{
+		SpecVersion:     cloudEventSpecVersion,
+		ID:              event.ID,
+		Source:          productEventSource,
+		Type:            fmt.Sprintf("com.tbtec.tremligeiro.%s.%s", event.Type, productEventVersion),
+		Subject:         event.AggregateId,
+		Time:            event.OccurredAt,
+		DataContentType: "application/json",
+		Data:            event.Payload,
+	}
+}
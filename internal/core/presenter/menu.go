@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type MenuPresenter struct {
+	productPresenter *ProductPresenter
+}
+
+func NewMenuPresenter() *MenuPresenter {
+	return &MenuPresenter{
+		productPresenter: NewProductPresenter(),
+	}
+}
+
+// BuildMenuResponse groups the products by category, the sections ordered by category id and
+// the products by name.
+func (presenter *MenuPresenter) BuildMenuResponse(at time.Time, location *time.Location, products []entity.Product,
+	categories map[int]entity.Category, components map[string]entity.Product) dto.Menu {
+
+	sort.SliceStable(products, func(i, j int) bool {
+		if products[i].CategoryId != products[j].CategoryId {
+			return products[i].CategoryId < products[j].CategoryId
+		}
+		return products[i].Name < products[j].Name
+	})
+
+	sections := []dto.MenuSection{}
+	for _, product := range products {
+		category, ok := categories[product.CategoryId]
+		if !ok {
+			category = entity.Category{ID: product.CategoryId}
+		}
+
+		if len(sections) == 0 || sections[len(sections)-1].Category.ID != category.ID {
+			sections = append(sections, dto.MenuSection{
+				Category: dto.Category{ID: category.ID, Name: category.Name},
+				Products: []dto.Product{},
+			})
+		}
+
+		section := &sections[len(sections)-1]
+		section.Products = append(section.Products, presenter.productPresenter.BuildOneProductContentResponse(product, category, components))
+	}
+
+	return dto.Menu{
+		At:       at.In(location),
+		TimeZone: location.String(),
+		Sections: sections,
+	}
+}
@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type ModifierGroupPresenter struct {
+}
+
+func NewModifierGroupPresenter() *ModifierGroupPresenter {
+	return &ModifierGroupPresenter{}
+}
+
+func (presenter *ModifierGroupPresenter) BuildModifierGroupResponse(group entity.ModifierGroup) dto.ModifierGroup {
+	options := []dto.ModifierOption{}
+	for _, option := range group.Options {
+		options = append(options, dto.ModifierOption{
+			OptionId: option.ID,
+			Name:     option.Name,
+			Amount:   option.Amount,
+		})
+	}
+
+	return dto.ModifierGroup{
+		GroupId:       group.ID,
+		Name:          group.Name,
+		Required:      group.Required,
+		MinSelections: group.MinSelections,
+		MaxSelections: group.MaxSelections,
+		Options:       options,
+		CreatedAt:     group.CreatedAt,
+		UpdatedAt:     group.UpdatedAt,
+	}
+}
+
+func (presenter *ModifierGroupPresenter) BuildModifierGroupContentResponse(groups []entity.ModifierGroup) dto.ModifierGroupContent {
+	response := []dto.ModifierGroup{}
+
+	for _, group := range groups {
+		response = append(response, presenter.BuildModifierGroupResponse(group))
+	}
+
+	return dto.ModifierGroupContent{Content: response}
+}
@@ -3,6 +3,12 @@ package presenter
 import (
 	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/xstrings"
+)
+
+const (
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
 )
 
 // import "github.com/tbtec/tremligeiro/internal/core/usecase"
@@ -14,9 +20,17 @@ func NewProductPresenter() *ProductPresenter {
 	return &ProductPresenter{}
 }
 
+// BuildProductCreateResponse presents the components of a bundle by id, see BuildOneProductContentResponse
+// to expand them.
 func (presenter *ProductPresenter) BuildProductCreateResponse(product entity.Product, category entity.Category) dto.Product {
+	productType := dto.ProductTypeSingle
+	if product.IsBundle() {
+		productType = dto.ProductTypeBundle
+	}
+
 	return dto.Product{
 		ProductId:   product.ID,
+		Type:        productType,
 		Name:        product.Name,
 		Description: product.Description,
 		Amount:      product.Amount,
@@ -24,24 +38,249 @@ func (presenter *ProductPresenter) BuildProductCreateResponse(product entity.Pro
 			ID:   category.ID,
 			Name: category.Name,
 		},
-		CreatedAt: product.CreatedAt,
-		UpdatedAt: product.UpdatedAt,
+		Images:          presenter.BuildProductImagesResponse(product.Images),
+		VariantsEnabled: product.VariantsEnabled,
+		Variants:        presenter.BuildProductVariantsResponse(product.Variants),
+		ModifierGroups:  NewModifierGroupPresenter().BuildModifierGroupContentResponse(product.ModifierGroups).Content,
+		Bundle:          presenter.BuildProductBundleResponse(product, nil),
+		Available:       product.Available(),
+		Unavailability:  presenter.buildProductUnavailability(product.Unavailability),
+		Stock:           presenter.buildProductStock(product),
+		Version:         product.Version,
+		CreatedAt:       product.CreatedAt,
+		UpdatedAt:       product.UpdatedAt,
+		DeletedAt:       product.DeletedAt,
+	}
+}
+
+// BuildProductImagesResponse lists the images in the order they were uploaded, never as null.
+func (presenter *ProductPresenter) BuildProductImagesResponse(images []entity.ProductImage) []dto.ProductImage {
+	response := []dto.ProductImage{}
+
+	for _, image := range images {
+		thumbnails := []dto.ProductThumbnail{}
+		for _, thumbnail := range image.Thumbnails {
+			thumbnails = append(thumbnails, dto.ProductThumbnail{
+				Size:   thumbnail.Size,
+				Width:  thumbnail.Width,
+				Height: thumbnail.Height,
+				Url:    thumbnail.Url,
+			})
+		}
+
+		response = append(response, dto.ProductImage{
+			ImageId:     image.ID,
+			Url:         image.Url,
+			ContentType: image.ContentType,
+			Width:       image.Width,
+			Height:      image.Height,
+			Size:        image.Size,
+			Thumbnails:  thumbnails,
+			CreatedAt:   image.CreatedAt,
+		})
 	}
+
+	return response
+}
+
+// BuildProductVariantsResponse lists the variants in the order they were added, never as null.
+func (presenter *ProductPresenter) BuildProductVariantsResponse(variants []entity.ProductVariant) []dto.ProductVariant {
+	response := []dto.ProductVariant{}
+
+	for _, variant := range variants {
+		response = append(response, presenter.BuildProductVariantResponse(variant))
+	}
+
+	return response
 }
 
-func (presenter *ProductPresenter) BuildProductContentResponse(products []entity.Product, category entity.Category) dto.ProductContent {
+func (presenter *ProductPresenter) BuildProductVariantResponse(variant entity.ProductVariant) dto.ProductVariant {
+	return dto.ProductVariant{
+		VariantId: variant.ID,
+		Sku:       variant.Sku,
+		Label:     variant.Label,
+		Amount:    variant.Amount,
+	}
+}
+
+// BuildProductPriceResponse lists the modifiers in the order they were picked.
+func (presenter *ProductPresenter) BuildProductPriceResponse(product entity.Product, price entity.ProductPrice) dto.ProductPrice {
+	modifiers := []dto.PricedModifier{}
+	for _, modifier := range price.Modifiers {
+		modifiers = append(modifiers, dto.PricedModifier{
+			GroupId:    modifier.Group.ID,
+			GroupName:  modifier.Group.Name,
+			OptionId:   modifier.Option.ID,
+			OptionName: modifier.Option.Name,
+			Amount:     modifier.Option.Amount,
+		})
+	}
+
+	response := dto.ProductPrice{
+		ProductId: product.ID,
+		Base:      price.Base,
+		Modifiers: modifiers,
+		Total:     price.Total,
+	}
+	if price.Variant != nil {
+		response.VariantId = price.Variant.ID
+	}
+
+	return response
+}
+
+// BuildProductBundleResponse expands the components found in the map, by id, and compares the price
+// of the bundle with theirs when all of them are there. It is nil for a single product.
+func (presenter *ProductPresenter) BuildProductBundleResponse(product entity.Product, components map[string]entity.Product) *dto.ProductBundle {
+	if !product.IsBundle() {
+		return nil
+	}
+
+	response := &dto.ProductBundle{Components: []dto.BundleComponent{}}
+	for _, component := range product.Components {
+		presented := dto.BundleComponent{
+			ProductId: component.ProductId,
+			Quantity:  component.Quantity,
+			Swappable: component.Swappable,
+		}
+		if found, ok := components[component.ProductId]; ok {
+			presented.Name = found.Name
+			presented.CategoryId = found.CategoryId
+			presented.Amount = &found.Amount
+		}
+		response.Components = append(response.Components, presented)
+	}
+
+	if price, ok := product.BundlePrice(components); ok {
+		response.ComponentsAmount = &price.ComponentsAmount
+		response.Savings = &price.Savings
+	}
+
+	return response
+}
+
+func (presenter *ProductPresenter) BuildProductStockResponse(productId string, stock entity.ProductStock) dto.ProductStock {
+	return dto.ProductStock{
+		ProductId:         productId,
+		OnHand:            stock.OnHand,
+		Reserved:          stock.Reserved,
+		AvailableQuantity: stock.Free(),
+		LowStockThreshold: stock.LowStockThreshold,
+		Low:               stock.Low(),
+		UpdatedAt:         stock.UpdatedAt,
+	}
+}
+
+func (presenter *ProductPresenter) buildProductStock(product entity.Product) *dto.ProductStock {
+	if product.Stock == nil {
+		return nil
+	}
+	stock := presenter.BuildProductStockResponse(product.ID, *product.Stock)
+	return &stock
+}
+
+func (presenter *ProductPresenter) buildProductUnavailability(unavailability *entity.ProductUnavailability) *dto.ProductUnavailability {
+	if unavailability == nil {
+		return nil
+	}
+	return &dto.ProductUnavailability{
+		Reason:    unavailability.Reason,
+		Since:     unavailability.Since,
+		RestoreAt: unavailability.RestoreAt,
+		ChangedBy: unavailability.ChangedBy,
+	}
+}
+
+// BuildProductAvailabilityResponse presents the availability of the product along with the given changes.
+func (presenter *ProductPresenter) BuildProductAvailabilityResponse(product entity.Product, changes []entity.ProductAvailabilityChange) dto.ProductAvailability {
+	history := []dto.ProductAvailabilityChange{}
+	for _, change := range changes {
+		history = append(history, dto.ProductAvailabilityChange{
+			Available: change.Available,
+			Reason:    change.Reason,
+			RestoreAt: change.RestoreAt,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
+	return dto.ProductAvailability{
+		ProductId:      product.ID,
+		Available:      product.Available(),
+		Unavailability: presenter.buildProductUnavailability(product.Unavailability),
+		History:        history,
+	}
+}
+
+func (presenter *ProductPresenter) BuildProductEventResponse(event entity.ProductEvent) dto.ProductEvent {
+	return dto.ProductEvent{
+		Product:        presenter.BuildProductCreateResponse(event.Product, event.Category),
+		PreviousAmount: event.PreviousAmount,
+	}
+}
+
+// BuildProductContentResponse looks up each product's category in the given map,
+// presenting only the category id when it is missing, and expands the bundles with the components.
+func (presenter *ProductPresenter) BuildProductContentResponse(products []entity.Product, categories map[int]entity.Category,
+	components map[string]entity.Product, query dto.ProductQuery, total int64) dto.ProductContent {
 	response := []dto.Product{}
 
 	for _, product := range products {
-		response = append(response, presenter.BuildProductCreateResponse(product, category))
+		category, ok := categories[product.CategoryId]
+		if !ok {
+			category = entity.Category{ID: product.CategoryId}
+		}
+		response = append(response, presenter.BuildOneProductContentResponse(product, category, components))
+	}
+
+	totalPages := 0
+	if query.Size > 0 {
+		totalPages = int((total + int64(query.Size) - 1) / int64(query.Size))
 	}
 
-	return dto.ProductContent{Content: response}
+	return dto.ProductContent{
+		Content:       response,
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalElements: total,
+		TotalPages:    totalPages,
+	}
 }
 
-func (presenter *ProductPresenter) BuildOneProductContentResponse(product entity.Product, category entity.Category) dto.Product {
+// BuildOneProductContentResponse expands the components of a bundle found in the map, by id.
+func (presenter *ProductPresenter) BuildOneProductContentResponse(product entity.Product, category entity.Category, components map[string]entity.Product) dto.Product {
 
 	response := presenter.BuildProductCreateResponse(product, category)
+	response.Bundle = presenter.BuildProductBundleResponse(product, components)
 
 	return response
 }
+
+// BuildProductSearchResponse keeps the relevance order and marks where each search term occurs
+// in the name and description.
+func (presenter *ProductPresenter) BuildProductSearchResponse(matches []entity.ProductMatch, categories map[int]entity.Category, terms []string) dto.ProductSearchContent {
+	response := []dto.ProductSearchResult{}
+
+	for _, match := range matches {
+		category, ok := categories[match.Product.CategoryId]
+		if !ok {
+			category = entity.Category{ID: match.Product.CategoryId}
+		}
+
+		highlights := map[string]string{}
+		if name, found := xstrings.Highlight(match.Product.Name, terms, highlightOpen, highlightClose); found {
+			highlights["name"] = name
+		}
+		if description, found := xstrings.Highlight(match.Product.Description, terms, highlightOpen, highlightClose); found {
+			highlights["description"] = description
+		}
+
+		response = append(response, dto.ProductSearchResult{
+			Product:    presenter.BuildProductCreateResponse(match.Product, category),
+			Score:      match.Score,
+			Highlights: highlights,
+		})
+	}
+
+	return dto.ProductSearchContent{Content: response}
+}
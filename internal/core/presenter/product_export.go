@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+// BuildProductExportRecord flattens the product into a CSV record following dto.ProductExportColumns.
+func (presenter *ProductPresenter) BuildProductExportRecord(product dto.Product) []string {
+	return []string{
+		product.ProductId,
+		product.Name,
+		product.Description,
+		strconv.FormatFloat(product.Amount, 'f', -1, 64),
+		strconv.Itoa(product.Category.ID),
+		product.Category.Name,
+		strconv.FormatInt(product.Version, 10),
+		product.CreatedAt.Format(time.RFC3339Nano),
+		product.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
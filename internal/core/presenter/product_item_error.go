@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+// BuildItemError describes why an item of a batch failed the way the API describes a failed
+// request, hiding the unexpected errors. Known errors keep their code, so a missing product
+// reads the same whatever the operation.
+func (presenter *ProductPresenter) BuildItemError(err error) *dto.ItemError {
+	switch codError := err.(type) {
+	case xerrors.ValidationError:
+		details := []dto.ItemErrorDetail{}
+		for _, field := range codError.Fields {
+			details = append(details, dto.ItemErrorDetail{Attribute: field.Name, Messages: field.Reasons})
+		}
+		return &dto.ItemError{Code: "400", Description: "Bad Request", Details: details}
+	case xerrors.BusinessError:
+		return &dto.ItemError{Code: codError.Code, Description: codError.Description}
+	case xerrors.NotFoundError:
+		return &dto.ItemError{Code: codError.Code, Description: codError.Description}
+	case xerrors.ConflictError:
+		return &dto.ItemError{Code: codError.Code, Description: codError.Description}
+	default:
+		return &dto.ItemError{Code: "500", Description: "Internal Server Error"}
+	}
+}
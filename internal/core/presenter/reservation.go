@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type ReservationPresenter struct {
+}
+
+func NewReservationPresenter() *ReservationPresenter {
+	return &ReservationPresenter{}
+}
+
+func (presenter *ReservationPresenter) BuildReservationResponse(reservation entity.Reservation) dto.Reservation {
+	items := []dto.ReservationItem{}
+	for _, item := range reservation.Items {
+		items = append(items, dto.ReservationItem{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			Held:      item.Held,
+		})
+	}
+
+	return dto.Reservation{
+		ID:        reservation.ID,
+		Status:    string(reservation.Status),
+		Items:     items,
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+type WebhookPresenter struct {
+}
+
+func NewWebhookPresenter() *WebhookPresenter {
+	return &WebhookPresenter{}
+}
+
+func (presenter *WebhookPresenter) BuildWebhookResponse(webhook entity.Webhook) dto.Webhook {
+	eventTypes := []string{}
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return dto.Webhook{
+		ID:         webhook.ID,
+		Url:        webhook.Url,
+		EventTypes: eventTypes,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+func (presenter *WebhookPresenter) BuildWebhookContentResponse(webhooks []entity.Webhook) dto.WebhookContent {
+	response := []dto.Webhook{}
+
+	for _, webhook := range webhooks {
+		response = append(response, presenter.BuildWebhookResponse(webhook))
+	}
+
+	return dto.WebhookContent{Content: response}
+}
+
+// BuildWebhookDeliveryResponse only shows the next attempt of the deliveries still pending.
+func (presenter *WebhookPresenter) BuildWebhookDeliveryResponse(delivery entity.WebhookDelivery) dto.WebhookDelivery {
+	response := dto.WebhookDelivery{
+		ID:             delivery.ID,
+		WebhookId:      delivery.WebhookId,
+		EventId:        delivery.EventId,
+		EventType:      string(delivery.EventType),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		Payload:        delivery.Payload,
+	}
+
+	if delivery.Status == entity.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		response.NextAttemptAt = &nextAttemptAt
+	}
+
+	return response
+}
+
+func (presenter *WebhookPresenter) BuildWebhookDeliveryContentResponse(deliveries []entity.WebhookDelivery) dto.WebhookDeliveryContent {
+	response := []dto.WebhookDelivery{}
+
+	for _, delivery := range deliveries {
+		response = append(response, presenter.BuildWebhookDeliveryResponse(delivery))
+	}
+
+	return dto.WebhookDeliveryContent{Content: response}
+}
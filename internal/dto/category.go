@@ -4,3 +4,16 @@ type Category struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
+
+type CreateCategory struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type UpdateCategory struct {
+	CategoryId int    `json:"-"`
+	Name       string `json:"name" validate:"required"`
+}
+
+type CategoryContent struct {
+	Content []Category `json:"content"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CloudEventContentType is the media type of a CloudEvent in structured mode.
+const CloudEventContentType = "application/cloudevents+json"
+
+// CloudEvent is the envelope of the published events, see https://cloudevents.io (spec 1.0).
+// Type ends with the version of the data, which changes only on incompatible changes.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
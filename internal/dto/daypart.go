@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+// DaypartDocument is the writable representation of a daypart, replaced as a whole by PUT. The
+// schedules read weekday names, as "monday", and times as "HH:MM" in the time zone of the menu.
+type DaypartDocument struct {
+	Name        string                    `json:"name" validate:"required,max=100"`
+	Schedules   []DaypartScheduleDocument `json:"schedules" validate:"required,min=1,max=20,dive"`
+	ProductIds  []string                  `json:"productIds" validate:"max=500,dive,required"`
+	CategoryIds []int                     `json:"categoryIds" validate:"max=100"`
+}
+
+// DaypartScheduleDocument runs from Start until End, an End before Start runs past midnight and
+// "24:00" runs until midnight.
+type DaypartScheduleDocument struct {
+	Weekdays []string `json:"weekdays" validate:"required,min=1,max=7,dive,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+	Start    string   `json:"start" validate:"required"`
+	End      string   `json:"end" validate:"required"`
+}
+
+type UpdateDaypart struct {
+	DaypartId string
+	DaypartDocument
+}
+
+type Daypart struct {
+	DaypartId   string            `json:"id"`
+	Name        string            `json:"name"`
+	Schedules   []DaypartSchedule `json:"schedules"`
+	ProductIds  []string          `json:"productIds"`
+	CategoryIds []int             `json:"categoryIds"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+}
+
+type DaypartSchedule struct {
+	Weekdays []string `json:"weekdays"`
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+}
+
+type DaypartContent struct {
+	Content []Daypart `json:"content"`
+}
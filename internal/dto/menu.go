@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+// MenuQuery asks for the menu at an instant, the current one when At is nil.
+type MenuQuery struct {
+	At *time.Time
+}
+
+// Menu lists the products that can be ordered at the instant, by category.
+type Menu struct {
+	At       time.Time     `json:"at"`
+	TimeZone string        `json:"timeZone"`
+	Sections []MenuSection `json:"sections"`
+}
+
+type MenuSection struct {
+	Category Category  `json:"category"`
+	Products []Product `json:"products"`
+}
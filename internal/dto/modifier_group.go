@@ -0,0 +1,81 @@
+package dto
+
+import "time"
+
+// ModifierGroupDocument is the writable representation of a modifier group, replaced as a whole by PUT.
+// Options sent with the id of an option of the group keep it, the others get a new id.
+type ModifierGroupDocument struct {
+	Name          string                   `json:"name" validate:"required,max=100"`
+	Required      bool                     `json:"required"`
+	MinSelections int                      `json:"minSelections" validate:"gte=0"`
+	MaxSelections int                      `json:"maxSelections" validate:"gte=1"`
+	Options       []ModifierOptionDocument `json:"options" validate:"required,min=1,max=50,dive"`
+}
+
+type ModifierOptionDocument struct {
+	OptionId string   `json:"id"`
+	Name     string   `json:"name" validate:"required,max=100"`
+	Amount   *float64 `json:"amount" validate:"required,gte=0"`
+}
+
+type UpdateModifierGroup struct {
+	GroupId string
+	ModifierGroupDocument
+}
+
+type ModifierGroup struct {
+	GroupId       string           `json:"id"`
+	Name          string           `json:"name"`
+	Required      bool             `json:"required"`
+	MinSelections int              `json:"minSelections"`
+	MaxSelections int              `json:"maxSelections"`
+	Options       []ModifierOption `json:"options"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
+
+type ModifierOption struct {
+	OptionId string  `json:"id"`
+	Name     string  `json:"name"`
+	Amount   float64 `json:"amount"`
+}
+
+type ModifierGroupContent struct {
+	Content []ModifierGroup `json:"content"`
+}
+
+// AttachModifierGroups replaces the groups attached to the product, in the order given.
+type AttachModifierGroups struct {
+	ProductId string   `json:"-"`
+	GroupIds  []string `json:"groupIds" validate:"max=20,dive,required"`
+	Version   int64    `json:"-"`
+}
+
+// PriceProduct asks for the price of the product with a variant, required when variants are
+// enabled, and the options picked in its modifier groups.
+type PriceProduct struct {
+	ProductId string              `json:"-"`
+	VariantId string              `json:"variantId"`
+	Modifiers []ModifierSelection `json:"modifiers" validate:"max=100,dive"`
+}
+
+type ModifierSelection struct {
+	GroupId  string `json:"groupId" validate:"required"`
+	OptionId string `json:"optionId" validate:"required"`
+}
+
+type ProductPrice struct {
+	ProductId string           `json:"productId"`
+	VariantId string           `json:"variantId,omitempty"`
+	Base      float64          `json:"base"`
+	Modifiers []PricedModifier `json:"modifiers"`
+	Total     float64          `json:"total"`
+}
+
+type PricedModifier struct {
+	GroupId    string  `json:"groupId"`
+	GroupName  string  `json:"groupName"`
+	OptionId   string  `json:"optionId"`
+	OptionName string  `json:"optionName"`
+	Amount     float64 `json:"amount"`
+}
@@ -5,8 +5,13 @@ import "time"
 type CreateProduct struct {
 	Name        string  `json:"name" validate:"required"`
 	Description string  `json:"description" validate:"required"`
-	CategoryId  int     `json:"categoryId" validate:"required,oneof='1' '2' '3' '4'"`
+	CategoryId  int     `json:"categoryId" validate:"required"`
 	Amount      float64 `json:"amount" validate:"required"`
+	// VariantsEnabled requires at least one of Variants
+	VariantsEnabled bool                     `json:"variantsEnabled"`
+	Variants        []ProductVariantDocument `json:"variants" validate:"dive"`
+	// Components make the product a bundle
+	Components []BundleComponentDocument `json:"components" validate:"max=20,dive"`
 }
 
 type UpdateProduct struct {
@@ -15,19 +20,111 @@ type UpdateProduct struct {
 	Description string
 	CategoryId  int
 	Amount      float64
-	CreatedAt   time.Time
+	// Version is the version the client expects to replace, zero skips the check
+	Version int64
 }
 
+// ProductDocument is the writable representation of a product,
+// replaced as a whole by PUT and merged by PATCH.
+type ProductDocument struct {
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description"`
+	CategoryId  int      `json:"categoryId" validate:"required"`
+	Amount      *float64 `json:"amount" validate:"required,gte=0"`
+}
+
+type PatchProduct struct {
+	ProductId string
+	Patch     []byte
+	Version   int64
+}
+
+// ProductWrite is one write of a bulk, the replacement UpdateProduct describes or, when Delete
+// is set, the deletion of the product at Version.
+type ProductWrite struct {
+	UpdateProduct
+	Delete bool
+}
+
+type DeleteProduct struct {
+	ProductId string
+	Version   int64
+}
+
+// Product types
+const (
+	ProductTypeSingle = "single"
+	ProductTypeBundle = "bundle"
+)
+
 type Product struct {
-	ProductId   string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Amount      float64   `json:"amount"`
-	Category    Category  `json:"category"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ProductId       string           `json:"id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Amount          float64          `json:"amount"`
+	Category        Category         `json:"category"`
+	Images          []ProductImage   `json:"images"`
+	VariantsEnabled bool             `json:"variantsEnabled"`
+	Variants        []ProductVariant `json:"variants"`
+	ModifierGroups  []ModifierGroup  `json:"modifierGroups"`
+	Type            string           `json:"type"`
+	Bundle          *ProductBundle   `json:"bundle,omitempty"`
+	// Available is false when the product is marked unavailable or no unit of it is left for sale
+	Available      bool                   `json:"available"`
+	Unavailability *ProductUnavailability `json:"unavailability,omitempty"`
+	Stock          *ProductStock          `json:"stock,omitempty"`
+	Version        int64                  `json:"version"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+	DeletedAt      *time.Time             `json:"deletedAt,omitempty"`
+}
+
+type ProductQuery struct {
+	CategoryIds  []int
+	MinAmount    *float64 `validate:"omitempty,gte=0"`
+	MaxAmount    *float64 `validate:"omitempty,gte=0"`
+	CreatedAfter *time.Time
+	UpdatedAfter *time.Time
+	Name         string
+	Page         int    `validate:"min=1"`
+	Size         int    `validate:"min=1,max=100"`
+	Sort         string `validate:"oneof=name amount createdAt"`
+	Direction    string `validate:"oneof=asc desc"`
+	Trashed      bool
+	// HideUnavailable leaves out the products marked unavailable and the sold out ones,
+	// Unavailable only reads the products marked unavailable
+	HideUnavailable bool
+	Unavailable     bool
 }
 
 type ProductContent struct {
-	Content []Product `json:"content"`
+	Content       []Product `json:"content"`
+	Page          int       `json:"page"`
+	Size          int       `json:"size"`
+	TotalElements int64     `json:"totalElements"`
+	TotalPages    int       `json:"totalPages"`
+	Links         PageLinks `json:"links"`
+}
+
+type PageLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+type ProductSearch struct {
+	Query string `validate:"required"`
+	Limit int    `validate:"min=1,max=100"`
+	// HideUnavailable leaves out the products that cannot be sold
+	HideUnavailable bool
+}
+
+type ProductSearchResult struct {
+	Product
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
+
+type ProductSearchContent struct {
+	Content []ProductSearchResult `json:"content"`
 }
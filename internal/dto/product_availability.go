@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// ProductAvailabilityDocument marks the product unavailable, with the reason and optionally when it
+// comes back by itself, or available again. There is no authentication, ChangedBy names who did it.
+type ProductAvailabilityDocument struct {
+	Available *bool      `json:"available" validate:"required"`
+	Reason    string     `json:"reason" validate:"max=200"`
+	RestoreAt *time.Time `json:"restoreAt"`
+	ChangedBy string     `json:"changedBy" validate:"required,max=100"`
+}
+
+type ChangeProductAvailability struct {
+	ProductId string
+	// Version is the version the client expects to change, zero skips the check
+	Version int64
+	ProductAvailabilityDocument
+}
+
+type ProductUnavailability struct {
+	Reason    string     `json:"reason"`
+	Since     time.Time  `json:"since"`
+	RestoreAt *time.Time `json:"restoreAt,omitempty"`
+	ChangedBy string     `json:"changedBy"`
+}
+
+type ProductAvailabilityChange struct {
+	Available bool       `json:"available"`
+	Reason    string     `json:"reason,omitempty"`
+	RestoreAt *time.Time `json:"restoreAt,omitempty"`
+	ChangedBy string     `json:"changedBy"`
+	ChangedAt time.Time  `json:"changedAt"`
+}
+
+// ProductAvailability is the availability of the product along with its last changes, newest first.
+type ProductAvailability struct {
+	ProductId      string                      `json:"productId"`
+	Available      bool                        `json:"available"`
+	Unavailability *ProductUnavailability      `json:"unavailability,omitempty"`
+	History        []ProductAvailabilityChange `json:"history"`
+}
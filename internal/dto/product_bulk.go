@@ -0,0 +1,58 @@
+package dto
+
+const (
+	// BulkUpdate sets the attributes given of the product.
+	BulkUpdate = "update"
+	// BulkAdjustPrice changes the amount of every product of the category, by Percentage or by Delta.
+	BulkAdjustPrice = "adjustPrice"
+	// BulkDelete moves the product to the trash.
+	BulkDelete = "delete"
+)
+
+const (
+	BulkItemUpdated = "UPDATED"
+	BulkItemDeleted = "DELETED"
+	BulkItemFailed  = "FAILED"
+	// BulkItemSkipped is an item left undone because an atomic bulk was rejected.
+	BulkItemSkipped = "SKIPPED"
+)
+
+// BulkProducts is a list of operations run together. An atomic bulk applies all of them or none.
+type BulkProducts struct {
+	Operations []BulkOperation `json:"operations" validate:"required,min=1,max=100,dive"`
+	Atomic     bool            `json:"atomic"`
+}
+
+// BulkOperation holds the attributes of every type, each type reads only its own.
+// Version, when given, must be the current version of the product.
+type BulkOperation struct {
+	Type        string   `json:"type" validate:"oneof=update adjustPrice delete"`
+	ProductId   string   `json:"id" validate:"required_unless=Type adjustPrice"`
+	Version     int64    `json:"version" validate:"gte=0"`
+	Name        *string  `json:"name" validate:"omitempty,min=1"`
+	Description *string  `json:"description"`
+	CategoryId  *int     `json:"categoryId" validate:"required_if=Type adjustPrice"`
+	Amount      *float64 `json:"amount" validate:"omitempty,gte=0"`
+	Percentage  *float64 `json:"percentage" validate:"omitempty,gt=-100"`
+	Delta       *float64 `json:"delta"`
+}
+
+// BulkReport tells what happened to each product. Nothing was written unless Applied is set.
+type BulkReport struct {
+	Atomic    bool         `json:"atomic"`
+	Applied   bool         `json:"applied"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+	Results   []BulkResult `json:"results"`
+}
+
+// BulkResult is the outcome for one product, an adjustPrice has one per product of its category.
+// Operation is the position of the operation in the request, from 0.
+type BulkResult struct {
+	Operation int        `json:"operation"`
+	Type      string     `json:"type"`
+	ProductId string     `json:"id,omitempty"`
+	Status    string     `json:"status"`
+	Product   *Product   `json:"product,omitempty"`
+	Error     *ItemError `json:"error,omitempty"`
+}
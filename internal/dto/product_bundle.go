@@ -0,0 +1,34 @@
+package dto
+
+type BundleComponentDocument struct {
+	ProductId string `json:"productId" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"required,min=1,max=99"`
+	Swappable bool   `json:"swappable"`
+}
+
+// ReplaceBundleComponents replaces the components of the product, an empty list makes it a single
+// product again.
+type ReplaceBundleComponents struct {
+	ProductId  string                    `json:"-"`
+	Components []BundleComponentDocument `json:"components" validate:"max=20,dive"`
+	Version    int64                     `json:"-"`
+}
+
+// ProductBundle expands the components of a bundle. ComponentsAmount and Savings are left out
+// when some component was not found.
+type ProductBundle struct {
+	Components       []BundleComponent `json:"components"`
+	ComponentsAmount *float64          `json:"componentsAmount,omitempty"`
+	Savings          *float64          `json:"savings,omitempty"`
+}
+
+// BundleComponent is presented with the product when it was found. A swappable component may be
+// exchanged for any product of its category.
+type BundleComponent struct {
+	ProductId  string   `json:"productId"`
+	Quantity   int      `json:"quantity"`
+	Swappable  bool     `json:"swappable"`
+	Name       string   `json:"name,omitempty"`
+	CategoryId int      `json:"categoryId,omitempty"`
+	Amount     *float64 `json:"amount,omitempty"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// ProductEvent is the payload published for every product event, the product after the change.
+type ProductEvent struct {
+	Product
+	PreviousAmount *float64 `json:"previousAmount,omitempty"`
+}
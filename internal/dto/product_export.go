@@ -0,0 +1,16 @@
+package dto
+
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatJSON   = "json"
+	ExportFormatNDJSON = "ndjson"
+)
+
+// ExportProducts selects the products exported, every live product when CategoryIds is empty.
+type ExportProducts struct {
+	Format      string `validate:"oneof=csv json ndjson"`
+	CategoryIds []int
+}
+
+// ProductExportColumns is the CSV header of an export, named after the Product attributes.
+var ProductExportColumns = []string{"id", "name", "description", "amount", "category.id", "category.name", "version", "createdAt", "updatedAt"}
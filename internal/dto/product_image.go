@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// UploadProductImage is an image to add to the product, ContentType is the one the client declared.
+type UploadProductImage struct {
+	ProductId   string `validate:"required"`
+	ContentType string `validate:"required"`
+	Content     []byte `validate:"required"`
+}
+
+type DeleteProductImage struct {
+	ProductId string `validate:"required"`
+	ImageId   string `validate:"required"`
+}
+
+type ProductImage struct {
+	ImageId     string             `json:"id"`
+	Url         string             `json:"url"`
+	ContentType string             `json:"contentType"`
+	Width       int                `json:"width"`
+	Height      int                `json:"height"`
+	Size        int64              `json:"size"`
+	Thumbnails  []ProductThumbnail `json:"thumbnails"`
+	CreatedAt   time.Time          `json:"createdAt"`
+}
+
+type ProductThumbnail struct {
+	Size   int    `json:"size"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Url    string `json:"url"`
+}
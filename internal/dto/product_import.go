@@ -0,0 +1,55 @@
+package dto
+
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatJSON = "json"
+
+	// ImportAllOrNothing writes nothing unless every row is valid and saved.
+	ImportAllOrNothing = "all-or-nothing"
+	// ImportBestEffort saves every valid row, reporting the others as failed.
+	ImportBestEffort = "best-effort"
+)
+
+const (
+	ImportRowCreated = "CREATED"
+	ImportRowUpdated = "UPDATED"
+	ImportRowFailed  = "FAILED"
+	// ImportRowSkipped is a valid row left unsaved because an all-or-nothing import was rejected.
+	ImportRowSkipped = "SKIPPED"
+)
+
+type ImportProducts struct {
+	Format  string `validate:"oneof=csv json"`
+	Mode    string `validate:"oneof=all-or-nothing best-effort"`
+	DryRun  bool
+	Content []byte
+}
+
+// ImportProduct is one imported row. A row with an id replaces that product, any other row creates one.
+type ImportProduct struct {
+	ProductId   string   `json:"id"`
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description"`
+	CategoryId  int      `json:"categoryId" validate:"required"`
+	Amount      *float64 `json:"amount" validate:"required,gte=0"`
+}
+
+// ImportReport tells what happened to each row, or would happen on a dry run.
+// Nothing was saved unless Applied is set.
+type ImportReport struct {
+	Mode    string            `json:"mode"`
+	DryRun  bool              `json:"dryRun"`
+	Applied bool              `json:"applied"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+	Rows    []ImportRowReport `json:"rows"`
+}
+
+// ImportRowReport numbers the rows from 1, not counting the CSV header.
+type ImportRowReport struct {
+	Row       int        `json:"row"`
+	Status    string     `json:"status"`
+	ProductId string     `json:"id,omitempty"`
+	Error     *ItemError `json:"error,omitempty"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+// ItemError tells why an item of a batch failed, with the shape of the API error responses.
+type ItemError struct {
+	Description string            `json:"description"`
+	Code        string            `json:"code,omitempty"`
+	Details     []ItemErrorDetail `json:"details,omitempty"`
+}
+
+type ItemErrorDetail struct {
+	Attribute string   `json:"attribute"`
+	Messages  []string `json:"messages"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+type ProductStockDocument struct {
+	OnHand            *int `json:"onHand" validate:"required,gte=0"`
+	LowStockThreshold int  `json:"lowStockThreshold" validate:"gte=0"`
+}
+
+type SaveProductStock struct {
+	ProductId string
+	ProductStockDocument
+}
+
+// StockQuantity is the number of units added to or removed from the stock.
+type StockQuantity struct {
+	Quantity int `json:"quantity" validate:"required,min=1,max=100000"`
+}
+
+// ChangeProductStock adds Delta, negative to remove units, to the units on hand or, when Reserve
+// is set, to the units reserved.
+type ChangeProductStock struct {
+	ProductId string
+	Delta     int
+	Reserve   bool
+}
+
+type ProductStock struct {
+	ProductId string `json:"productId"`
+	OnHand    int    `json:"onHand"`
+	Reserved  int    `json:"reserved"`
+	// AvailableQuantity is the number of units on hand not reserved
+	AvailableQuantity int       `json:"availableQuantity"`
+	LowStockThreshold int       `json:"lowStockThreshold"`
+	Low               bool      `json:"low"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
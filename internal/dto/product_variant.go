@@ -0,0 +1,46 @@
+package dto
+
+// ProductVariantDocument is the writable representation of a variant, replaced as a whole by PUT.
+type ProductVariantDocument struct {
+	Sku    string   `json:"sku" validate:"required,max=64"`
+	Label  string   `json:"label" validate:"required,max=64"`
+	Amount *float64 `json:"amount" validate:"required,gte=0"`
+}
+
+// CreateProductVariant adds a variant to the product, enabling the variants of a product without any.
+type CreateProductVariant struct {
+	ProductId string
+	Sku       string
+	Label     string
+	Amount    float64
+	// Version is the product version the client expects to change, zero skips the check
+	Version int64
+}
+
+type UpdateProductVariant struct {
+	ProductId string
+	VariantId string
+	Sku       string
+	Label     string
+	Amount    float64
+	Version   int64
+}
+
+// DeleteProductVariant removes the variant or, without VariantId, every variant of the product,
+// which disables them.
+type DeleteProductVariant struct {
+	ProductId string
+	VariantId string
+	Version   int64
+}
+
+type ProductVariant struct {
+	VariantId string  `json:"id"`
+	Sku       string  `json:"sku"`
+	Label     string  `json:"label"`
+	Amount    float64 `json:"amount"`
+}
+
+type ProductVariantContent struct {
+	Content []ProductVariant `json:"content"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type ReservationItemDocument struct {
+	ProductId string `json:"productId" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"required,min=1,max=100000"`
+}
+
+// CreateReservation holds the items for TtlSeconds, or for the configured time to live when it is not given.
+type CreateReservation struct {
+	Items      []ReservationItemDocument `json:"items" validate:"required,min=1,max=50,dive"`
+	TtlSeconds *int                      `json:"ttlSeconds" validate:"omitempty,min=1,max=86400"`
+}
+
+type Reservation struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status"`
+	Items     []ReservationItem `json:"items"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// ReservationItem is held when the stock of the product is tracked, the units are then set aside.
+type ReservationItem struct {
+	ProductId string `json:"productId"`
+	Quantity  int    `json:"quantity"`
+	Held      bool   `json:"held"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type CreateWebhook struct {
+	Url        string   `json:"url" validate:"required,http_url"`
+	EventTypes []string `json:"eventTypes" validate:"required,min=1,dive,oneof=ProductCreated ProductUpdated ProductPriceChanged ProductDeleted ProductLowStock"`
+	Secret     string   `json:"secret" validate:"required,min=16"`
+}
+
+// Webhook never carries the secret, only the partner who chose it knows it.
+type Webhook struct {
+	ID         string    `json:"id"`
+	Url        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type WebhookContent struct {
+	Content []Webhook `json:"content"`
+}
+
+type WebhookDeliveryQuery struct {
+	WebhookId string
+	Size      int `validate:"min=1,max=100"`
+}
+
+type WebhookDelivery struct {
+	ID             string          `json:"id"`
+	WebhookId      string          `json:"webhookId"`
+	EventId        string          `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	LastStatusCode int             `json:"lastStatusCode,omitempty"`
+	LastError      string          `json:"lastError,omitempty"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	DeliveredAt    *time.Time      `json:"deliveredAt,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+type WebhookDeliveryContent struct {
+	Content []WebhookDelivery `json:"content"`
+}
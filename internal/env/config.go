@@ -4,22 +4,66 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/caarlos0/env/v9"
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Env            string `env:"ENV" envDefault:"local"`
-	Port           int    `env:"PORT" envDefault:"8080"`
-	DbHost         string `env:"MONGO_HOST"`
-	DbUser         string `env:"MONGO_USER"`
-	DbPassword     string `env:"MONGO_PASS"`
-	DbName         string `env:"MONGO_DB"`
-	DbPort         int    `env:"MONGO_PORT"`
-	CollectionName string `env:"MONGO_COLLECTION"`
-	DbUrl          string `env:"MONGO_URL"`
-	DBUseUrl       bool   `env:"MONGO_USE_URL" envDefault:"false"`
+	Env                           string        `env:"ENV" envDefault:"local"`
+	Port                          int           `env:"PORT" envDefault:"8080"`
+	DbDriver                      string        `env:"DB_DRIVER" envDefault:"mongodb"`
+	SqlDsn                        string        `env:"SQL_DSN" envDefault:"file:tremligeiro.db"`
+	DbHost                        string        `env:"MONGO_HOST"`
+	DbUser                        string        `env:"MONGO_USER"`
+	DbPassword                    string        `env:"MONGO_PASS"`
+	DbName                        string        `env:"MONGO_DB"`
+	DbPort                        int           `env:"MONGO_PORT"`
+	CollectionName                string        `env:"MONGO_COLLECTION"`
+	CategoryCollectionName        string        `env:"MONGO_CATEGORY_COLLECTION" envDefault:"category"`
+	DbUrl                         string        `env:"MONGO_URL"`
+	DBUseUrl                      bool          `env:"MONGO_USE_URL" envDefault:"false"`
+	ProductTrashRetention         time.Duration `env:"PRODUCT_TRASH_RETENTION" envDefault:"720h"`
+	ProductPurgeInterval          time.Duration `env:"PRODUCT_PURGE_INTERVAL" envDefault:"1h"`
+	OutboxCollectionName          string        `env:"MONGO_OUTBOX_COLLECTION" envDefault:"outbox"`
+	EventPublisher                string        `env:"EVENT_PUBLISHER" envDefault:"log"`
+	AwsRegion                     string        `env:"AWS_REGION" envDefault:"us-east-1"`
+	AwsEndpointUrl                string        `env:"AWS_ENDPOINT_URL"`
+	SnsTopicArn                   string        `env:"SNS_TOPIC_ARN"`
+	SqsQueueUrl                   string        `env:"SQS_QUEUE_URL"`
+	KafkaBrokers                  []string      `env:"KAFKA_BROKERS" envSeparator:"," envDefault:"localhost:9092"`
+	KafkaTopic                    string        `env:"KAFKA_TOPIC" envDefault:"tremligeiro.product.events"`
+	OutboxRelayInterval           time.Duration `env:"OUTBOX_RELAY_INTERVAL" envDefault:"5s"`
+	OutboxBatchSize               int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	OutboxRetention               time.Duration `env:"OUTBOX_RETENTION" envDefault:"168h"`
+	WebhookCollectionName         string        `env:"MONGO_WEBHOOK_COLLECTION" envDefault:"webhook"`
+	WebhookDeliveryCollectionName string        `env:"MONGO_WEBHOOK_DELIVERY_COLLECTION" envDefault:"webhook_delivery"`
+	ModifierGroupCollectionName   string        `env:"MONGO_MODIFIER_GROUP_COLLECTION" envDefault:"modifier_group"`
+	StockCollectionName           string        `env:"MONGO_STOCK_COLLECTION" envDefault:"stock"`
+	ReservationCollectionName     string        `env:"MONGO_RESERVATION_COLLECTION" envDefault:"reservation"`
+	AvailabilityLogCollectionName string        `env:"MONGO_AVAILABILITY_LOG_COLLECTION" envDefault:"product_availability_log"`
+	DaypartCollectionName         string        `env:"MONGO_DAYPART_COLLECTION" envDefault:"daypart"`
+	WebhookDeliveryInterval       time.Duration `env:"WEBHOOK_DELIVERY_INTERVAL" envDefault:"5s"`
+	WebhookBatchSize              int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"50"`
+	WebhookTimeout                time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+	WebhookMaxAttempts            int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+	WebhookBackoffBase            time.Duration `env:"WEBHOOK_BACKOFF_BASE" envDefault:"30s"`
+	WebhookBackoffMax             time.Duration `env:"WEBHOOK_BACKOFF_MAX" envDefault:"1h"`
+	WebhookRetention              time.Duration `env:"WEBHOOK_RETENTION" envDefault:"168h"`
+	ReservationTtl                time.Duration `env:"RESERVATION_TTL" envDefault:"15m"`
+	ReservationSweepInterval      time.Duration `env:"RESERVATION_SWEEP_INTERVAL" envDefault:"30s"`
+	ReservationBatchSize          int           `env:"RESERVATION_BATCH_SIZE" envDefault:"100"`
+	MenuTimeZone                  string        `env:"MENU_TIME_ZONE" envDefault:"America/Sao_Paulo"`
+	StorageKind                   string        `env:"STORAGE_KIND" envDefault:"local"`
+	StorageLocalDir               string        `env:"STORAGE_LOCAL_DIR" envDefault:"media"`
+	StoragePublicUrl              string        `env:"STORAGE_PUBLIC_URL" envDefault:"http://localhost:8080/media"`
+	S3Bucket                      string        `env:"S3_BUCKET"`
+	S3EndpointUrl                 string        `env:"S3_ENDPOINT_URL"`
+	S3UsePathStyle                bool          `env:"S3_USE_PATH_STYLE" envDefault:"false"`
+	ImageMaxBytes                 int64         `env:"IMAGE_MAX_BYTES" envDefault:"5242880"`
+	ImageMaxPerProduct            int           `env:"IMAGE_MAX_PER_PRODUCT" envDefault:"10"`
+	ImageThumbnailSizes           []int         `env:"IMAGE_THUMBNAIL_SIZES" envSeparator:"," envDefault:"160,320,640"`
 }
 
 func LoadEnvConfig() (Config, error) {
@@ -5,30 +5,114 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"time"
 
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
 	"github.com/tbtec/tremligeiro/internal/env"
 	"github.com/tbtec/tremligeiro/internal/infra/database/mongodb"
 	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/infra/database/sqldb"
+	"github.com/tbtec/tremligeiro/internal/infra/httpclient"
+	"github.com/tbtec/tremligeiro/internal/infra/job"
+	"github.com/tbtec/tremligeiro/internal/infra/publisher"
+	"github.com/tbtec/tremligeiro/internal/infra/storage"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
 )
 
 type Container struct {
-	Config             env.Config
-	TremLigeiroDB      *mongo.Collection
-	ProductRepository  repository.IProductRepository
-	CategoryRepository repository.ICategoryRepository
+	Config                  env.Config
+	TremLigeiroDB           *mongo.Collection
+	SqlDB                   *gorm.DB
+	ProductRepository       repository.IProductRepository
+	CategoryRepository      repository.ICategoryRepository
+	OutboxRepository        repository.IOutboxRepository
+	WebhookRepository       repository.IWebhookRepository
+	ModifierGroupRepository repository.IModifierGroupRepository
+	StockRepository         repository.IStockRepository
+	ReservationRepository   repository.IReservationRepository
+	AvailabilityRepository  repository.IAvailabilityRepository
+	DaypartRepository       repository.IDaypartRepository
+	Transactor              repository.ITransactor
+	Publisher               gateway.IEventPublisher
+	ImageStorage            gateway.IImageStorage
+	ImagePolicy             entity.ProductImagePolicy
+	MenuLocation            *time.Location
+	Scheduler               *job.Scheduler
+	// Clock is the system clock unless a test fixes the time
+	Clock clock.Clock
 }
 
 func New(config env.Config) (*Container, error) {
 	factory := Container{}
 	factory.Config = config
+	factory.Clock = clock.System
 
 	return &factory, nil
 }
 
+const (
+	DriverMongoDB  = "mongodb"
+	DriverMemory   = "memory"
+	DriverSQLite   = sqldb.DriverSQLite
+	DriverPostgres = sqldb.DriverPostgres
+)
+
 func (container *Container) Start() error {
 
-	err := mongodb.Migrate(getMongoDBConf(container.Config))
+	switch container.Config.DbDriver {
+	case DriverMongoDB:
+		container.startMongoDB()
+	case DriverMemory:
+		container.startMemory()
+	case DriverSQLite, DriverPostgres:
+		if err := container.startSQL(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported DB_DRIVER %q", container.Config.DbDriver)
+	}
+
+	eventPublisher, err := publisher.New(context.Background(), getPublisherConf(container.Config))
+	if err != nil {
+		return err
+	}
+	container.Publisher = eventPublisher
+
+	imageStorage, err := storage.New(context.Background(), getStorageConf(container.Config))
+	if err != nil {
+		return err
+	}
+	container.ImageStorage = imageStorage
+	container.ImagePolicy = getImagePolicy(container.Config)
+
+	container.MenuLocation, err = time.LoadLocation(container.Config.MenuTimeZone)
+	if err != nil {
+		return fmt.Errorf("invalid MENU_TIME_ZONE %q: %w", container.Config.MenuTimeZone, err)
+	}
+
+	container.Scheduler = job.NewScheduler()
+	container.Scheduler.Every(container.Config.ProductPurgeInterval,
+		job.NewProductPurgeJob(container.ProductRepository, container.Config.ProductTrashRetention, container.Clock))
+	container.Scheduler.Every(container.Config.OutboxRelayInterval,
+		job.NewOutboxRelayJob(container.OutboxRepository, container.WebhookRepository, container.Publisher,
+			container.Config.OutboxBatchSize, container.Config.OutboxRetention, container.Clock))
+	container.Scheduler.Every(container.Config.WebhookDeliveryInterval,
+		job.NewWebhookDeliveryJob(container.WebhookRepository, httpclient.NewWebhookSender(container.Config.WebhookTimeout),
+			getWebhookRetryPolicy(container.Config), container.Config.WebhookBatchSize, container.Config.WebhookRetention, container.Clock))
+	container.Scheduler.Every(container.Config.ReservationSweepInterval,
+		job.NewReservationSweepJob(container.ReservationRepository, container.StockRepository, container.Transactor,
+			container.Config.ReservationBatchSize, container.Clock))
+	container.Scheduler.Start(context.Background())
+
+	return nil
+}
+
+func (container *Container) startMongoDB() {
+
+	err := mongodb.Migrate(getMongoDBConf(container.Config), container.Clock)
 	if err != nil {
 		log.Fatalf("Erro ao conectar ao MongoDB: %v", err)
 	}
@@ -43,15 +127,114 @@ func (container *Container) Start() error {
 	slog.InfoContext(context.Background(), "repository.NewProductRepository")
 	container.ProductRepository = repository.NewProductRepository(container.TremLigeiroDB)
 	slog.InfoContext(context.Background(), "repository.NewCategoryRepository")
-	container.CategoryRepository = repository.NewCategoryRepository()
+	container.CategoryRepository = repository.NewCategoryRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.CategoryCollectionName))
+	slog.InfoContext(context.Background(), "repository.NewOutboxRepository")
+	container.OutboxRepository = repository.NewOutboxRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.OutboxCollectionName))
+	slog.InfoContext(context.Background(), "repository.NewWebhookRepository")
+	container.WebhookRepository = repository.NewWebhookRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.WebhookCollectionName),
+		container.TremLigeiroDB.Database().Collection(container.Config.WebhookDeliveryCollectionName))
+	slog.InfoContext(context.Background(), "repository.NewModifierGroupRepository")
+	container.ModifierGroupRepository = repository.NewModifierGroupRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.ModifierGroupCollectionName))
+	slog.InfoContext(context.Background(), "repository.NewStockRepository")
+	container.StockRepository = repository.NewStockRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.StockCollectionName))
+	slog.InfoContext(context.Background(), "repository.NewReservationRepository")
+	container.ReservationRepository = repository.NewReservationRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.ReservationCollectionName))
+	slog.InfoContext(context.Background(), "repository.NewAvailabilityRepository")
+	container.AvailabilityRepository = repository.NewAvailabilityRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.AvailabilityLogCollectionName))
+	slog.InfoContext(context.Background(), "repository.NewDaypartRepository")
+	container.DaypartRepository = repository.NewDaypartRepository(
+		container.TremLigeiroDB.Database().Collection(container.Config.DaypartCollectionName))
+	container.Transactor = repository.NewMongoTransactor(container.TremLigeiroDB.Database().Client())
 
 	slog.InfoContext(context.Background(), fmt.Sprintf("Database start: %s", container.TremLigeiroDB.Name()))
+}
+
+func (container *Container) startSQL() error {
+
+	db, err := sqldb.New(sqldb.SQLConf{Driver: container.Config.DbDriver, Dsn: container.Config.SqlDsn, Clock: container.Clock})
+	if err != nil {
+		return err
+	}
+
+	err = sqldb.Migrate(context.Background(), db)
+	if err != nil {
+		return err
+	}
+
+	container.SqlDB = db
+
+	slog.InfoContext(context.Background(), "repository.NewProductSQLRepository")
+	container.ProductRepository = repository.NewProductSQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewCategorySQLRepository")
+	container.CategoryRepository = repository.NewCategorySQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewOutboxSQLRepository")
+	container.OutboxRepository = repository.NewOutboxSQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewWebhookSQLRepository")
+	container.WebhookRepository = repository.NewWebhookSQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewModifierGroupSQLRepository")
+	container.ModifierGroupRepository = repository.NewModifierGroupSQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewStockSQLRepository")
+	container.StockRepository = repository.NewStockSQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewReservationSQLRepository")
+	container.ReservationRepository = repository.NewReservationSQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewAvailabilitySQLRepository")
+	container.AvailabilityRepository = repository.NewAvailabilitySQLRepository(db)
+	slog.InfoContext(context.Background(), "repository.NewDaypartSQLRepository")
+	container.DaypartRepository = repository.NewDaypartSQLRepository(db)
+	container.Transactor = repository.NewSQLTransactor(db)
 
 	return nil
 }
 
+// startMemory keeps every record in memory, so nothing survives a restart.
+func (container *Container) startMemory() {
+	slog.InfoContext(context.Background(), "repository.NewProductMemoryRepository")
+	container.ProductRepository = repository.NewProductMemoryRepository()
+	slog.InfoContext(context.Background(), "repository.NewCategoryMemoryRepository")
+	container.CategoryRepository = repository.NewCategoryMemoryRepository(container.Clock.Now().UTC())
+	slog.InfoContext(context.Background(), "repository.NewOutboxMemoryRepository")
+	container.OutboxRepository = repository.NewOutboxMemoryRepository()
+	slog.InfoContext(context.Background(), "repository.NewWebhookMemoryRepository")
+	container.WebhookRepository = repository.NewWebhookMemoryRepository()
+	slog.InfoContext(context.Background(), "repository.NewModifierGroupMemoryRepository")
+	container.ModifierGroupRepository = repository.NewModifierGroupMemoryRepository()
+	slog.InfoContext(context.Background(), "repository.NewStockMemoryRepository")
+	container.StockRepository = repository.NewStockMemoryRepository()
+	slog.InfoContext(context.Background(), "repository.NewReservationMemoryRepository")
+	container.ReservationRepository = repository.NewReservationMemoryRepository()
+	slog.InfoContext(context.Background(), "repository.NewAvailabilityMemoryRepository")
+	container.AvailabilityRepository = repository.NewAvailabilityMemoryRepository()
+	slog.InfoContext(context.Background(), "repository.NewDaypartMemoryRepository")
+	container.DaypartRepository = repository.NewDaypartMemoryRepository()
+	container.Transactor = repository.NewMemoryTransactor()
+}
+
 func (container *Container) Stop() error {
+	if container.Scheduler != nil {
+		container.Scheduler.Stop()
+	}
+
+	if container.Publisher != nil {
+		if err := publisher.Close(container.Publisher); err != nil {
+			slog.ErrorContext(context.Background(), err.Error())
+		}
+	}
+
+	if container.SqlDB != nil {
+		return sqldb.Close(container.SqlDB)
+	}
+
 	db := container.TremLigeiroDB
+	if db == nil {
+		return nil
+	}
 
 	defer db.Database().Client().Disconnect(context.Background())
 	return nil
@@ -59,13 +242,62 @@ func (container *Container) Stop() error {
 
 func getMongoDBConf(config env.Config) mongodb.MongoConf {
 	return mongodb.MongoConf{
-		User:           config.DbUser,
-		Pass:           config.DbPassword,
-		Url:            config.DbHost,
-		Port:           config.DbPort,
-		DbName:         config.DbName,
-		CollectionName: config.CollectionName,
-		CompleteUrl:    config.DbUrl,
-		UseUrl:         config.DBUseUrl,
+		User:                          config.DbUser,
+		Pass:                          config.DbPassword,
+		Url:                           config.DbHost,
+		Port:                          config.DbPort,
+		DbName:                        config.DbName,
+		CollectionName:                config.CollectionName,
+		CategoryCollectionName:        config.CategoryCollectionName,
+		OutboxCollectionName:          config.OutboxCollectionName,
+		WebhookCollectionName:         config.WebhookCollectionName,
+		WebhookDeliveryCollectionName: config.WebhookDeliveryCollectionName,
+		ModifierGroupCollectionName:   config.ModifierGroupCollectionName,
+		StockCollectionName:           config.StockCollectionName,
+		ReservationCollectionName:     config.ReservationCollectionName,
+		AvailabilityLogCollectionName: config.AvailabilityLogCollectionName,
+		DaypartCollectionName:         config.DaypartCollectionName,
+		CompleteUrl:                   config.DbUrl,
+		UseUrl:                        config.DBUseUrl,
+	}
+}
+
+func getPublisherConf(config env.Config) publisher.PublisherConf {
+	return publisher.PublisherConf{
+		Kind:           config.EventPublisher,
+		AwsRegion:      config.AwsRegion,
+		AwsEndpointUrl: config.AwsEndpointUrl,
+		SnsTopicArn:    config.SnsTopicArn,
+		SqsQueueUrl:    config.SqsQueueUrl,
+		KafkaBrokers:   config.KafkaBrokers,
+		KafkaTopic:     config.KafkaTopic,
+	}
+}
+
+func getStorageConf(config env.Config) storage.StorageConf {
+	return storage.StorageConf{
+		Kind:           config.StorageKind,
+		LocalDir:       config.StorageLocalDir,
+		PublicUrl:      config.StoragePublicUrl,
+		AwsRegion:      config.AwsRegion,
+		S3EndpointUrl:  config.S3EndpointUrl,
+		S3Bucket:       config.S3Bucket,
+		S3UsePathStyle: config.S3UsePathStyle,
+	}
+}
+
+func getImagePolicy(config env.Config) entity.ProductImagePolicy {
+	return entity.ProductImagePolicy{
+		MaxBytes:       config.ImageMaxBytes,
+		MaxImages:      config.ImageMaxPerProduct,
+		ThumbnailSizes: config.ImageThumbnailSizes,
+	}
+}
+
+func getWebhookRetryPolicy(config env.Config) entity.WebhookRetryPolicy {
+	return entity.WebhookRetryPolicy{
+		MaxAttempts: config.WebhookMaxAttempts,
+		BaseDelay:   config.WebhookBackoffBase,
+		MaxDelay:    config.WebhookBackoffMax,
 	}
 }
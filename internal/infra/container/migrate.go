@@ -0,0 +1,73 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/migration"
+	"github.com/tbtec/tremligeiro/internal/infra/database/mongodb"
+	"github.com/tbtec/tremligeiro/internal/infra/database/sqldb"
+)
+
+// Migrate runs a migration action against the configured database without starting the
+// application, down reverts the latest steps migrations. It returns the resulting status.
+func (container *Container) Migrate(ctx context.Context, action string, steps int) ([]migration.State, error) {
+	switch container.Config.DbDriver {
+	case DriverMongoDB:
+		return container.migrateMongoDB(ctx, action, steps)
+	case DriverSQLite, DriverPostgres:
+		return container.migrateSQL(ctx, action, steps)
+	default:
+		return nil, fmt.Errorf("DB_DRIVER %q has no migrations", container.Config.DbDriver)
+	}
+}
+
+func (container *Container) migrateMongoDB(ctx context.Context, action string, steps int) ([]migration.State, error) {
+	conf := getMongoDBConf(container.Config)
+
+	client, err := mongodb.Connect(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Disconnect(ctx)
+
+	schema := mongodb.NewSchema(client, conf, container.Clock)
+
+	switch action {
+	case migration.ActionUp:
+		err = mongodb.MigrateUp(ctx, schema)
+	case migration.ActionDown:
+		err = mongodb.MigrateDown(ctx, schema, steps)
+	case migration.ActionStatus:
+	default:
+		err = fmt.Errorf("unknown migration action %q", action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mongodb.MigrationStatus(ctx, schema)
+}
+
+func (container *Container) migrateSQL(ctx context.Context, action string, steps int) ([]migration.State, error) {
+	db, err := sqldb.New(sqldb.SQLConf{Driver: container.Config.DbDriver, Dsn: container.Config.SqlDsn, Clock: container.Clock})
+	if err != nil {
+		return nil, err
+	}
+	defer sqldb.Close(db)
+
+	switch action {
+	case migration.ActionUp:
+		err = sqldb.Migrate(ctx, db)
+	case migration.ActionDown:
+		err = sqldb.MigrateDown(ctx, db, steps)
+	case migration.ActionStatus:
+	default:
+		err = fmt.Errorf("unknown migration action %q", action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sqldb.MigrationStatus(ctx, db)
+}
@@ -0,0 +1,19 @@
+package migration
+
+import "time"
+
+const (
+	ActionUp     = "up"
+	ActionDown   = "down"
+	ActionStatus = "status"
+)
+
+// TableName is where every backend records the migrations applied to it.
+const TableName = "schema_migrations"
+
+// State tells whether a known migration was applied and when.
+type State struct {
+	Version     int
+	Description string
+	AppliedAt   *time.Time
+}
@@ -1,6 +1,24 @@
 package model
 
+import "time"
+
 type Category struct {
-	ID   int
-	Name string
+	ID        int       `gorm:"column:category_id;primaryKey"`
+	Name      string    `gorm:"column:name"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (Category) TableName() string {
+	return "category"
+}
+
+// DefaultCategories is the catalog seeded on an empty database.
+func DefaultCategories() []Category {
+	return []Category{
+		{ID: 1, Name: "Lanche"},
+		{ID: 2, Name: "Acompanhamento"},
+		{ID: 3, Name: "Bebida"},
+		{ID: 4, Name: "Sobremesa"},
+	}
 }
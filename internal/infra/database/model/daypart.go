@@ -0,0 +1,66 @@
+package model
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+type Daypart struct {
+	ID          string            `gorm:"column:daypart_id;primaryKey"`
+	Name        string            `gorm:"column:name"`
+	Schedules   DaypartSchedules  `gorm:"column:schedules"`
+	ProductIds  DaypartProducts   `gorm:"column:product_ids"`
+	CategoryIds DaypartCategories `gorm:"column:category_ids"`
+	CreatedAt   time.Time         `gorm:"column:created_at"`
+	UpdatedAt   time.Time         `gorm:"column:updated_at"`
+}
+
+func (Daypart) TableName() string {
+	return "daypart"
+}
+
+// DaypartSchedule keeps the weekdays as numbers, Sunday is zero.
+type DaypartSchedule struct {
+	Weekdays []int
+	Start    int
+	End      int
+}
+
+type DaypartSchedules []DaypartSchedule
+
+func (schedules DaypartSchedules) Value() (driver.Value, error) {
+	if schedules == nil {
+		return "[]", nil
+	}
+	return jsonValue(schedules)
+}
+
+func (schedules *DaypartSchedules) Scan(value any) error {
+	return scanJSON(value, schedules)
+}
+
+type DaypartProducts []string
+
+func (ids DaypartProducts) Value() (driver.Value, error) {
+	if ids == nil {
+		return "[]", nil
+	}
+	return jsonValue(ids)
+}
+
+func (ids *DaypartProducts) Scan(value any) error {
+	return scanJSON(value, ids)
+}
+
+type DaypartCategories []int
+
+func (ids DaypartCategories) Value() (driver.Value, error) {
+	if ids == nil {
+		return "[]", nil
+	}
+	return jsonValue(ids)
+}
+
+func (ids *DaypartCategories) Scan(value any) error {
+	return scanJSON(value, ids)
+}
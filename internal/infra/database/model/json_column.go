@@ -0,0 +1,31 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonValue and scanJSON keep the values embedded in the product document as JSON columns in SQL.
+
+func jsonValue(value any) (driver.Value, error) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(content), nil
+}
+
+func scanJSON(value any, dest any) error {
+	switch content := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(content), dest)
+	case []byte:
+		return json.Unmarshal(content, dest)
+	default:
+		return fmt.Errorf("unsupported JSON column value %T", value)
+	}
+}
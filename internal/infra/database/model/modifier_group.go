@@ -0,0 +1,54 @@
+package model
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+type ModifierGroup struct {
+	ID            string          `gorm:"column:modifier_group_id;primaryKey"`
+	Name          string          `gorm:"column:name"`
+	Required      bool            `gorm:"column:required"`
+	MinSelections int             `gorm:"column:min_selections"`
+	MaxSelections int             `gorm:"column:max_selections"`
+	Options       ModifierOptions `gorm:"column:options"`
+	CreatedAt     time.Time       `gorm:"column:created_at"`
+	UpdatedAt     time.Time       `gorm:"column:updated_at"`
+}
+
+func (ModifierGroup) TableName() string {
+	return "modifier_group"
+}
+
+type ModifierOption struct {
+	ID     string
+	Name   string
+	Amount float64
+}
+
+type ModifierOptions []ModifierOption
+
+func (options ModifierOptions) Value() (driver.Value, error) {
+	if options == nil {
+		return "[]", nil
+	}
+	return jsonValue(options)
+}
+
+func (options *ModifierOptions) Scan(value any) error {
+	return scanJSON(value, options)
+}
+
+// ModifierGroups are the copies of the groups attached to a product, embedded like its images.
+type ModifierGroups []ModifierGroup
+
+func (groups ModifierGroups) Value() (driver.Value, error) {
+	if groups == nil {
+		return "[]", nil
+	}
+	return jsonValue(groups)
+}
+
+func (groups *ModifierGroups) Scan(value any) error {
+	return scanJSON(value, groups)
+}
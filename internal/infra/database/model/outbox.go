@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// OutboxEvent is a domain event saved together with the change that raised it,
+// waiting to be published. Payload holds the event as JSON.
+type OutboxEvent struct {
+	ID          string     `gorm:"column:event_id;primaryKey"`
+	Type        string     `gorm:"column:type"`
+	AggregateId string     `gorm:"column:aggregate_id"`
+	Payload     string     `gorm:"column:payload"`
+	OccurredAt  time.Time  `gorm:"column:occurred_at"`
+	Attempts    int        `gorm:"column:attempts"`
+	LastError   string     `gorm:"column:last_error"`
+	PublishedAt *time.Time `gorm:"column:published_at"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox"
+}
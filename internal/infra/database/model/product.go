@@ -3,11 +3,41 @@ package model
 import "time"
 
 type Product struct {
-	ID          string    `gorm:"column:product_id;primaryKey"`
-	Name        string    `gorm:"column:name"`
-	Description string    `gorm:"column:description"`
-	CategoryId  int       `gorm:"column:category_id"`
-	Amount      float64   `gorm:"column:amount"`
-	CreatedAt   time.Time `gorm:"column:created_at"`
-	UpdatedAt   time.Time `gorm:"column:updated_at"`
+	ID          string        `gorm:"column:product_id;primaryKey"`
+	Name        string        `gorm:"column:name"`
+	Description string        `gorm:"column:description"`
+	CategoryId  int           `gorm:"column:category_id"`
+	Amount      float64       `gorm:"column:amount"`
+	Version     int64         `gorm:"column:version"`
+	CreatedAt   time.Time     `gorm:"column:created_at"`
+	UpdatedAt   time.Time     `gorm:"column:updated_at"`
+	DeletedAt   *time.Time    `gorm:"column:deleted_at"`
+	Images      ProductImages `gorm:"column:images"`
+	// VariantsEnabled products are sold as one of their Variants, never without any
+	VariantsEnabled bool             `gorm:"column:variants_enabled"`
+	Variants        ProductVariants  `gorm:"column:variants"`
+	ModifierGroups  ModifierGroups   `gorm:"column:modifier_groups"`
+	Components      BundleComponents `gorm:"column:components"`
+	// UnavailableSince is set while the product is marked unavailable, until UnavailableUntil when given
+	UnavailableSince  *time.Time `gorm:"column:unavailable_since"`
+	UnavailableUntil  *time.Time `gorm:"column:unavailable_until"`
+	UnavailableReason string     `gorm:"column:unavailable_reason"`
+	UnavailableBy     string     `gorm:"column:unavailable_by"`
+}
+
+func (Product) TableName() string {
+	return "product"
+}
+
+// ProductWrite is one write of a bulk, the replacement of the product by Product or, when Delete
+// is set, its move to the trash. Either only applies while the product is at Product.Version.
+type ProductWrite struct {
+	Product Product
+	Delete  bool
+}
+
+// ProductMatch is a product found by a text search along with its relevance.
+type ProductMatch struct {
+	Product `bson:",inline"`
+	Score   float64 `bson:"score"`
 }
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// ProductAvailabilityChange is the audit log of the availability toggles of the products.
+type ProductAvailabilityChange struct {
+	ID        string     `gorm:"column:change_id;primaryKey"`
+	ProductId string     `gorm:"column:product_id"`
+	Available bool       `gorm:"column:available"`
+	Reason    string     `gorm:"column:reason"`
+	RestoreAt *time.Time `gorm:"column:restore_at"`
+	ChangedBy string     `gorm:"column:changed_by"`
+	ChangedAt time.Time  `gorm:"column:changed_at"`
+}
+
+func (ProductAvailabilityChange) TableName() string {
+	return "product_availability_log"
+}
@@ -0,0 +1,23 @@
+package model
+
+import "database/sql/driver"
+
+type BundleComponent struct {
+	ProductId string
+	Quantity  int
+	Swappable bool
+}
+
+// BundleComponents are embedded in the product document like the variants.
+type BundleComponents []BundleComponent
+
+func (components BundleComponents) Value() (driver.Value, error) {
+	if components == nil {
+		return "[]", nil
+	}
+	return jsonValue(components)
+}
+
+func (components *BundleComponents) Scan(value any) error {
+	return scanJSON(value, components)
+}
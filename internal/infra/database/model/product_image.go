@@ -0,0 +1,41 @@
+package model
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+type ProductImage struct {
+	ID          string
+	ContentType string
+	Width       int
+	Height      int
+	Size        int64
+	Key         string
+	Url         string
+	Thumbnails  []ProductThumbnail
+	CreatedAt   time.Time
+}
+
+type ProductThumbnail struct {
+	Size   int
+	Width  int
+	Height int
+	Key    string
+	Url    string
+}
+
+// ProductImages are embedded in the product document, SQL keeps them as a JSON column.
+// Being a driver.Valuer, they can go in the maps of the SQL updates.
+type ProductImages []ProductImage
+
+func (images ProductImages) Value() (driver.Value, error) {
+	if images == nil {
+		return "[]", nil
+	}
+	return jsonValue(images)
+}
+
+func (images *ProductImages) Scan(value any) error {
+	return scanJSON(value, images)
+}
@@ -0,0 +1,24 @@
+package model
+
+import "database/sql/driver"
+
+type ProductVariant struct {
+	ID     string
+	Sku    string
+	Label  string
+	Amount float64
+}
+
+// ProductVariants are embedded in the product document like the images.
+type ProductVariants []ProductVariant
+
+func (variants ProductVariants) Value() (driver.Value, error) {
+	if variants == nil {
+		return "[]", nil
+	}
+	return jsonValue(variants)
+}
+
+func (variants *ProductVariants) Scan(value any) error {
+	return scanJSON(value, variants)
+}
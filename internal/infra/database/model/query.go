@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+const (
+	SortByName      = "name"
+	SortByAmount    = "amount"
+	SortByCreatedAt = "createdAt"
+)
+
+// ProductQuery describes which products to read and how to page through them.
+// Every filter left at its zero value is ignored, and a zero Size returns every matching product.
+// Deleted products are only read when Trashed is set, and then no other product is.
+type ProductQuery struct {
+	CategoryIds  []int
+	MinAmount    *float64
+	MaxAmount    *float64
+	CreatedAfter *time.Time
+	UpdatedAfter *time.Time
+	NamePrefix   string
+	Page         int
+	Size         int
+	Sort         string
+	Descending   bool
+	Trashed      bool
+	// AvailableAt only reads the products not marked unavailable at that instant,
+	// UnavailableAt only the ones marked unavailable then
+	AvailableAt   *time.Time
+	UnavailableAt *time.Time
+	ExcludeIds    []string
+}
+
+// Offset returns how many products precede the requested page.
+func (query ProductQuery) Offset() int {
+	if query.Page < 1 || query.Size < 1 {
+		return 0
+	}
+	return (query.Page - 1) * query.Size
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// Reservation statuses the repositories filter on.
+const (
+	ReservationPending = "PENDING"
+)
+
+// Reservation holds units of several products, SQL stores its Items as a JSON array.
+type Reservation struct {
+	ID        string           `gorm:"column:reservation_id;primaryKey"`
+	Items     ReservationItems `gorm:"column:items"`
+	Status    string           `gorm:"column:status"`
+	ExpiresAt time.Time        `gorm:"column:expires_at"`
+	CreatedAt time.Time        `gorm:"column:created_at"`
+	UpdatedAt time.Time        `gorm:"column:updated_at"`
+}
+
+func (Reservation) TableName() string {
+	return "reservation"
+}
+
+type ReservationItem struct {
+	ProductId string
+	Quantity  int
+	Held      bool
+}
+
+type ReservationItems []ReservationItem
+
+func (items ReservationItems) Value() (driver.Value, error) {
+	if items == nil {
+		return "[]", nil
+	}
+	return jsonValue(items)
+}
+
+func (items *ReservationItems) Scan(value any) error {
+	return scanJSON(value, items)
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Stock is the stock of a product, kept apart from the product so that selling does not
+// conflict with the catalog changes.
+type Stock struct {
+	ProductId         string    `gorm:"column:product_id;primaryKey"`
+	OnHand            int       `gorm:"column:on_hand"`
+	Reserved          int       `gorm:"column:reserved"`
+	LowStockThreshold int       `gorm:"column:low_stock_threshold"`
+	UpdatedAt         time.Time `gorm:"column:updated_at"`
+}
+
+func (Stock) TableName() string {
+	return "stock"
+}
@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// Webhook is a subscription to product events, SQL stores EventTypes as a JSON array.
+type Webhook struct {
+	ID         string    `gorm:"column:webhook_id;primaryKey"`
+	Url        string    `gorm:"column:url"`
+	EventTypes []string  `gorm:"column:event_types;serializer:json"`
+	Secret     string    `gorm:"column:secret"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (Webhook) TableName() string {
+	return "webhook"
+}
+
+// Delivery statuses the repositories filter on.
+const (
+	WebhookDeliveryPending   = "PENDING"
+	WebhookDeliveryDelivered = "DELIVERED"
+)
+
+// WebhookDelivery is the delivery log of one event to one webhook. Payload holds the CloudEvent as JSON.
+type WebhookDelivery struct {
+	ID             string     `gorm:"column:delivery_id;primaryKey"`
+	WebhookId      string     `gorm:"column:webhook_id"`
+	EventId        string     `gorm:"column:event_id"`
+	EventType      string     `gorm:"column:event_type"`
+	Payload        string     `gorm:"column:payload"`
+	Status         string     `gorm:"column:status"`
+	Attempts       int        `gorm:"column:attempts"`
+	LastStatusCode int        `gorm:"column:last_status_code"`
+	LastError      string     `gorm:"column:last_error"`
+	NextAttemptAt  time.Time  `gorm:"column:next_attempt_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+	DeliveredAt    *time.Time `gorm:"column:delivered_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_delivery"
+}
+
+// WebhookDeliveryQuery filters the delivery log, empty attributes match every delivery
+// and a zero Limit returns all of them.
+type WebhookDeliveryQuery struct {
+	WebhookId string
+	Status    string
+	Limit     int
+}
@@ -0,0 +1,187 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/migration"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Migration is one versioned schema step. Steps must be idempotent: Mongo cannot run index
+// or collection changes in a transaction, so a step may run again if recording it failed.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, schema Schema) error
+	Down        func(ctx context.Context, schema Schema) error
+}
+
+// Schema gives the migrations access to the collections they change.
+type Schema struct {
+	Database   *mongo.Database
+	Products   *mongo.Collection
+	Categories *mongo.Collection
+	Outbox     *mongo.Collection
+	Webhooks   *mongo.Collection
+	// WebhookDeliveries is the delivery log of the webhooks
+	WebhookDeliveries *mongo.Collection
+	ModifierGroups    *mongo.Collection
+	Stock             *mongo.Collection
+	Reservations      *mongo.Collection
+	// AvailabilityLog is the audit of the availability toggles of the products
+	AvailabilityLog *mongo.Collection
+	Dayparts        *mongo.Collection
+	// Clock stamps the documents the migrations write
+	Clock clock.Clock
+}
+
+func NewSchema(client *mongo.Client, conf MongoConf, clock clock.Clock) Schema {
+	database := client.Database(conf.DbName)
+
+	return Schema{
+		Database:          database,
+		Products:          database.Collection(conf.CollectionName),
+		Categories:        database.Collection(conf.CategoryCollectionName),
+		Outbox:            database.Collection(conf.OutboxCollectionName),
+		Webhooks:          database.Collection(conf.WebhookCollectionName),
+		WebhookDeliveries: database.Collection(conf.WebhookDeliveryCollectionName),
+		ModifierGroups:    database.Collection(conf.ModifierGroupCollectionName),
+		Stock:             database.Collection(conf.StockCollectionName),
+		Reservations:      database.Collection(conf.ReservationCollectionName),
+		AvailabilityLog:   database.Collection(conf.AvailabilityLogCollectionName),
+		Dayparts:          database.Collection(conf.DaypartCollectionName),
+		Clock:             clock,
+	}
+}
+
+type appliedMigration struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedat"`
+}
+
+// MigrateUp applies, in version order, every migration not yet recorded in schema_migrations.
+func MigrateUp(ctx context.Context, schema Schema) error {
+	applied, err := appliedMigrations(ctx, schema)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+
+		if err := m.Up(ctx, schema); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.Version, m.Description, err)
+		}
+
+		record := appliedMigration{Version: m.Version, Description: m.Description, AppliedAt: schema.Clock.Now().UTC()}
+		_, err := schema.Database.Collection(migration.TableName).InsertOne(ctx, record)
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("migration %d (%s): %w", m.Version, m.Description, err)
+		}
+
+		slog.InfoContext(ctx, fmt.Sprintf("Migration %d applied: %s", m.Version, m.Description))
+	}
+
+	return nil
+}
+
+// MigrateDown reverts the latest steps applied migrations, newest first.
+func MigrateDown(ctx context.Context, schema Schema, steps int) error {
+	applied, err := appliedMigrations(ctx, schema)
+	if err != nil {
+		return err
+	}
+
+	for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+
+		if err := m.Down(ctx, schema); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.Version, m.Description, err)
+		}
+
+		_, err := schema.Database.Collection(migration.TableName).DeleteOne(ctx, bson.M{"_id": m.Version})
+		if err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.Version, m.Description, err)
+		}
+
+		slog.InfoContext(ctx, fmt.Sprintf("Migration %d reverted: %s", m.Version, m.Description))
+		steps--
+	}
+
+	return nil
+}
+
+// MigrationStatus lists every known migration and when it was applied.
+func MigrationStatus(ctx context.Context, schema Schema) ([]migration.State, error) {
+	applied, err := appliedMigrations(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]migration.State, 0, len(migrations))
+	for _, m := range migrations {
+		state := migration.State{Version: m.Version, Description: m.Description}
+		if record, ok := applied[m.Version]; ok {
+			appliedAt := record.AppliedAt
+			state.AppliedAt = &appliedAt
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+func appliedMigrations(ctx context.Context, schema Schema) (map[int]appliedMigration, error) {
+	cursor, err := schema.Database.Collection(migration.TableName).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []appliedMigration
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]appliedMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+// Server error codes the migrations tolerate.
+const (
+	codeNamespaceNotFound = 26
+	codeIndexNotFound     = 27
+)
+
+// isNamespaceNotFound tells whether a command failed because the collection does not exist.
+func isNamespaceNotFound(err error) bool {
+	var commandErr mongo.CommandError
+	return errors.As(err, &commandErr) && commandErr.Code == codeNamespaceNotFound
+}
+
+// dropIndex removes the named index, ignoring it when the index or the collection is gone.
+func dropIndex(ctx context.Context, collection *mongo.Collection, name string) error {
+	_, err := collection.Indexes().DropOne(ctx, name)
+
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && commandErr.Code == codeIndexNotFound || isNamespaceNotFound(err) {
+		return nil
+	}
+
+	return err
+}
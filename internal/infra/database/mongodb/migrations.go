@@ -0,0 +1,291 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// migrations lists every schema change in version order. Released migrations must never be edited,
+// add a new one instead.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create product text index",
+		Up:          createProductTextIndex,
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Products, "product_text")
+		},
+	},
+	{
+		Version:     2,
+		Description: "seed default categories",
+		Up:          seedCategories,
+		Down: func(ctx context.Context, schema Schema) error {
+			ids := []int{}
+			for _, category := range model.DefaultCategories() {
+				ids = append(ids, category.ID)
+			}
+			_, err := schema.Categories.DeleteMany(ctx, bson.M{"id": bson.M{"$in": ids}})
+			return err
+		},
+	},
+	{
+		Version:     3,
+		Description: "unique index on product id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Products },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("product_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Products, "product_id_unique")
+		},
+	},
+	{
+		Version:     4,
+		Description: "index on product categoryid",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Products },
+			bson.D{{Key: "categoryid", Value: 1}}, options.Index().SetName("product_categoryid")),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Products, "product_categoryid")
+		},
+	},
+	{
+		Version:     5,
+		Description: "unique index on category id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Categories },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("category_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Categories, "category_id_unique")
+		},
+	},
+	{
+		Version:     6,
+		Description: "product document validator",
+		Up:          setProductValidator,
+		Down:        removeProductValidator,
+	},
+	{
+		Version:     7,
+		Description: "index on pending outbox events",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Outbox },
+			bson.D{{Key: "publishedat", Value: 1}, {Key: "occurredat", Value: 1}}, options.Index().SetName("outbox_pending")),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Outbox, "outbox_pending")
+		},
+	},
+	{
+		Version:     8,
+		Description: "unique index on outbox event id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Outbox },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("outbox_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Outbox, "outbox_id_unique")
+		},
+	},
+	{
+		Version:     9,
+		Description: "unique index on webhook id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Webhooks },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("webhook_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Webhooks, "webhook_id_unique")
+		},
+	},
+	{
+		Version:     10,
+		Description: "unique index on webhook delivery id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.WebhookDeliveries },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("webhook_delivery_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.WebhookDeliveries, "webhook_delivery_id_unique")
+		},
+	},
+	{
+		Version:     11,
+		Description: "index on due webhook deliveries",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.WebhookDeliveries },
+			bson.D{{Key: "status", Value: 1}, {Key: "nextattemptat", Value: 1}}, options.Index().SetName("webhook_delivery_due")),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.WebhookDeliveries, "webhook_delivery_due")
+		},
+	},
+	{
+		Version:     12,
+		Description: "index on webhook delivery log",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.WebhookDeliveries },
+			bson.D{{Key: "webhookid", Value: 1}, {Key: "createdat", Value: -1}}, options.Index().SetName("webhook_delivery_webhookid")),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.WebhookDeliveries, "webhook_delivery_webhookid")
+		},
+	},
+	{
+		Version:     13,
+		Description: "unique index on modifier group id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.ModifierGroups },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("modifier_group_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.ModifierGroups, "modifier_group_id_unique")
+		},
+	},
+	{
+		Version:     14,
+		Description: "unique index on stock product id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Stock },
+			bson.D{{Key: "productid", Value: 1}}, options.Index().SetName("stock_productid_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Stock, "stock_productid_unique")
+		},
+	},
+	{
+		Version:     15,
+		Description: "unique index on reservation id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Reservations },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("reservation_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Reservations, "reservation_id_unique")
+		},
+	},
+	{
+		Version:     16,
+		Description: "index on expired reservations",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Reservations },
+			bson.D{{Key: "status", Value: 1}, {Key: "expiresat", Value: 1}}, options.Index().SetName("reservation_expired")),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Reservations, "reservation_expired")
+		},
+	},
+	{
+		Version:     17,
+		Description: "index on product availability log",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.AvailabilityLog },
+			bson.D{{Key: "productid", Value: 1}, {Key: "changedat", Value: -1}}, options.Index().SetName("availability_log_productid")),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.AvailabilityLog, "availability_log_productid")
+		},
+	},
+	{
+		Version:     18,
+		Description: "unique index on daypart id",
+		Up: createIndex(func(schema Schema) *mongo.Collection { return schema.Dayparts },
+			bson.D{{Key: "id", Value: 1}}, options.Index().SetName("daypart_id_unique").SetUnique(true)),
+		Down: func(ctx context.Context, schema Schema) error {
+			return dropIndex(ctx, schema.Dayparts, "daypart_id_unique")
+		},
+	},
+	{
+		Version:     19,
+		Description: "backfill product version",
+		Up:          backfillProductVersion,
+		Down: func(ctx context.Context, schema Schema) error {
+			// version one is how the products without a version were read anyway
+			return nil
+		},
+	},
+}
+
+// createProductTextIndex backs the product search. Text indexes ignore case and diacritics,
+// and the portuguese language enables stemming for our menu names.
+func createProductTextIndex(ctx context.Context, schema Schema) error {
+	index := mongo.IndexModel{
+		Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+		Options: options.Index().
+			SetName("product_text").
+			SetDefaultLanguage("portuguese").
+			SetWeights(bson.D{{Key: "name", Value: 10}, {Key: "description", Value: 2}}),
+	}
+
+	_, err := schema.Products.Indexes().CreateOne(ctx, index)
+
+	return err
+}
+
+// seedCategories inserts the default catalog, keeping categories that already exist untouched.
+func seedCategories(ctx context.Context, schema Schema) error {
+	now := schema.Clock.Now().UTC()
+
+	for _, category := range model.DefaultCategories() {
+		category.CreatedAt = now
+		category.UpdatedAt = now
+
+		_, err := schema.Categories.UpdateOne(ctx,
+			bson.M{"id": category.ID},
+			bson.M{"$setOnInsert": category},
+			options.Update().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillProductVersion sets version one on the products written before versioning.
+func backfillProductVersion(ctx context.Context, schema Schema) error {
+	_, err := schema.Products.UpdateMany(ctx,
+		bson.M{"version": bson.M{"$in": bson.A{0, nil}}},
+		bson.M{"$set": bson.M{"version": 1}})
+
+	return err
+}
+
+// createIndex builds a step creating the index, which is a no-op when an identical one exists.
+func createIndex(collection func(Schema) *mongo.Collection, keys bson.D, opts *options.IndexOptions) func(context.Context, Schema) error {
+	return func(ctx context.Context, schema Schema) error {
+		_, err := collection(schema).Indexes().CreateOne(ctx, mongo.IndexModel{Keys: keys, Options: opts})
+		return err
+	}
+}
+
+// productSchema mirrors model.Product. The moderate level only checks inserts and documents
+// that are already valid, so legacy documents can still be updated.
+func productSchema() bson.M {
+	return bson.M{
+		"bsonType": "object",
+		"required": bson.A{"id", "name", "categoryid", "amount"},
+		"properties": bson.M{
+			"id":          bson.M{"bsonType": "string", "minLength": 1},
+			"name":        bson.M{"bsonType": "string", "minLength": 1},
+			"description": bson.M{"bsonType": "string"},
+			"categoryid":  bson.M{"bsonType": bson.A{"int", "long"}},
+			"amount":      bson.M{"bsonType": bson.A{"double", "int", "long", "decimal"}, "minimum": 0},
+			"version":     bson.M{"bsonType": bson.A{"int", "long"}, "minimum": 0},
+			"createdat":   bson.M{"bsonType": "date"},
+			"updatedat":   bson.M{"bsonType": "date"},
+			"deletedat":   bson.M{"bsonType": bson.A{"date", "null"}},
+		},
+	}
+}
+
+func setProductValidator(ctx context.Context, schema Schema) error {
+	validator := bson.M{"$jsonSchema": productSchema()}
+
+	err := schema.Database.RunCommand(ctx, bson.D{
+		{Key: "collMod", Value: schema.Products.Name()},
+		{Key: "validator", Value: validator},
+		{Key: "validationLevel", Value: "moderate"},
+		{Key: "validationAction", Value: "error"},
+	}).Err()
+	if !isNamespaceNotFound(err) {
+		return err
+	}
+
+	return schema.Database.CreateCollection(ctx, schema.Products.Name(), options.CreateCollection().
+		SetValidator(validator).
+		SetValidationLevel("moderate").
+		SetValidationAction("error"))
+}
+
+func removeProductValidator(ctx context.Context, schema Schema) error {
+	err := schema.Database.RunCommand(ctx, bson.D{
+		{Key: "collMod", Value: schema.Products.Name()},
+		{Key: "validator", Value: bson.M{}},
+		{Key: "validationLevel", Value: "off"},
+	}).Err()
+	if isNamespaceNotFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -5,19 +5,30 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/tbtec/tremligeiro/internal/types/clock"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type MongoConf struct {
-	Url            string
-	DbName         string
-	CollectionName string
-	User           string
-	Pass           string
-	Port           int
-	CompleteUrl    string
-	UseUrl         bool
+	Url                    string
+	DbName                 string
+	CollectionName         string
+	CategoryCollectionName string
+	OutboxCollectionName   string
+	WebhookCollectionName  string
+	// WebhookDeliveryCollectionName holds the delivery log of the webhooks
+	WebhookDeliveryCollectionName string
+	ModifierGroupCollectionName   string
+	StockCollectionName           string
+	ReservationCollectionName     string
+	AvailabilityLogCollectionName string
+	DaypartCollectionName         string
+	User                          string
+	Pass                          string
+	Port                          int
+	CompleteUrl                   string
+	UseUrl                        bool
 }
 
 func New(conf MongoConf) (*mongo.Collection, error) {
@@ -65,25 +76,35 @@ func New(conf MongoConf) (*mongo.Collection, error) {
 	return db.Collection(conf.CollectionName), nil
 }
 
-func Migrate(conf MongoConf) error {
-	//dsn := fmt.Sprintf("mongodb://%s:%s@%s:%d/%s", conf.User, conf.Pass, conf.Url, conf.Port, conf.DbName)
-	dsn := fmt.Sprintf("mongodb://%s:%s@%s:%d/", conf.User, conf.Pass, conf.Url, conf.Port)
-
+// Migrate applies every pending migration, it runs at startup so the schema is always current.
+func Migrate(conf MongoConf, clock clock.Clock) error {
 	slog.InfoContext(context.Background(), "Initializing migrations...")
 
-	clientOptions := options.Client().ApplyURI(dsn)
-
-	client, err := mongo.Connect(context.Background(), clientOptions)
+	client, err := Connect(conf)
 	if err != nil {
 		slog.ErrorContext(context.Background(), err.Error())
 		return err
 	}
+	defer client.Disconnect(context.Background())
 
-	database := client.Database(conf.DbName)
-
-	database.Collection(conf.CollectionName)
+	err = MigrateUp(context.Background(), NewSchema(client, conf, clock))
+	if err != nil {
+		slog.ErrorContext(context.Background(), err.Error())
+		return err
+	}
 
 	slog.InfoContext(context.Background(), "Finished migrations")
 
 	return nil
 }
+
+// Connect opens a client for administrative tasks such as migrations.
+func Connect(conf MongoConf) (*mongo.Client, error) {
+	dsn := conf.CompleteUrl
+	if !conf.UseUrl {
+		//dsn := fmt.Sprintf("mongodb://%s:%s@%s:%d/%s", conf.User, conf.Pass, conf.Url, conf.Port, conf.DbName)
+		dsn = fmt.Sprintf("mongodb://%s:%s@%s:%d/", conf.User, conf.Pass, conf.Url, conf.Port)
+	}
+
+	return mongo.Connect(context.Background(), options.Client().ApplyURI(dsn))
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IAvailabilityRepository keeps the audit log of the availability toggles, the availability
+// itself is saved with the product.
+type IAvailabilityRepository interface {
+	AddChange(ctx context.Context, change *model.ProductAvailabilityChange) error
+	// FindChanges returns the last changes of the availability of the product, newest first.
+	FindChanges(ctx context.Context, productId string, limit int) (*[]model.ProductAvailabilityChange, error)
+}
+
+type AvailabilityRepository struct {
+	database *mongo.Collection
+}
+
+func NewAvailabilityRepository(database *mongo.Collection) IAvailabilityRepository {
+	return &AvailabilityRepository{
+		database: database,
+	}
+}
+
+func (repository *AvailabilityRepository) AddChange(ctx context.Context, change *model.ProductAvailabilityChange) error {
+	_, err := repository.database.InsertOne(ctx, change)
+
+	return err
+}
+
+func (repository *AvailabilityRepository) FindChanges(ctx context.Context, productId string, limit int) (*[]model.ProductAvailabilityChange, error) {
+	changes := []model.ProductAvailabilityChange{}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "changedat", Value: -1}, {Key: "id", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := repository.database.Find(ctx, bson.M{"productid": productId}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &changes); err != nil {
+		return nil, err
+	}
+
+	return &changes, nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// AvailabilityMemoryRepository keeps the availability log in memory, in the order it was written.
+type AvailabilityMemoryRepository struct {
+	mutex   sync.Mutex
+	changes []model.ProductAvailabilityChange
+}
+
+func NewAvailabilityMemoryRepository() IAvailabilityRepository {
+	return &AvailabilityMemoryRepository{}
+}
+
+func (repository *AvailabilityMemoryRepository) AddChange(ctx context.Context, change *model.ProductAvailabilityChange) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	repository.changes = append(repository.changes, *change)
+
+	return nil
+}
+
+func (repository *AvailabilityMemoryRepository) FindChanges(ctx context.Context, productId string, limit int) (*[]model.ProductAvailabilityChange, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	changes := []model.ProductAvailabilityChange{}
+	for i := len(repository.changes) - 1; i >= 0 && len(changes) < limit; i-- {
+		if repository.changes[i].ProductId == productId {
+			changes = append(changes, repository.changes[i])
+		}
+	}
+
+	return &changes, nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+)
+
+type AvailabilitySQLRepository struct {
+	db *gorm.DB
+}
+
+func NewAvailabilitySQLRepository(db *gorm.DB) IAvailabilityRepository {
+	return &AvailabilitySQLRepository{
+		db: db,
+	}
+}
+
+func (repository *AvailabilitySQLRepository) AddChange(ctx context.Context, change *model.ProductAvailabilityChange) error {
+	return sqlSession(ctx, repository.db).Create(change).Error
+}
+
+func (repository *AvailabilitySQLRepository) FindChanges(ctx context.Context, productId string, limit int) (*[]model.ProductAvailabilityChange, error) {
+	changes := []model.ProductAvailabilityChange{}
+
+	err := sqlSession(ctx, repository.db).
+		Where("product_id = ?", productId).
+		Order("changed_at DESC").
+		Order("change_id DESC").
+		Limit(limit).
+		Find(&changes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &changes, nil
+}
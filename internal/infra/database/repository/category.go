@@ -1,52 +1,110 @@
 package repository
 
 import (
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-var (
-	Lanche = entity.Category{
-		ID:   1,
-		Name: "Lanche",
-	}
-	Acompanhamento = entity.Category{
-		ID:   2,
-		Name: "Acompanhamento",
+type ICategoryRepository interface {
+	Create(ctx context.Context, category *model.Category) error
+	FindById(ctx context.Context, id int) (*model.Category, error)
+	FindAll(ctx context.Context) (*[]model.Category, error)
+	UpdateById(ctx context.Context, category *model.Category) error
+	DeleteById(ctx context.Context, id int) error
+}
+
+type CategoryRepository struct {
+	database *mongo.Collection
+}
+
+func NewCategoryRepository(database *mongo.Collection) ICategoryRepository {
+	return &CategoryRepository{
+		database: database,
 	}
-	Bebida = entity.Category{
-		ID:   3,
-		Name: "Bebida",
+}
+
+// categoryIdAttempts bounds the retries of Create when concurrent creates pick the same id.
+const categoryIdAttempts = 5
+
+// Create stores the category, assigning the next sequential id when none is given. Two creates
+// may read the same last id, the unique index on id rejects the second one, which tries again
+// with the next id.
+func (repository *CategoryRepository) Create(ctx context.Context, category *model.Category) error {
+
+	if category.ID != 0 {
+		_, err := repository.database.InsertOne(ctx, category)
+		return err
 	}
-	Sobremesa = entity.Category{
-		ID:   4,
-		Name: "Sobremesa",
+
+	var err error
+	for attempt := 0; attempt < categoryIdAttempts; attempt++ {
+		last := model.Category{}
+		err = repository.database.FindOne(ctx, bson.M{}, options.FindOne().SetSort(bson.D{{Key: "id", Value: -1}})).Decode(&last)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return err
+		}
+		category.ID = last.ID + 1
+
+		_, err = repository.database.InsertOne(ctx, category)
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
 	}
-)
 
-var categoryCatalog = []entity.Category{
-	Lanche,
-	Acompanhamento,
-	Bebida,
-	Sobremesa,
+	category.ID = 0
+
+	return err
 }
 
-type ICategoryRepository interface {
-	FindById(id int) *entity.Category
+// FindById returns nil when the category does not exist.
+func (repository *CategoryRepository) FindById(ctx context.Context, id int) (*model.Category, error) {
+	category := &model.Category{}
+
+	err := repository.database.FindOne(ctx, bson.M{"id": id}).Decode(category)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
 }
 
-type CategoryRepository struct {
+func (repository *CategoryRepository) FindAll(ctx context.Context) (*[]model.Category, error) {
+	categories := []model.Category{}
+
+	cursor, err := repository.database.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &categories); err != nil {
+		return nil, err
+	}
+
+	return &categories, nil
 }
 
-func NewCategoryRepository() ICategoryRepository {
-	return &CategoryRepository{}
+func (repository *CategoryRepository) UpdateById(ctx context.Context, category *model.Category) error {
+
+	_, err := repository.database.UpdateOne(
+		ctx,
+		bson.M{"id": category.ID},
+		bson.M{"$set": bson.M{"name": category.Name, "updatedat": category.UpdatedAt}})
+
+	return err
 }
 
-func (repository *CategoryRepository) FindById(id int) *entity.Category {
-	for _, category := range categoryCatalog {
-		if category.ID == id {
-			return &category
-		}
-	}
+func (repository *CategoryRepository) DeleteById(ctx context.Context, id int) error {
+
+	_, err := repository.database.DeleteOne(ctx, bson.M{"id": id})
 
-	return nil
+	return err
 }
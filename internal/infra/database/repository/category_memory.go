@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// CategoryMemoryRepository keeps the categories in memory, starting with the default catalog.
+type CategoryMemoryRepository struct {
+	mutex      sync.RWMutex
+	categories map[int]model.Category
+}
+
+// NewCategoryMemoryRepository starts with the default catalog, created at seededAt.
+func NewCategoryMemoryRepository(seededAt time.Time) ICategoryRepository {
+	repository := &CategoryMemoryRepository{
+		categories: map[int]model.Category{},
+	}
+
+	for _, category := range model.DefaultCategories() {
+		category.CreatedAt = seededAt
+		category.UpdatedAt = seededAt
+		repository.categories[category.ID] = category
+	}
+
+	return repository
+}
+
+// Create stores the category, assigning the next sequential id when none is given.
+func (repository *CategoryMemoryRepository) Create(ctx context.Context, category *model.Category) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if category.ID == 0 {
+		for id := range repository.categories {
+			category.ID = max(category.ID, id)
+		}
+		category.ID++
+	}
+
+	repository.categories[category.ID] = *category
+
+	return nil
+}
+
+// FindById returns nil when the category does not exist.
+func (repository *CategoryMemoryRepository) FindById(ctx context.Context, id int) (*model.Category, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	category, ok := repository.categories[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &category, nil
+}
+
+func (repository *CategoryMemoryRepository) FindAll(ctx context.Context) (*[]model.Category, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	categories := []model.Category{}
+	for _, category := range repository.categories {
+		categories = append(categories, category)
+	}
+
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].ID < categories[j].ID
+	})
+
+	return &categories, nil
+}
+
+func (repository *CategoryMemoryRepository) UpdateById(ctx context.Context, category *model.Category) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	current, ok := repository.categories[category.ID]
+	if !ok {
+		return nil
+	}
+
+	current.Name = category.Name
+	current.UpdatedAt = category.UpdatedAt
+	repository.categories[category.ID] = current
+
+	return nil
+}
+
+func (repository *CategoryMemoryRepository) DeleteById(ctx context.Context, id int) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	delete(repository.categories, id)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+)
+
+type CategorySQLRepository struct {
+	db *gorm.DB
+}
+
+func NewCategorySQLRepository(db *gorm.DB) ICategoryRepository {
+	return &CategorySQLRepository{
+		db: db,
+	}
+}
+
+// Create stores the category, assigning the next sequential id when none is given.
+func (repository *CategorySQLRepository) Create(ctx context.Context, category *model.Category) error {
+	return sqlSession(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if category.ID == 0 {
+			var last int
+			err := tx.Model(&model.Category{}).Select("COALESCE(MAX(category_id), 0)").Scan(&last).Error
+			if err != nil {
+				return err
+			}
+			category.ID = last + 1
+		}
+
+		return tx.Create(category).Error
+	})
+}
+
+// FindById returns nil when the category does not exist.
+func (repository *CategorySQLRepository) FindById(ctx context.Context, id int) (*model.Category, error) {
+	category := &model.Category{}
+
+	err := sqlSession(ctx, repository.db).Where("category_id = ?", id).Take(category).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (repository *CategorySQLRepository) FindAll(ctx context.Context) (*[]model.Category, error) {
+	categories := []model.Category{}
+
+	err := sqlSession(ctx, repository.db).Order("category_id").Find(&categories).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &categories, nil
+}
+
+func (repository *CategorySQLRepository) UpdateById(ctx context.Context, category *model.Category) error {
+	return sqlSession(ctx, repository.db).
+		Model(&model.Category{}).
+		Where("category_id = ?", category.ID).
+		Updates(map[string]any{"name": category.Name, "updated_at": category.UpdatedAt}).Error
+}
+
+func (repository *CategorySQLRepository) DeleteById(ctx context.Context, id int) error {
+	return sqlSession(ctx, repository.db).Where("category_id = ?", id).Delete(&model.Category{}).Error
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IDaypartRepository stores the dayparts. UpdateById and DeleteById return
+// ErrNotFound when there is no such daypart.
+type IDaypartRepository interface {
+	Create(ctx context.Context, daypart *model.Daypart) error
+	FindAll(ctx context.Context) (*[]model.Daypart, error)
+	FindById(ctx context.Context, id string) (*model.Daypart, error)
+	UpdateById(ctx context.Context, daypart *model.Daypart) error
+	DeleteById(ctx context.Context, id string) error
+}
+
+type DaypartRepository struct {
+	database *mongo.Collection
+}
+
+func NewDaypartRepository(database *mongo.Collection) IDaypartRepository {
+	return &DaypartRepository{
+		database: database,
+	}
+}
+
+func (repository *DaypartRepository) Create(ctx context.Context, daypart *model.Daypart) error {
+	_, err := repository.database.InsertOne(ctx, daypart)
+
+	return err
+}
+
+func (repository *DaypartRepository) FindAll(ctx context.Context) (*[]model.Daypart, error) {
+	dayparts := []model.Daypart{}
+
+	cursor, err := repository.database.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &dayparts); err != nil {
+		return nil, err
+	}
+
+	return &dayparts, nil
+}
+
+// FindById returns nil when the daypart does not exist.
+func (repository *DaypartRepository) FindById(ctx context.Context, id string) (*model.Daypart, error) {
+	daypart := &model.Daypart{}
+
+	err := repository.database.FindOne(ctx, bson.M{"id": id}).Decode(daypart)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return daypart, nil
+}
+
+func (repository *DaypartRepository) UpdateById(ctx context.Context, daypart *model.Daypart) error {
+	result, err := repository.database.ReplaceOne(ctx, bson.M{"id": daypart.ID}, daypart)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *DaypartRepository) DeleteById(ctx context.Context, id string) error {
+	result, err := repository.database.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// DaypartMemoryRepository keeps the dayparts in memory.
+type DaypartMemoryRepository struct {
+	mutex    sync.RWMutex
+	dayparts map[string]model.Daypart
+}
+
+func NewDaypartMemoryRepository() IDaypartRepository {
+	return &DaypartMemoryRepository{
+		dayparts: map[string]model.Daypart{},
+	}
+}
+
+func (repository *DaypartMemoryRepository) Create(ctx context.Context, daypart *model.Daypart) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	repository.dayparts[daypart.ID] = copyDaypart(*daypart)
+
+	return nil
+}
+
+func (repository *DaypartMemoryRepository) FindAll(ctx context.Context) (*[]model.Daypart, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	dayparts := []model.Daypart{}
+	for _, daypart := range repository.dayparts {
+		dayparts = append(dayparts, copyDaypart(daypart))
+	}
+
+	sort.Slice(dayparts, func(i, j int) bool {
+		if dayparts[i].Name != dayparts[j].Name {
+			return dayparts[i].Name < dayparts[j].Name
+		}
+		return dayparts[i].ID < dayparts[j].ID
+	})
+
+	return &dayparts, nil
+}
+
+// FindById returns nil when the daypart does not exist.
+func (repository *DaypartMemoryRepository) FindById(ctx context.Context, id string) (*model.Daypart, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	daypart, ok := repository.dayparts[id]
+	if !ok {
+		return nil, nil
+	}
+
+	daypart = copyDaypart(daypart)
+
+	return &daypart, nil
+}
+
+func (repository *DaypartMemoryRepository) UpdateById(ctx context.Context, daypart *model.Daypart) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.dayparts[daypart.ID]; !ok {
+		return ErrNotFound
+	}
+
+	repository.dayparts[daypart.ID] = copyDaypart(*daypart)
+
+	return nil
+}
+
+func (repository *DaypartMemoryRepository) DeleteById(ctx context.Context, id string) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.dayparts[id]; !ok {
+		return ErrNotFound
+	}
+
+	delete(repository.dayparts, id)
+
+	return nil
+}
+
+func copyDaypart(daypart model.Daypart) model.Daypart {
+	schedules := model.DaypartSchedules{}
+	for _, schedule := range daypart.Schedules {
+		schedule.Weekdays = append([]int{}, schedule.Weekdays...)
+		schedules = append(schedules, schedule)
+	}
+	daypart.Schedules = schedules
+	daypart.ProductIds = append(model.DaypartProducts{}, daypart.ProductIds...)
+	daypart.CategoryIds = append(model.DaypartCategories{}, daypart.CategoryIds...)
+	return daypart
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+)
+
+type DaypartSQLRepository struct {
+	db *gorm.DB
+}
+
+func NewDaypartSQLRepository(db *gorm.DB) IDaypartRepository {
+	return &DaypartSQLRepository{
+		db: db,
+	}
+}
+
+func (repository *DaypartSQLRepository) Create(ctx context.Context, daypart *model.Daypart) error {
+	return sqlSession(ctx, repository.db).Create(daypart).Error
+}
+
+func (repository *DaypartSQLRepository) FindAll(ctx context.Context) (*[]model.Daypart, error) {
+	dayparts := []model.Daypart{}
+
+	err := sqlSession(ctx, repository.db).Order("name, daypart_id").Find(&dayparts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &dayparts, nil
+}
+
+// FindById returns nil when the daypart does not exist.
+func (repository *DaypartSQLRepository) FindById(ctx context.Context, id string) (*model.Daypart, error) {
+	daypart := &model.Daypart{}
+
+	err := sqlSession(ctx, repository.db).Where("daypart_id = ?", id).Take(daypart).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return daypart, nil
+}
+
+func (repository *DaypartSQLRepository) UpdateById(ctx context.Context, daypart *model.Daypart) error {
+	result := sqlSession(ctx, repository.db).
+		Model(&model.Daypart{}).
+		Where("daypart_id = ?", daypart.ID).
+		Updates(map[string]any{
+			"name":         daypart.Name,
+			"schedules":    daypart.Schedules,
+			"product_ids":  daypart.ProductIds,
+			"category_ids": daypart.CategoryIds,
+			"updated_at":   daypart.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *DaypartSQLRepository) DeleteById(ctx context.Context, id string) error {
+	result := sqlSession(ctx, repository.db).Where("daypart_id = ?", id).Delete(&model.Daypart{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+// ErrNotFound is returned when the record to read or write does not exist.
+var ErrNotFound = errors.New("record not found")
+
+// ErrVersionConflict is returned when a write expected a version the record no longer has.
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrInsufficientStock is returned when a change of stock would leave fewer units on hand than reserved.
+var ErrInsufficientStock = xerrors.NewBusinessError("TL-STOCK-002", "Not enough stock")
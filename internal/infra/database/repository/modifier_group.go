@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IModifierGroupRepository stores the modifier groups. UpdateById and DeleteById return
+// ErrNotFound when there is no such group.
+type IModifierGroupRepository interface {
+	Create(ctx context.Context, group *model.ModifierGroup) error
+	FindAll(ctx context.Context) (*[]model.ModifierGroup, error)
+	FindById(ctx context.Context, id string) (*model.ModifierGroup, error)
+	UpdateById(ctx context.Context, group *model.ModifierGroup) error
+	DeleteById(ctx context.Context, id string) error
+}
+
+type ModifierGroupRepository struct {
+	database *mongo.Collection
+}
+
+func NewModifierGroupRepository(database *mongo.Collection) IModifierGroupRepository {
+	return &ModifierGroupRepository{
+		database: database,
+	}
+}
+
+func (repository *ModifierGroupRepository) Create(ctx context.Context, group *model.ModifierGroup) error {
+	_, err := repository.database.InsertOne(ctx, group)
+
+	return err
+}
+
+func (repository *ModifierGroupRepository) FindAll(ctx context.Context) (*[]model.ModifierGroup, error) {
+	groups := []model.ModifierGroup{}
+
+	cursor, err := repository.database.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	return &groups, nil
+}
+
+// FindById returns nil when the group does not exist.
+func (repository *ModifierGroupRepository) FindById(ctx context.Context, id string) (*model.ModifierGroup, error) {
+	group := &model.ModifierGroup{}
+
+	err := repository.database.FindOne(ctx, bson.M{"id": id}).Decode(group)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (repository *ModifierGroupRepository) UpdateById(ctx context.Context, group *model.ModifierGroup) error {
+	result, err := repository.database.ReplaceOne(ctx, bson.M{"id": group.ID}, group)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *ModifierGroupRepository) DeleteById(ctx context.Context, id string) error {
+	result, err := repository.database.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
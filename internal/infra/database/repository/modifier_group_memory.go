@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// ModifierGroupMemoryRepository keeps the modifier groups in memory.
+type ModifierGroupMemoryRepository struct {
+	mutex  sync.RWMutex
+	groups map[string]model.ModifierGroup
+}
+
+func NewModifierGroupMemoryRepository() IModifierGroupRepository {
+	return &ModifierGroupMemoryRepository{
+		groups: map[string]model.ModifierGroup{},
+	}
+}
+
+func (repository *ModifierGroupMemoryRepository) Create(ctx context.Context, group *model.ModifierGroup) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	repository.groups[group.ID] = copyModifierGroup(*group)
+
+	return nil
+}
+
+func (repository *ModifierGroupMemoryRepository) FindAll(ctx context.Context) (*[]model.ModifierGroup, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	groups := []model.ModifierGroup{}
+	for _, group := range repository.groups {
+		groups = append(groups, copyModifierGroup(group))
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Name != groups[j].Name {
+			return groups[i].Name < groups[j].Name
+		}
+		return groups[i].ID < groups[j].ID
+	})
+
+	return &groups, nil
+}
+
+// FindById returns nil when the group does not exist.
+func (repository *ModifierGroupMemoryRepository) FindById(ctx context.Context, id string) (*model.ModifierGroup, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	group, ok := repository.groups[id]
+	if !ok {
+		return nil, nil
+	}
+
+	group = copyModifierGroup(group)
+
+	return &group, nil
+}
+
+func (repository *ModifierGroupMemoryRepository) UpdateById(ctx context.Context, group *model.ModifierGroup) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.groups[group.ID]; !ok {
+		return ErrNotFound
+	}
+
+	repository.groups[group.ID] = copyModifierGroup(*group)
+
+	return nil
+}
+
+func (repository *ModifierGroupMemoryRepository) DeleteById(ctx context.Context, id string) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.groups[id]; !ok {
+		return ErrNotFound
+	}
+
+	delete(repository.groups, id)
+
+	return nil
+}
+
+func copyModifierGroup(group model.ModifierGroup) model.ModifierGroup {
+	group.Options = append(model.ModifierOptions{}, group.Options...)
+	return group
+}
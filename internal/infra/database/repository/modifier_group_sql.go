@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+)
+
+type ModifierGroupSQLRepository struct {
+	db *gorm.DB
+}
+
+func NewModifierGroupSQLRepository(db *gorm.DB) IModifierGroupRepository {
+	return &ModifierGroupSQLRepository{
+		db: db,
+	}
+}
+
+func (repository *ModifierGroupSQLRepository) Create(ctx context.Context, group *model.ModifierGroup) error {
+	return sqlSession(ctx, repository.db).Create(group).Error
+}
+
+func (repository *ModifierGroupSQLRepository) FindAll(ctx context.Context) (*[]model.ModifierGroup, error) {
+	groups := []model.ModifierGroup{}
+
+	err := sqlSession(ctx, repository.db).Order("name, modifier_group_id").Find(&groups).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &groups, nil
+}
+
+// FindById returns nil when the group does not exist.
+func (repository *ModifierGroupSQLRepository) FindById(ctx context.Context, id string) (*model.ModifierGroup, error) {
+	group := &model.ModifierGroup{}
+
+	err := sqlSession(ctx, repository.db).Where("modifier_group_id = ?", id).Take(group).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (repository *ModifierGroupSQLRepository) UpdateById(ctx context.Context, group *model.ModifierGroup) error {
+	result := sqlSession(ctx, repository.db).
+		Model(&model.ModifierGroup{}).
+		Where("modifier_group_id = ?", group.ID).
+		Updates(map[string]any{
+			"name":           group.Name,
+			"required":       group.Required,
+			"min_selections": group.MinSelections,
+			"max_selections": group.MaxSelections,
+			"options":        group.Options,
+			"updated_at":     group.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *ModifierGroupSQLRepository) DeleteById(ctx context.Context, id string) error {
+	result := sqlSession(ctx, repository.db).Where("modifier_group_id = ?", id).Delete(&model.ModifierGroup{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
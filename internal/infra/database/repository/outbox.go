@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IOutboxRepository stores the events waiting to be published. Add must run in the
+// same transaction as the change raising the events, see ITransactor.
+type IOutboxRepository interface {
+	Add(ctx context.Context, events ...model.OutboxEvent) error
+	FindPending(ctx context.Context, limit int) (*[]model.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id string, at time.Time) error
+	MarkFailed(ctx context.Context, id string, reason string) error
+	PurgePublished(ctx context.Context, before time.Time) (int64, error)
+}
+
+type OutboxRepository struct {
+	database *mongo.Collection
+}
+
+func NewOutboxRepository(database *mongo.Collection) IOutboxRepository {
+	return &OutboxRepository{
+		database: database,
+	}
+}
+
+func (repository *OutboxRepository) Add(ctx context.Context, events ...model.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	documents := make([]any, 0, len(events))
+	for _, event := range events {
+		documents = append(documents, event)
+	}
+
+	_, err := repository.database.InsertMany(ctx, documents)
+
+	return err
+}
+
+// FindPending returns the events not published yet, oldest first.
+func (repository *OutboxRepository) FindPending(ctx context.Context, limit int) (*[]model.OutboxEvent, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "occurredat", Value: 1}, {Key: "id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := repository.database.Find(ctx, bson.M{"publishedat": nil}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []model.OutboxEvent{}
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+func (repository *OutboxRepository) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	_, err := repository.database.UpdateOne(ctx,
+		bson.M{"id": id},
+		bson.M{"$set": bson.M{"publishedat": at, "lasterror": ""}})
+
+	return err
+}
+
+func (repository *OutboxRepository) MarkFailed(ctx context.Context, id string, reason string) error {
+	_, err := repository.database.UpdateOne(ctx,
+		bson.M{"id": id},
+		bson.M{"$set": bson.M{"lasterror": reason}, "$inc": bson.M{"attempts": 1}})
+
+	return err
+}
+
+// PurgePublished removes the events published before the given instant.
+func (repository *OutboxRepository) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	result, err := repository.database.DeleteMany(ctx, bson.M{"publishedat": bson.M{"$ne": nil, "$lte": before}})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// OutboxMemoryRepository keeps the events in memory, in the order they were added.
+type OutboxMemoryRepository struct {
+	mutex  sync.Mutex
+	events []model.OutboxEvent
+}
+
+func NewOutboxMemoryRepository() IOutboxRepository {
+	return &OutboxMemoryRepository{}
+}
+
+func (repository *OutboxMemoryRepository) Add(ctx context.Context, events ...model.OutboxEvent) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	repository.events = append(repository.events, events...)
+
+	return nil
+}
+
+func (repository *OutboxMemoryRepository) FindPending(ctx context.Context, limit int) (*[]model.OutboxEvent, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	events := []model.OutboxEvent{}
+	for _, event := range repository.events {
+		if len(events) == limit {
+			break
+		}
+		if event.PublishedAt == nil {
+			events = append(events, event)
+		}
+	}
+
+	return &events, nil
+}
+
+func (repository *OutboxMemoryRepository) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	repository.update(id, func(event *model.OutboxEvent) {
+		event.PublishedAt = &at
+		event.LastError = ""
+	})
+
+	return nil
+}
+
+func (repository *OutboxMemoryRepository) MarkFailed(ctx context.Context, id string, reason string) error {
+	repository.update(id, func(event *model.OutboxEvent) {
+		event.Attempts++
+		event.LastError = reason
+	})
+
+	return nil
+}
+
+func (repository *OutboxMemoryRepository) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	kept := repository.events[:0]
+	for _, event := range repository.events {
+		if event.PublishedAt == nil || event.PublishedAt.After(before) {
+			kept = append(kept, event)
+		}
+	}
+
+	purged := int64(len(repository.events) - len(kept))
+	repository.events = kept
+
+	return purged, nil
+}
+
+func (repository *OutboxMemoryRepository) update(id string, change func(event *model.OutboxEvent)) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	for i := range repository.events {
+		if repository.events[i].ID == id {
+			change(&repository.events[i])
+			return
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+)
+
+type OutboxSQLRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxSQLRepository(db *gorm.DB) IOutboxRepository {
+	return &OutboxSQLRepository{
+		db: db,
+	}
+}
+
+func (repository *OutboxSQLRepository) Add(ctx context.Context, events ...model.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return sqlSession(ctx, repository.db).Create(&events).Error
+}
+
+// FindPending returns the events not published yet, oldest first.
+func (repository *OutboxSQLRepository) FindPending(ctx context.Context, limit int) (*[]model.OutboxEvent, error) {
+	events := []model.OutboxEvent{}
+
+	err := sqlSession(ctx, repository.db).
+		Where("published_at IS NULL").
+		Order("occurred_at").
+		Order("event_id").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+func (repository *OutboxSQLRepository) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	return sqlSession(ctx, repository.db).
+		Model(&model.OutboxEvent{}).
+		Where("event_id = ?", id).
+		Updates(map[string]any{"published_at": at, "last_error": ""}).Error
+}
+
+func (repository *OutboxSQLRepository) MarkFailed(ctx context.Context, id string, reason string) error {
+	return sqlSession(ctx, repository.db).
+		Model(&model.OutboxEvent{}).
+		Where("event_id = ?", id).
+		Updates(map[string]any{"attempts": gorm.Expr("attempts + 1"), "last_error": reason}).Error
+}
+
+// PurgePublished removes the events published before the given instant.
+func (repository *OutboxSQLRepository) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	result := sqlSession(ctx, repository.db).
+		Where("published_at IS NOT NULL AND published_at <= ?", before).
+		Delete(&model.OutboxEvent{})
+
+	return result.RowsAffected, result.Error
+}
@@ -2,20 +2,34 @@ package repository
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"errors"
+	"regexp"
+	"time"
 
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type IProductRepository interface {
 	Create(ctx context.Context, product *model.Product) error
 	FindOne(ctx context.Context, id string) (*model.Product, error)
-	FindByCategory(ctx context.Context, id int) (*[]model.Product, error)
-	DeleteById(ctx context.Context, id string) (*model.Product, error)
+	Find(ctx context.Context, query model.ProductQuery) (*[]model.Product, error)
+	Count(ctx context.Context, query model.ProductQuery) (int64, error)
+	// Stream hands every product matching the query to fn, one at a time and ignoring the page,
+	// stopping at the first error fn returns.
+	Stream(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error
+	Search(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error)
+	// DeleteById and Restore stamp the product with at, UpdateById keeps the UpdatedAt it is given.
+	DeleteById(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error)
 	UpdateById(ctx context.Context, product *model.Product) error
+	// BulkWrite applies every write it can, incrementing the version of the products written.
+	// The UpdatedAt of each product is the instant of its write, a delete trashes the product at it.
+	// It returns the error of each write, ErrNotFound or ErrVersionConflict, nil for the ones done.
+	BulkWrite(ctx context.Context, writes []model.ProductWrite) ([]error, error)
+	Restore(ctx context.Context, id string, at time.Time) (*model.Product, error)
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
 }
 
 type ProductRepository struct {
@@ -30,22 +44,18 @@ func NewProductRepository(database *mongo.Collection) IProductRepository {
 
 func (repository *ProductRepository) Create(ctx context.Context, product *model.Product) error {
 
-	//result := repository.database.DB.WithContext(ctx).Create(&product)
-	result, err := repository.database.InsertOne(context.Background(), &product)
+	_, err := repository.database.InsertOne(ctx, product)
 
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	fmt.Print("result: ", result)
-
-	return nil
+	return err
 }
 
 func (repository *ProductRepository) FindOne(ctx context.Context, id string) (*model.Product, error) {
 	product := &model.Product{}
 
-	err := repository.database.FindOne(ctx, bson.M{"id": id}).Decode(&product)
+	err := repository.database.FindOne(ctx, bson.M{"id": id, "deletedat": nil}).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		//log.Fatal(err)
 		return nil, err
@@ -54,52 +64,339 @@ func (repository *ProductRepository) FindOne(ctx context.Context, id string) (*m
 	return product, nil
 }
 
-func (repository *ProductRepository) FindByCategory(ctx context.Context, id int) (*[]model.Product, error) {
+func (repository *ProductRepository) Find(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
 	product := []model.Product{}
 
-	cursor, err := repository.database.Find(ctx, bson.M{"categoryid": id})
+	opts := options.Find().SetSort(productSort(query))
+	if query.Size > 0 {
+		opts.SetSkip(int64(query.Offset())).SetLimit(int64(query.Size))
+	}
+
+	cursor, err := repository.database.Find(ctx, productFilter(query), opts)
 	if err != nil {
-		//TODO
+		return nil, err
 	}
 	defer cursor.Close(ctx)
 
 	if err := cursor.All(ctx, &product); err != nil {
-		//TODO
+		return nil, err
 	}
 
-	fmt.Printf(cursor.Current.String())
-
 	return &product, nil
 }
 
-func (repository *ProductRepository) DeleteById(ctx context.Context, id string) (*model.Product, error) {
-	product := &model.Product{
-		ID: id,
+func (repository *ProductRepository) Count(ctx context.Context, query model.ProductQuery) (int64, error) {
+	return repository.database.CountDocuments(ctx, productFilter(query))
+}
+
+// Stream reads the products from the cursor, so the matches never sit in memory all at once.
+func (repository *ProductRepository) Stream(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error {
+	cursor, err := repository.database.Find(ctx, productFilter(query), options.Find().SetSort(productSort(query)))
+	if err != nil {
+		return err
 	}
+	defer cursor.Close(ctx)
 
-	err := repository.database.FindOneAndDelete(ctx, bson.M{"id": id})
+	for cursor.Next(ctx) {
+		product := model.Product{}
+		if err := cursor.Decode(&product); err != nil {
+			return err
+		}
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
 
-	fmt.Printf(product.ID)
+	return cursor.Err()
+}
+
+// Search runs a text search over name and description among the products matching the query,
+// most relevant products first.
+func (repository *ProductRepository) Search(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+	matches := []model.ProductMatch{}
+
+	score := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}}).
+		SetLimit(int64(limit))
+
+	filter := productFilter(query)
+	filter["$text"] = bson.M{"$search": text}
+
+	cursor, err := repository.database.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, err.Err()
-	} /*else if err. RowsAffected < 1 {
-		return product, fmt.Errorf("record not found")
-	}*/
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &matches); err != nil {
+		return nil, err
+	}
+
+	return &matches, nil
+}
+
+// DeleteById moves the product to the trash only while it still has the given version,
+// zero deletes any version. It returns the product as trashed.
+func (repository *ProductRepository) DeleteById(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+	filter := bson.M{"id": id, "deletedat": nil}
+	if version != 0 {
+		filter = versionFilter(id, version)
+	}
+
+	result := repository.database.FindOneAndUpdate(ctx, filter, bson.M{
+		"$set": bson.M{"deletedat": at, "updatedat": at},
+		"$inc": bson.M{"version": 1},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if result.Err() != nil {
+		return nil, repository.conflictOrMissing(ctx, id, result.Err())
+	}
+
+	product := &model.Product{}
+	if err := result.Decode(product); err != nil {
+		return nil, err
+	}
 
 	return product, nil
 }
 
+// UpdateById replaces the product only while it still has product.Version,
+// which is then incremented to the version saved.
 func (repository *ProductRepository) UpdateById(ctx context.Context, product *model.Product) error {
 
+	expected := product.Version
+	product.Version = expected + 1
+
 	result := repository.database.FindOneAndUpdate(
 		ctx,
-		bson.M{"id": product.ID},
+		versionFilter(product.ID, expected),
 		bson.M{"$set": product})
 
 	if result.Err() != nil {
-		fmt.Printf(result.Err().Error())
-		return result.Err()
+		product.Version = expected
+		return repository.conflictOrMissing(ctx, product.ID, result.Err())
 	}
 
 	return nil
 }
+
+// BulkWrite sends the writes in a single unordered BulkWrite. The result only counts the
+// matches, so when some write missed, the products are read back to tell which. The instants
+// are cut to the milliseconds MongoDB keeps, so they compare equal once read back.
+func (repository *ProductRepository) BulkWrite(ctx context.Context, writes []model.ProductWrite) ([]error, error) {
+	errs := make([]error, len(writes))
+	if len(writes) == 0 {
+		return errs, nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(writes))
+	for i := range writes {
+		product := &writes[i].Product
+		expected := product.Version
+
+		product.Version = expected + 1
+		product.UpdatedAt = product.UpdatedAt.Truncate(time.Millisecond)
+		if writes[i].Delete {
+			deletedAt := product.UpdatedAt
+			product.DeletedAt = &deletedAt
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(versionFilter(product.ID, expected)).
+			SetUpdate(bson.M{"$set": product}))
+	}
+
+	result, err := repository.database.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == int64(len(writes)) {
+		return errs, nil
+	}
+
+	ids := bson.A{}
+	for _, write := range writes {
+		ids = append(ids, write.Product.ID)
+	}
+
+	cursor, err := repository.database.Find(ctx, bson.M{"id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	saved := []model.Product{}
+	if err := cursor.All(ctx, &saved); err != nil {
+		return nil, err
+	}
+
+	current := map[string]model.Product{}
+	for _, product := range saved {
+		current[product.ID] = product
+	}
+
+	for i := range writes {
+		product := &writes[i].Product
+		found, ok := current[product.ID]
+
+		// a write that matched left its version and instant, unless some other write came after it
+		if ok && found.Version == product.Version && found.UpdatedAt.Equal(product.UpdatedAt) && (found.DeletedAt != nil) == writes[i].Delete {
+			continue
+		}
+
+		product.Version--
+		product.DeletedAt = nil
+		errs[i] = ErrNotFound
+		if ok && found.DeletedAt == nil {
+			errs[i] = ErrVersionConflict
+		}
+	}
+
+	return errs, nil
+}
+
+// conflictOrMissing tells a version mismatch apart from a product that does not exist,
+// translating the driver error into ErrVersionConflict or ErrNotFound.
+func (repository *ProductRepository) conflictOrMissing(ctx context.Context, id string, err error) error {
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+
+	count, countErr := repository.database.CountDocuments(ctx, bson.M{"id": id, "deletedat": nil})
+	if countErr != nil {
+		return countErr
+	}
+	if count > 0 {
+		return ErrVersionConflict
+	}
+
+	return ErrNotFound
+}
+
+// Restore takes the product out of the trash, returning ErrNotFound when it is not there.
+func (repository *ProductRepository) Restore(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+	product := &model.Product{}
+
+	err := repository.database.FindOneAndUpdate(ctx,
+		bson.M{"id": id, "deletedat": bson.M{"$ne": nil}},
+		bson.M{
+			"$set": bson.M{"deletedat": nil, "updatedat": at},
+			"$inc": bson.M{"version": 1},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// PurgeDeleted permanently removes the products deleted before the given instant.
+func (repository *ProductRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	result, err := repository.database.DeleteMany(ctx, bson.M{"deletedat": bson.M{"$ne": nil, "$lte": before}})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
+
+// versionFilter matches the live product at the given version, documents written
+// before versioning have no version field and count as version one.
+func versionFilter(id string, version int64) bson.M {
+	if version <= 1 {
+		return bson.M{"id": id, "deletedat": nil, "version": bson.M{"$in": bson.A{1, 0, nil}}}
+	}
+	return bson.M{"id": id, "deletedat": nil, "version": version}
+}
+
+// storedVersions lists the stored versions a write expecting the given version matches.
+// Products written before versioning are stored at version zero and read as version one.
+func storedVersions(version int64) []int64 {
+	if version <= 1 {
+		return []int64{1, 0}
+	}
+	return []int64{version}
+}
+
+// productFilter combines every filter of the query into a single Mongo query document.
+func productFilter(query model.ProductQuery) bson.M {
+	filter := bson.M{"deletedat": nil}
+	if query.Trashed {
+		filter["deletedat"] = bson.M{"$ne": nil}
+	}
+
+	if len(query.CategoryIds) > 0 {
+		filter["categoryid"] = bson.M{"$in": query.CategoryIds}
+	}
+
+	amount := bson.M{}
+	if query.MinAmount != nil {
+		amount["$gte"] = *query.MinAmount
+	}
+	if query.MaxAmount != nil {
+		amount["$lte"] = *query.MaxAmount
+	}
+	if len(amount) > 0 {
+		filter["amount"] = amount
+	}
+
+	if query.CreatedAfter != nil {
+		filter["createdat"] = bson.M{"$gt": *query.CreatedAfter}
+	}
+	if query.UpdatedAfter != nil {
+		filter["updatedat"] = bson.M{"$gt": *query.UpdatedAfter}
+	}
+	if query.NamePrefix != "" {
+		filter["name"] = bson.M{"$regex": "^" + regexp.QuoteMeta(query.NamePrefix), "$options": "i"}
+	}
+
+	// each availability condition is an $or of its own, kept apart under $and
+	availability := bson.A{}
+	if query.AvailableAt != nil {
+		availability = append(availability, bson.M{"$or": bson.A{
+			bson.M{"unavailablesince": nil},
+			bson.M{"unavailableuntil": bson.M{"$lte": *query.AvailableAt}},
+		}})
+	}
+	if query.UnavailableAt != nil {
+		availability = append(availability, bson.M{"unavailablesince": bson.M{"$ne": nil}}, bson.M{"$or": bson.A{
+			bson.M{"unavailableuntil": nil},
+			bson.M{"unavailableuntil": bson.M{"$gt": *query.UnavailableAt}},
+		}})
+	}
+	if len(availability) > 0 {
+		filter["$and"] = availability
+	}
+
+	if len(query.ExcludeIds) > 0 {
+		filter["id"] = bson.M{"$nin": query.ExcludeIds}
+	}
+
+	return filter
+}
+
+var productSortFields = map[string]string{
+	model.SortByName:      "name",
+	model.SortByAmount:    "amount",
+	model.SortByCreatedAt: "createdat",
+}
+
+// productSort orders by the requested field, breaking ties by id so pages never overlap.
+func productSort(query model.ProductQuery) bson.D {
+	direction := 1
+	if query.Descending {
+		direction = -1
+	}
+
+	field, ok := productSortFields[query.Sort]
+	if !ok {
+		field = productSortFields[model.SortByCreatedAt]
+	}
+
+	return bson.D{{Key: field, Value: direction}, {Key: "id", Value: direction}}
+}
@@ -0,0 +1,358 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// ProductMemoryRepository keeps the products in memory, for local runs and tests without a database.
+// It honours the same query, versioning and trash rules as the Mongo repository.
+type ProductMemoryRepository struct {
+	mutex    sync.RWMutex
+	products map[string]model.Product
+}
+
+func NewProductMemoryRepository() IProductRepository {
+	return &ProductMemoryRepository{
+		products: map[string]model.Product{},
+	}
+}
+
+func (repository *ProductMemoryRepository) Create(ctx context.Context, product *model.Product) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.products[product.ID]; ok {
+		return fmt.Errorf("product %s already exists", product.ID)
+	}
+
+	repository.products[product.ID] = copyProduct(*product)
+
+	return nil
+}
+
+func (repository *ProductMemoryRepository) FindOne(ctx context.Context, id string) (*model.Product, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	product, ok := repository.products[id]
+	if !ok || product.DeletedAt != nil {
+		return nil, ErrNotFound
+	}
+
+	found := copyProduct(product)
+
+	return &found, nil
+}
+
+func (repository *ProductMemoryRepository) Find(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	products := repository.filter(query)
+	sortProducts(products, query)
+
+	if query.Size > 0 {
+		start := min(query.Offset(), len(products))
+		end := min(start+query.Size, len(products))
+		products = products[start:end]
+	}
+
+	return &products, nil
+}
+
+func (repository *ProductMemoryRepository) Count(ctx context.Context, query model.ProductQuery) (int64, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	return int64(len(repository.filter(query))), nil
+}
+
+// Stream hands over a snapshot, so fn may change the repository.
+func (repository *ProductMemoryRepository) Stream(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error {
+	repository.mutex.RLock()
+	products := repository.filter(query)
+	repository.mutex.RUnlock()
+
+	sortProducts(products, query)
+
+	for _, product := range products {
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Search follows the Mongo text search rules, see textSearch.
+func (repository *ProductMemoryRepository) Search(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	search := parseTextSearch(text)
+	matches := []model.ProductMatch{}
+
+	for _, product := range repository.products {
+		if !matchesQuery(product, query) {
+			continue
+		}
+		score, ok := search.score(product)
+		if !ok {
+			continue
+		}
+		matches = append(matches, model.ProductMatch{Product: copyProduct(product), Score: score})
+	}
+
+	matches = rankMatches(matches, limit)
+
+	return &matches, nil
+}
+
+// DeleteById moves the product to the trash only while it still has the given version,
+// zero deletes any version. It returns the product as trashed.
+func (repository *ProductMemoryRepository) DeleteById(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	product, ok := repository.products[id]
+	if !ok || product.DeletedAt != nil {
+		return nil, ErrNotFound
+	}
+	if version != 0 && !slices.Contains(storedVersions(version), product.Version) {
+		return nil, ErrVersionConflict
+	}
+
+	product.DeletedAt = &at
+	product.UpdatedAt = at
+	product.Version++
+	repository.products[id] = product
+
+	deleted := copyProduct(product)
+
+	return &deleted, nil
+}
+
+// UpdateById replaces the product only while it still has product.Version,
+// which is then incremented to the version saved.
+func (repository *ProductMemoryRepository) UpdateById(ctx context.Context, product *model.Product) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	current, ok := repository.products[product.ID]
+	if !ok || current.DeletedAt != nil {
+		return ErrNotFound
+	}
+	if !slices.Contains(storedVersions(product.Version), current.Version) {
+		return ErrVersionConflict
+	}
+
+	product.Version++
+	repository.products[product.ID] = copyProduct(*product)
+
+	return nil
+}
+
+// BulkWrite applies the writes under a single lock, following the UpdateById and DeleteById rules.
+func (repository *ProductMemoryRepository) BulkWrite(ctx context.Context, writes []model.ProductWrite) ([]error, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	errs := make([]error, len(writes))
+
+	for i := range writes {
+		product := &writes[i].Product
+
+		current, ok := repository.products[product.ID]
+		if !ok || current.DeletedAt != nil {
+			errs[i] = ErrNotFound
+			continue
+		}
+		if !slices.Contains(storedVersions(product.Version), current.Version) {
+			errs[i] = ErrVersionConflict
+			continue
+		}
+
+		product.Version++
+		if writes[i].Delete {
+			deletedAt := product.UpdatedAt
+			current.DeletedAt = &deletedAt
+			current.UpdatedAt = product.UpdatedAt
+			current.Version = product.Version
+			*product = copyProduct(current)
+		}
+
+		repository.products[product.ID] = copyProduct(*product)
+	}
+
+	return errs, nil
+}
+
+// Restore takes the product out of the trash, returning ErrNotFound when it is not there.
+func (repository *ProductMemoryRepository) Restore(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	product, ok := repository.products[id]
+	if !ok || product.DeletedAt == nil {
+		return nil, ErrNotFound
+	}
+
+	product.DeletedAt = nil
+	product.UpdatedAt = at
+	product.Version++
+	repository.products[id] = product
+
+	restored := copyProduct(product)
+
+	return &restored, nil
+}
+
+// PurgeDeleted permanently removes the products deleted before the given instant.
+func (repository *ProductMemoryRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	var purged int64
+	for id, product := range repository.products {
+		if product.DeletedAt != nil && !product.DeletedAt.After(before) {
+			delete(repository.products, id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+// filter returns copies of the products matching the query, in no particular order.
+func (repository *ProductMemoryRepository) filter(query model.ProductQuery) []model.Product {
+	products := []model.Product{}
+
+	for _, product := range repository.products {
+		if matchesQuery(product, query) {
+			products = append(products, copyProduct(product))
+		}
+	}
+
+	return products
+}
+
+func matchesQuery(product model.Product, query model.ProductQuery) bool {
+	if (product.DeletedAt != nil) != query.Trashed {
+		return false
+	}
+	if len(query.CategoryIds) > 0 && !containsInt(query.CategoryIds, product.CategoryId) {
+		return false
+	}
+	if query.MinAmount != nil && product.Amount < *query.MinAmount {
+		return false
+	}
+	if query.MaxAmount != nil && product.Amount > *query.MaxAmount {
+		return false
+	}
+	if query.CreatedAfter != nil && !product.CreatedAt.After(*query.CreatedAfter) {
+		return false
+	}
+	if query.UpdatedAfter != nil && !product.UpdatedAt.After(*query.UpdatedAfter) {
+		return false
+	}
+	if query.NamePrefix != "" && !strings.HasPrefix(strings.ToLower(product.Name), strings.ToLower(query.NamePrefix)) {
+		return false
+	}
+	if query.AvailableAt != nil && unavailableAt(product, *query.AvailableAt) {
+		return false
+	}
+	if query.UnavailableAt != nil && !unavailableAt(product, *query.UnavailableAt) {
+		return false
+	}
+	if slices.Contains(query.ExcludeIds, product.ID) {
+		return false
+	}
+	return true
+}
+
+func unavailableAt(product model.Product, now time.Time) bool {
+	return product.UnavailableSince != nil && (product.UnavailableUntil == nil || product.UnavailableUntil.After(now))
+}
+
+// sortProducts orders by the requested field, breaking ties by id so pages never overlap.
+func sortProducts(products []model.Product, query model.ProductQuery) {
+	compare := func(a, b model.Product) int {
+		switch query.Sort {
+		case model.SortByName:
+			return strings.Compare(a.Name, b.Name)
+		case model.SortByAmount:
+			return compareFloat(a.Amount, b.Amount)
+		default:
+			return a.CreatedAt.Compare(b.CreatedAt)
+		}
+	}
+
+	sort.Slice(products, func(i, j int) bool {
+		order := compare(products[i], products[j])
+		if order == 0 {
+			order = strings.Compare(products[i].ID, products[j].ID)
+		}
+		if query.Descending {
+			return order > 0
+		}
+		return order < 0
+	})
+}
+
+func compareFloat(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func copyProduct(product model.Product) model.Product {
+	if product.DeletedAt != nil {
+		deletedAt := *product.DeletedAt
+		product.DeletedAt = &deletedAt
+	}
+	if product.Images != nil {
+		images := make(model.ProductImages, len(product.Images))
+		for i, image := range product.Images {
+			image.Thumbnails = append([]model.ProductThumbnail(nil), image.Thumbnails...)
+			images[i] = image
+		}
+		product.Images = images
+	}
+	if product.Variants != nil {
+		product.Variants = append(model.ProductVariants{}, product.Variants...)
+	}
+	if product.ModifierGroups != nil {
+		groups := make(model.ModifierGroups, len(product.ModifierGroups))
+		for i, group := range product.ModifierGroups {
+			group.Options = append(model.ModifierOptions(nil), group.Options...)
+			groups[i] = group
+		}
+		product.ModifierGroups = groups
+	}
+	if product.Components != nil {
+		product.Components = append(model.BundleComponents{}, product.Components...)
+	}
+	return product
+}
@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const searchBatchSize = 500
+
+// ProductSQLRepository stores the products in a relational database through gorm,
+// following the same query, versioning and trash rules as the Mongo repository.
+type ProductSQLRepository struct {
+	db *gorm.DB
+}
+
+func NewProductSQLRepository(db *gorm.DB) IProductRepository {
+	return &ProductSQLRepository{
+		db: db,
+	}
+}
+
+func (repository *ProductSQLRepository) Create(ctx context.Context, product *model.Product) error {
+	return sqlSession(ctx, repository.db).Create(product).Error
+}
+
+func (repository *ProductSQLRepository) FindOne(ctx context.Context, id string) (*model.Product, error) {
+	product := &model.Product{}
+
+	err := sqlSession(ctx, repository.db).
+		Where("product_id = ? AND deleted_at IS NULL", id).
+		Take(product).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+func (repository *ProductSQLRepository) Find(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+	products := []model.Product{}
+
+	tx := sqlSession(ctx, repository.db).
+		Scopes(sqlProductFilter(query)).
+		Order(sqlProductSort(query))
+	if query.Size > 0 {
+		tx = tx.Offset(query.Offset()).Limit(query.Size)
+	}
+
+	if err := tx.Find(&products).Error; err != nil {
+		return nil, err
+	}
+
+	return &products, nil
+}
+
+func (repository *ProductSQLRepository) Count(ctx context.Context, query model.ProductQuery) (int64, error) {
+	var count int64
+
+	err := sqlSession(ctx, repository.db).
+		Model(&model.Product{}).
+		Scopes(sqlProductFilter(query)).
+		Count(&count).Error
+
+	return count, err
+}
+
+// Stream scans the result rows one by one instead of loading them.
+func (repository *ProductSQLRepository) Stream(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error {
+	tx := sqlSession(ctx, repository.db).
+		Model(&model.Product{}).
+		Scopes(sqlProductFilter(query)).
+		Order(sqlProductSort(query))
+
+	rows, err := tx.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		product := model.Product{}
+		if err := tx.ScanRows(rows, &product); err != nil {
+			return err
+		}
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Search scores the live products in batches with the Mongo text search rules, see textSearch,
+// since text search is not portable between SQL dialects.
+func (repository *ProductSQLRepository) Search(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+	search := parseTextSearch(text)
+	matches := []model.ProductMatch{}
+	batch := []model.Product{}
+
+	err := sqlSession(ctx, repository.db).
+		Scopes(sqlProductFilter(query)).
+		FindInBatches(&batch, searchBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, product := range batch {
+				if score, ok := search.score(product); ok {
+					matches = append(matches, model.ProductMatch{Product: product, Score: score})
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	matches = rankMatches(matches, limit)
+
+	return &matches, nil
+}
+
+// DeleteById moves the product to the trash only while it still has the given version,
+// zero deletes any version. It returns the product as trashed.
+func (repository *ProductSQLRepository) DeleteById(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+	tx := sqlSession(ctx, repository.db).
+		Model(&model.Product{}).
+		Where("product_id = ? AND deleted_at IS NULL", id)
+	if version != 0 {
+		tx = tx.Where("version IN ?", storedVersions(version))
+	}
+
+	result := tx.Updates(map[string]any{
+		"deleted_at": at,
+		"updated_at": at,
+		"version":    gorm.Expr("version + 1"),
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, repository.conflictOrMissing(ctx, id)
+	}
+
+	product := &model.Product{}
+	if err := sqlSession(ctx, repository.db).Where("product_id = ?", id).Take(product).Error; err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// UpdateById replaces the product only while it still has product.Version,
+// which is then incremented to the version saved.
+func (repository *ProductSQLRepository) UpdateById(ctx context.Context, product *model.Product) error {
+	expected := product.Version
+
+	result := sqlSession(ctx, repository.db).
+		Model(&model.Product{}).
+		Where("product_id = ? AND deleted_at IS NULL AND version IN ?", product.ID, storedVersions(expected)).
+		Updates(map[string]any{
+			"name":               product.Name,
+			"description":        product.Description,
+			"category_id":        product.CategoryId,
+			"amount":             product.Amount,
+			"images":             product.Images,
+			"variants_enabled":   product.VariantsEnabled,
+			"variants":           product.Variants,
+			"modifier_groups":    product.ModifierGroups,
+			"components":         product.Components,
+			"unavailable_since":  product.UnavailableSince,
+			"unavailable_until":  product.UnavailableUntil,
+			"unavailable_reason": product.UnavailableReason,
+			"unavailable_by":     product.UnavailableBy,
+			"version":            expected + 1,
+			"created_at":         product.CreatedAt,
+			"updated_at":         product.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.conflictOrMissing(ctx, product.ID)
+	}
+
+	product.Version = expected + 1
+
+	return nil
+}
+
+// BulkWrite runs the writes one after the other, the way UpdateById and DeleteById do.
+func (repository *ProductSQLRepository) BulkWrite(ctx context.Context, writes []model.ProductWrite) ([]error, error) {
+	errs := make([]error, len(writes))
+
+	for i := range writes {
+		product := &writes[i].Product
+		expected := product.Version
+
+		values := map[string]any{
+			"name":               product.Name,
+			"description":        product.Description,
+			"category_id":        product.CategoryId,
+			"amount":             product.Amount,
+			"images":             product.Images,
+			"variants_enabled":   product.VariantsEnabled,
+			"variants":           product.Variants,
+			"modifier_groups":    product.ModifierGroups,
+			"components":         product.Components,
+			"unavailable_since":  product.UnavailableSince,
+			"unavailable_until":  product.UnavailableUntil,
+			"unavailable_reason": product.UnavailableReason,
+			"unavailable_by":     product.UnavailableBy,
+			"version":            expected + 1,
+			"created_at":         product.CreatedAt,
+			"updated_at":         product.UpdatedAt,
+		}
+		if writes[i].Delete {
+			values = map[string]any{"deleted_at": product.UpdatedAt, "updated_at": product.UpdatedAt, "version": expected + 1}
+		}
+
+		result := sqlSession(ctx, repository.db).
+			Model(&model.Product{}).
+			Where("product_id = ? AND deleted_at IS NULL AND version IN ?", product.ID, storedVersions(expected)).
+			Updates(values)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			err := repository.conflictOrMissing(ctx, product.ID)
+			if !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrVersionConflict) {
+				return nil, err
+			}
+			errs[i] = err
+			continue
+		}
+
+		product.Version = expected + 1
+		if writes[i].Delete {
+			deletedAt := product.UpdatedAt
+			product.DeletedAt = &deletedAt
+		}
+	}
+
+	return errs, nil
+}
+
+// Restore takes the product out of the trash, returning ErrNotFound when it is not there.
+func (repository *ProductSQLRepository) Restore(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+	product := &model.Product{}
+
+	err := sqlSession(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Product{}).
+			Where("product_id = ? AND deleted_at IS NOT NULL", id).
+			Updates(map[string]any{
+				"deleted_at": nil,
+				"updated_at": at,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return tx.Where("product_id = ?", id).Take(product).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// PurgeDeleted permanently removes the products deleted before the given instant.
+func (repository *ProductSQLRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	result := sqlSession(ctx, repository.db).
+		Where("deleted_at IS NOT NULL AND deleted_at <= ?", before).
+		Delete(&model.Product{})
+
+	return result.RowsAffected, result.Error
+}
+
+// conflictOrMissing tells a version mismatch apart from a product that does not exist.
+func (repository *ProductSQLRepository) conflictOrMissing(ctx context.Context, id string) error {
+	var count int64
+
+	err := sqlSession(ctx, repository.db).
+		Model(&model.Product{}).
+		Where("product_id = ? AND deleted_at IS NULL", id).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrVersionConflict
+	}
+
+	return ErrNotFound
+}
+
+// sqlProductFilter combines every filter of the query into a single WHERE clause.
+func sqlProductFilter(query model.ProductQuery) func(tx *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if query.Trashed {
+			tx = tx.Where("deleted_at IS NOT NULL")
+		} else {
+			tx = tx.Where("deleted_at IS NULL")
+		}
+
+		if len(query.CategoryIds) > 0 {
+			tx = tx.Where("category_id IN ?", query.CategoryIds)
+		}
+		if query.MinAmount != nil {
+			tx = tx.Where("amount >= ?", *query.MinAmount)
+		}
+		if query.MaxAmount != nil {
+			tx = tx.Where("amount <= ?", *query.MaxAmount)
+		}
+		if query.CreatedAfter != nil {
+			tx = tx.Where("created_at > ?", query.CreatedAfter.UTC())
+		}
+		if query.UpdatedAfter != nil {
+			tx = tx.Where("updated_at > ?", query.UpdatedAfter.UTC())
+		}
+		if query.NamePrefix != "" {
+			tx = tx.Where(`LOWER(name) LIKE ? ESCAPE '\'`, escapeLike(strings.ToLower(query.NamePrefix))+"%")
+		}
+		if query.AvailableAt != nil {
+			tx = tx.Where("(unavailable_since IS NULL OR unavailable_until <= ?)", query.AvailableAt.UTC())
+		}
+		if query.UnavailableAt != nil {
+			tx = tx.Where("unavailable_since IS NOT NULL AND (unavailable_until IS NULL OR unavailable_until > ?)", query.UnavailableAt.UTC())
+		}
+		if len(query.ExcludeIds) > 0 {
+			tx = tx.Where("product_id NOT IN ?", query.ExcludeIds)
+		}
+
+		return tx
+	}
+}
+
+var sqlProductSortColumns = map[string]string{
+	model.SortByName:      "name",
+	model.SortByAmount:    "amount",
+	model.SortByCreatedAt: "created_at",
+}
+
+// sqlProductSort orders by the requested column, breaking ties by id so pages never overlap.
+func sqlProductSort(query model.ProductQuery) clause.OrderBy {
+	column, ok := sqlProductSortColumns[query.Sort]
+	if !ok {
+		column = sqlProductSortColumns[model.SortByCreatedAt]
+	}
+
+	return clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: clause.Column{Name: column}, Desc: query.Descending},
+		{Column: clause.Column{Name: "product_id"}, Desc: query.Descending},
+	}}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IReservationRepository stores the stock reservations. UpdateStatus is a conditional update, so
+// a reservation confirmed, released and expired concurrently ends in a single one of these statuses.
+type IReservationRepository interface {
+	Create(ctx context.Context, reservation *model.Reservation) error
+	// FindById returns nil when the reservation does not exist.
+	FindById(ctx context.Context, id string) (*model.Reservation, error)
+	// UpdateStatus moves the reservation from status to another, returning ErrNotFound
+	// when there is no such reservation in the from status.
+	UpdateStatus(ctx context.Context, id string, from string, to string, updatedAt time.Time) error
+	// FindExpired returns the pending reservations expired at the given instant, the oldest first.
+	FindExpired(ctx context.Context, now time.Time, limit int) (*[]model.Reservation, error)
+}
+
+type ReservationRepository struct {
+	database *mongo.Collection
+}
+
+func NewReservationRepository(database *mongo.Collection) IReservationRepository {
+	return &ReservationRepository{
+		database: database,
+	}
+}
+
+func (repository *ReservationRepository) Create(ctx context.Context, reservation *model.Reservation) error {
+	_, err := repository.database.InsertOne(ctx, reservation)
+
+	return err
+}
+
+func (repository *ReservationRepository) FindById(ctx context.Context, id string) (*model.Reservation, error) {
+	reservation := &model.Reservation{}
+
+	err := repository.database.FindOne(ctx, bson.M{"id": id}).Decode(reservation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (repository *ReservationRepository) UpdateStatus(ctx context.Context, id string, from string, to string, updatedAt time.Time) error {
+	result, err := repository.database.UpdateOne(ctx,
+		bson.M{"id": id, "status": from},
+		bson.M{"$set": bson.M{"status": to, "updatedat": updatedAt}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *ReservationRepository) FindExpired(ctx context.Context, now time.Time, limit int) (*[]model.Reservation, error) {
+	reservations := []model.Reservation{}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "expiresat", Value: 1}, {Key: "id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := repository.database.Find(ctx,
+		bson.M{"status": model.ReservationPending, "expiresat": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &reservations); err != nil {
+		return nil, err
+	}
+
+	return &reservations, nil
+}
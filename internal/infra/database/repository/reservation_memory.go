@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// ReservationMemoryRepository keeps the reservations in memory.
+type ReservationMemoryRepository struct {
+	mutex        sync.Mutex
+	reservations map[string]model.Reservation
+}
+
+func NewReservationMemoryRepository() IReservationRepository {
+	return &ReservationMemoryRepository{
+		reservations: map[string]model.Reservation{},
+	}
+}
+
+func (repository *ReservationMemoryRepository) Create(ctx context.Context, reservation *model.Reservation) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	repository.reservations[reservation.ID] = copyReservation(*reservation)
+
+	return nil
+}
+
+func (repository *ReservationMemoryRepository) FindById(ctx context.Context, id string) (*model.Reservation, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	reservation, ok := repository.reservations[id]
+	if !ok {
+		return nil, nil
+	}
+
+	reservation = copyReservation(reservation)
+
+	return &reservation, nil
+}
+
+func (repository *ReservationMemoryRepository) UpdateStatus(ctx context.Context, id string, from string, to string, updatedAt time.Time) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	reservation, ok := repository.reservations[id]
+	if !ok || reservation.Status != from {
+		return ErrNotFound
+	}
+
+	reservation.Status = to
+	reservation.UpdatedAt = updatedAt
+	repository.reservations[id] = reservation
+
+	return nil
+}
+
+func (repository *ReservationMemoryRepository) FindExpired(ctx context.Context, now time.Time, limit int) (*[]model.Reservation, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	reservations := []model.Reservation{}
+	for _, reservation := range repository.reservations {
+		if reservation.Status == model.ReservationPending && !reservation.ExpiresAt.After(now) {
+			reservations = append(reservations, copyReservation(reservation))
+		}
+	}
+
+	sort.Slice(reservations, func(i, j int) bool {
+		if order := reservations[i].ExpiresAt.Compare(reservations[j].ExpiresAt); order != 0 {
+			return order < 0
+		}
+		return reservations[i].ID < reservations[j].ID
+	})
+
+	if len(reservations) > limit {
+		reservations = reservations[:limit]
+	}
+
+	return &reservations, nil
+}
+
+func copyReservation(reservation model.Reservation) model.Reservation {
+	reservation.Items = append(model.ReservationItems{}, reservation.Items...)
+	return reservation
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+)
+
+type ReservationSQLRepository struct {
+	db *gorm.DB
+}
+
+func NewReservationSQLRepository(db *gorm.DB) IReservationRepository {
+	return &ReservationSQLRepository{
+		db: db,
+	}
+}
+
+func (repository *ReservationSQLRepository) Create(ctx context.Context, reservation *model.Reservation) error {
+	return sqlSession(ctx, repository.db).Create(reservation).Error
+}
+
+func (repository *ReservationSQLRepository) FindById(ctx context.Context, id string) (*model.Reservation, error) {
+	reservation := &model.Reservation{}
+
+	err := sqlSession(ctx, repository.db).Where("reservation_id = ?", id).Take(reservation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (repository *ReservationSQLRepository) UpdateStatus(ctx context.Context, id string, from string, to string, updatedAt time.Time) error {
+	result := sqlSession(ctx, repository.db).
+		Model(&model.Reservation{}).
+		Where("reservation_id = ? AND status = ?", id, from).
+		Updates(map[string]any{
+			"status":     to,
+			"updated_at": updatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *ReservationSQLRepository) FindExpired(ctx context.Context, now time.Time, limit int) (*[]model.Reservation, error) {
+	reservations := []model.Reservation{}
+
+	err := sqlSession(ctx, repository.db).
+		Where("status = ? AND expires_at <= ?", model.ReservationPending, now).
+		Order("expires_at").
+		Order("reservation_id").
+		Limit(limit).
+		Find(&reservations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservations, nil
+}
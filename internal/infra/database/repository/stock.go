@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IStockRepository stores the stock of the products. Save, Adjust and Reserve are conditional
+// updates, applied only while they leave at least as many units on hand as reserved, so concurrent
+// sales never oversell. They return ErrInsufficientStock otherwise, and Adjust, Reserve and Consume
+// return ErrNotFound when the stock of the product is not tracked. Those three stamp the stock with at,
+// Save keeps the UpdatedAt it is given.
+type IStockRepository interface {
+	// FindByProductId returns nil when the stock of the product is not tracked.
+	FindByProductId(ctx context.Context, productId string) (*model.Stock, error)
+	FindByProductIds(ctx context.Context, productIds []string) (*[]model.Stock, error)
+	// FindSoldOut returns the stocks without units left for sale.
+	FindSoldOut(ctx context.Context) (*[]model.Stock, error)
+	// Save sets the units on hand and the threshold, tracking the stock of the product if needed.
+	Save(ctx context.Context, stock *model.Stock) error
+	DeleteByProductId(ctx context.Context, productId string) error
+	// Adjust adds delta, negative to remove units, to the units on hand.
+	Adjust(ctx context.Context, productId string, delta int, at time.Time) (*model.Stock, error)
+	// Reserve adds quantity, negative to release units, to the units reserved.
+	Reserve(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error)
+	// Consume removes quantity reserved units from the units on hand, once they are sold.
+	Consume(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error)
+}
+
+type StockRepository struct {
+	database *mongo.Collection
+}
+
+func NewStockRepository(database *mongo.Collection) IStockRepository {
+	return &StockRepository{
+		database: database,
+	}
+}
+
+func (repository *StockRepository) FindByProductId(ctx context.Context, productId string) (*model.Stock, error) {
+	stock := &model.Stock{}
+
+	err := repository.database.FindOne(ctx, bson.M{"productid": productId}).Decode(stock)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (repository *StockRepository) FindByProductIds(ctx context.Context, productIds []string) (*[]model.Stock, error) {
+	stocks := []model.Stock{}
+	if len(productIds) == 0 {
+		return &stocks, nil
+	}
+
+	cursor, err := repository.database.Find(ctx, bson.M{"productid": bson.M{"$in": productIds}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &stocks); err != nil {
+		return nil, err
+	}
+
+	return &stocks, nil
+}
+
+func (repository *StockRepository) FindSoldOut(ctx context.Context) (*[]model.Stock, error) {
+	stocks := []model.Stock{}
+
+	cursor, err := repository.database.Find(ctx, bson.M{"$expr": bson.M{"$lte": bson.A{"$onhand", "$reserved"}}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &stocks); err != nil {
+		return nil, err
+	}
+
+	return &stocks, nil
+}
+
+// Save updates the stock while its reservations fit in the new units on hand, inserting it when
+// there is none. Two first saves racing end with a duplicate key error for one of them.
+func (repository *StockRepository) Save(ctx context.Context, stock *model.Stock) error {
+	result := repository.database.FindOneAndUpdate(ctx,
+		bson.M{"productid": stock.ProductId, "reserved": bson.M{"$lte": stock.OnHand}},
+		bson.M{"$set": bson.M{
+			"onhand":            stock.OnHand,
+			"lowstockthreshold": stock.LowStockThreshold,
+			"updatedat":         stock.UpdatedAt,
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if result.Err() == nil {
+		return result.Decode(stock)
+	}
+	if !errors.Is(result.Err(), mongo.ErrNoDocuments) {
+		return result.Err()
+	}
+
+	existing, err := repository.FindByProductId(ctx, stock.ProductId)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return ErrInsufficientStock
+	}
+
+	stock.Reserved = 0
+	_, err = repository.database.InsertOne(ctx, stock)
+
+	return err
+}
+
+func (repository *StockRepository) DeleteByProductId(ctx context.Context, productId string) error {
+	result, err := repository.database.DeleteOne(ctx, bson.M{"productid": productId})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *StockRepository) Adjust(ctx context.Context, productId string, delta int, at time.Time) (*model.Stock, error) {
+	return repository.change(ctx, productId, at, bson.M{"onhand": delta},
+		bson.M{"$gte": bson.A{bson.M{"$add": bson.A{"$onhand", delta}}, "$reserved"}})
+}
+
+func (repository *StockRepository) Reserve(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error) {
+	return repository.change(ctx, productId, at, bson.M{"reserved": quantity},
+		bson.M{"$and": bson.A{
+			bson.M{"$gte": bson.A{bson.M{"$add": bson.A{"$reserved", quantity}}, 0}},
+			bson.M{"$lte": bson.A{bson.M{"$add": bson.A{"$reserved", quantity}}, "$onhand"}},
+		}})
+}
+
+func (repository *StockRepository) Consume(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error) {
+	return repository.change(ctx, productId, at, bson.M{"onhand": -quantity, "reserved": -quantity},
+		bson.M{"$gte": bson.A{"$reserved", quantity}})
+}
+
+// change increments the fields of the stock in a single update matching only while condition holds.
+func (repository *StockRepository) change(ctx context.Context, productId string, at time.Time, increments bson.M, condition bson.M) (*model.Stock, error) {
+	stock := &model.Stock{}
+
+	err := repository.database.FindOneAndUpdate(ctx,
+		bson.M{"productid": productId, "$expr": condition},
+		bson.M{"$inc": increments, "$set": bson.M{"updatedat": at}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(stock)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, repository.missingOrInsufficient(ctx, productId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (repository *StockRepository) missingOrInsufficient(ctx context.Context, productId string) error {
+	existing, err := repository.FindByProductId(ctx, productId)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrNotFound
+	}
+	return ErrInsufficientStock
+}
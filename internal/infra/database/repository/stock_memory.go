@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// StockMemoryRepository keeps the stock in memory, the mutex makes every change conditional.
+type StockMemoryRepository struct {
+	mutex  sync.RWMutex
+	stocks map[string]model.Stock
+}
+
+func NewStockMemoryRepository() IStockRepository {
+	return &StockMemoryRepository{
+		stocks: map[string]model.Stock{},
+	}
+}
+
+func (repository *StockMemoryRepository) FindByProductId(ctx context.Context, productId string) (*model.Stock, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	stock, ok := repository.stocks[productId]
+	if !ok {
+		return nil, nil
+	}
+
+	return &stock, nil
+}
+
+func (repository *StockMemoryRepository) FindByProductIds(ctx context.Context, productIds []string) (*[]model.Stock, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	stocks := []model.Stock{}
+	for _, productId := range productIds {
+		if stock, ok := repository.stocks[productId]; ok {
+			stocks = append(stocks, stock)
+		}
+	}
+
+	return &stocks, nil
+}
+
+func (repository *StockMemoryRepository) FindSoldOut(ctx context.Context) (*[]model.Stock, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	stocks := []model.Stock{}
+	for _, stock := range repository.stocks {
+		if stock.OnHand <= stock.Reserved {
+			stocks = append(stocks, stock)
+		}
+	}
+
+	return &stocks, nil
+}
+
+func (repository *StockMemoryRepository) Save(ctx context.Context, stock *model.Stock) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	existing := repository.stocks[stock.ProductId]
+	if existing.Reserved > stock.OnHand {
+		return ErrInsufficientStock
+	}
+
+	stock.Reserved = existing.Reserved
+	repository.stocks[stock.ProductId] = *stock
+
+	return nil
+}
+
+func (repository *StockMemoryRepository) DeleteByProductId(ctx context.Context, productId string) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.stocks[productId]; !ok {
+		return ErrNotFound
+	}
+	delete(repository.stocks, productId)
+
+	return nil
+}
+
+func (repository *StockMemoryRepository) Adjust(ctx context.Context, productId string, delta int, at time.Time) (*model.Stock, error) {
+	return repository.change(productId, at, func(stock *model.Stock) {
+		stock.OnHand += delta
+	})
+}
+
+func (repository *StockMemoryRepository) Reserve(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error) {
+	return repository.change(productId, at, func(stock *model.Stock) {
+		stock.Reserved += quantity
+	})
+}
+
+func (repository *StockMemoryRepository) Consume(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error) {
+	return repository.change(productId, at, func(stock *model.Stock) {
+		stock.OnHand -= quantity
+		stock.Reserved -= quantity
+	})
+}
+
+// change keeps fn's change while the stock stays consistent.
+func (repository *StockMemoryRepository) change(productId string, at time.Time, fn func(stock *model.Stock)) (*model.Stock, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	stock, ok := repository.stocks[productId]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	fn(&stock)
+	if stock.Reserved < 0 || stock.Reserved > stock.OnHand {
+		return nil, ErrInsufficientStock
+	}
+	stock.UpdatedAt = at
+	repository.stocks[productId] = stock
+
+	return &stock, nil
+}
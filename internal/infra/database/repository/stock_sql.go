@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+)
+
+type StockSQLRepository struct {
+	db *gorm.DB
+}
+
+func NewStockSQLRepository(db *gorm.DB) IStockRepository {
+	return &StockSQLRepository{
+		db: db,
+	}
+}
+
+func (repository *StockSQLRepository) FindByProductId(ctx context.Context, productId string) (*model.Stock, error) {
+	stock := &model.Stock{}
+
+	err := sqlSession(ctx, repository.db).Where("product_id = ?", productId).Take(stock).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (repository *StockSQLRepository) FindByProductIds(ctx context.Context, productIds []string) (*[]model.Stock, error) {
+	stocks := []model.Stock{}
+	if len(productIds) == 0 {
+		return &stocks, nil
+	}
+
+	err := sqlSession(ctx, repository.db).Where("product_id IN ?", productIds).Find(&stocks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &stocks, nil
+}
+
+func (repository *StockSQLRepository) FindSoldOut(ctx context.Context) (*[]model.Stock, error) {
+	stocks := []model.Stock{}
+
+	err := sqlSession(ctx, repository.db).Where("on_hand <= reserved").Find(&stocks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &stocks, nil
+}
+
+// Save updates the stock while its reservations fit in the new units on hand, inserting it when
+// there is none.
+func (repository *StockSQLRepository) Save(ctx context.Context, stock *model.Stock) error {
+	result := sqlSession(ctx, repository.db).
+		Model(&model.Stock{}).
+		Where("product_id = ? AND reserved <= ?", stock.ProductId, stock.OnHand).
+		Updates(map[string]any{
+			"on_hand":             stock.OnHand,
+			"low_stock_threshold": stock.LowStockThreshold,
+			"updated_at":          stock.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		saved, err := repository.FindByProductId(ctx, stock.ProductId)
+		if err != nil {
+			return err
+		}
+		*stock = *saved
+		return nil
+	}
+
+	existing, err := repository.FindByProductId(ctx, stock.ProductId)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return ErrInsufficientStock
+	}
+
+	stock.Reserved = 0
+	return sqlSession(ctx, repository.db).Create(stock).Error
+}
+
+func (repository *StockSQLRepository) DeleteByProductId(ctx context.Context, productId string) error {
+	result := sqlSession(ctx, repository.db).Where("product_id = ?", productId).Delete(&model.Stock{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (repository *StockSQLRepository) Adjust(ctx context.Context, productId string, delta int, at time.Time) (*model.Stock, error) {
+	return repository.change(ctx, productId, at, map[string]int{"on_hand": delta}, "on_hand + ? >= reserved", delta)
+}
+
+func (repository *StockSQLRepository) Reserve(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error) {
+	return repository.change(ctx, productId, at, map[string]int{"reserved": quantity}, "reserved + ? BETWEEN 0 AND on_hand", quantity)
+}
+
+func (repository *StockSQLRepository) Consume(ctx context.Context, productId string, quantity int, at time.Time) (*model.Stock, error) {
+	return repository.change(ctx, productId, at, map[string]int{"on_hand": -quantity, "reserved": -quantity}, "reserved >= ?", quantity)
+}
+
+// change increments the columns in a single UPDATE matching only while condition, given its
+// arguments, holds.
+func (repository *StockSQLRepository) change(ctx context.Context, productId string, at time.Time, increments map[string]int, condition string, args ...any) (*model.Stock, error) {
+	updates := map[string]any{"updated_at": at}
+	for column, increment := range increments {
+		updates[column] = gorm.Expr(column+" + ?", increment)
+	}
+
+	result := sqlSession(ctx, repository.db).
+		Model(&model.Stock{}).
+		Where("product_id = ? AND "+condition, append([]any{productId}, args...)...).
+		Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	stock, err := repository.FindByProductId(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if stock == nil {
+		return nil, ErrNotFound
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrInsufficientStock
+	}
+
+	return stock, nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/types/xstrings"
+)
+
+// Text search weights, the same given to each field by the Mongo text index.
+const (
+	nameWeight        = 10
+	descriptionWeight = 2
+)
+
+// textSearch evaluates a Mongo style text search expression for the backends without text indexes:
+// a product matches any of the words, must contain every quoted phrase and none of the negated words,
+// all compared without case and accents.
+type textSearch struct {
+	words   []string
+	phrases []string
+	negated []string
+}
+
+func parseTextSearch(text string) textSearch {
+	search := textSearch{}
+
+	parts := strings.Split(text, `"`)
+	for i, part := range parts {
+		if i%2 == 1 {
+			if phrase := strings.TrimSpace(xstrings.Fold(part)); phrase != "" {
+				search.phrases = append(search.phrases, phrase)
+			}
+			continue
+		}
+		for _, word := range strings.Fields(xstrings.Fold(part)) {
+			if strings.HasPrefix(word, "-") {
+				if word = strings.TrimPrefix(word, "-"); word != "" {
+					search.negated = append(search.negated, word)
+				}
+				continue
+			}
+			search.words = append(search.words, word)
+		}
+	}
+
+	return search
+}
+
+// score weighs each word found in the name and description like the text index does,
+// a word matches the beginning of a product word to stand in for stemming.
+func (search textSearch) score(product model.Product) (float64, bool) {
+	name := xstrings.Fold(product.Name)
+	description := xstrings.Fold(product.Description)
+	nameWords := strings.FieldsFunc(name, isSeparator)
+	descriptionWords := strings.FieldsFunc(description, isSeparator)
+
+	for _, phrase := range search.phrases {
+		if !strings.Contains(name, phrase) && !strings.Contains(description, phrase) {
+			return 0, false
+		}
+	}
+	for _, word := range search.negated {
+		if countWord(nameWords, word) > 0 || countWord(descriptionWords, word) > 0 {
+			return 0, false
+		}
+	}
+
+	score := 0.0
+	for _, word := range append(search.words, search.phrases...) {
+		for _, part := range strings.Fields(word) {
+			score += float64(nameWeight*countWord(nameWords, part) + descriptionWeight*countWord(descriptionWords, part))
+		}
+	}
+
+	return score, score > 0
+}
+
+func countWord(words []string, word string) int {
+	count := 0
+	for _, w := range words {
+		if strings.HasPrefix(w, word) {
+			count++
+		}
+	}
+	return count
+}
+
+func isSeparator(r rune) bool {
+	return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+}
+
+// rankMatches orders the matches by relevance, breaking ties by id, and keeps at most limit of them.
+func rankMatches(matches []model.ProductMatch, limit int) []model.ProductMatch {
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
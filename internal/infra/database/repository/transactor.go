@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+)
+
+// ITransactor runs fn so that every repository write made with the context it receives
+// commits or rolls back together. fn may run more than once when the database asks for a retry.
+// RollsBack tells whether the writes of a failed fn are undone.
+type ITransactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+	RollsBack() bool
+}
+
+// MongoTransactor needs MongoDB running as a replica set, standalone servers have no transactions.
+type MongoTransactor struct {
+	client *mongo.Client
+}
+
+func NewMongoTransactor(client *mongo.Client) ITransactor {
+	return &MongoTransactor{
+		client: client,
+	}
+}
+
+// Transaction relies on the driver taking the session from the context,
+// so the Mongo repositories join it just by using ctx.
+func (transactor *MongoTransactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := transactor.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (any, error) {
+		return nil, fn(sessionCtx)
+	})
+
+	return err
+}
+
+func (transactor *MongoTransactor) RollsBack() bool {
+	return true
+}
+
+type sqlTxKey struct{}
+
+type SQLTransactor struct {
+	db *gorm.DB
+}
+
+func NewSQLTransactor(db *gorm.DB) ITransactor {
+	return &SQLTransactor{
+		db: db,
+	}
+}
+
+func (transactor *SQLTransactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return sqlSession(ctx, transactor.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, sqlTxKey{}, tx))
+	})
+}
+
+func (transactor *SQLTransactor) RollsBack() bool {
+	return true
+}
+
+// sqlSession returns the transaction started by SQLTransactor when there is one.
+// SQL repositories must always go through it, SQLite has a single connection
+// and would wait forever for the one held by the transaction.
+func sqlSession(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(sqlTxKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// MemoryTransactor only serializes the transactions, memory writes cannot be rolled back.
+type MemoryTransactor struct {
+	mutex sync.Mutex
+}
+
+func NewMemoryTransactor() ITransactor {
+	return &MemoryTransactor{}
+}
+
+func (transactor *MemoryTransactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	transactor.mutex.Lock()
+	defer transactor.mutex.Unlock()
+
+	return fn(ctx)
+}
+
+func (transactor *MemoryTransactor) RollsBack() bool {
+	return false
+}
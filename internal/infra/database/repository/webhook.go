@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IWebhookRepository stores the webhook subscriptions and their delivery log.
+// UpdateDelivery ignores the deliveries removed meanwhile along with their webhook.
+type IWebhookRepository interface {
+	Create(ctx context.Context, webhook *model.Webhook) error
+	FindAll(ctx context.Context) (*[]model.Webhook, error)
+	FindById(ctx context.Context, id string) (*model.Webhook, error)
+	DeleteById(ctx context.Context, id string) error
+	AddDeliveries(ctx context.Context, deliveries ...model.WebhookDelivery) error
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) (*[]model.WebhookDelivery, error)
+	FindDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*[]model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	PurgeDelivered(ctx context.Context, before time.Time) (int64, error)
+}
+
+type WebhookRepository struct {
+	webhooks   *mongo.Collection
+	deliveries *mongo.Collection
+}
+
+func NewWebhookRepository(webhooks *mongo.Collection, deliveries *mongo.Collection) IWebhookRepository {
+	return &WebhookRepository{
+		webhooks:   webhooks,
+		deliveries: deliveries,
+	}
+}
+
+func (repository *WebhookRepository) Create(ctx context.Context, webhook *model.Webhook) error {
+	_, err := repository.webhooks.InsertOne(ctx, webhook)
+
+	return err
+}
+
+func (repository *WebhookRepository) FindAll(ctx context.Context) (*[]model.Webhook, error) {
+	webhooks := []model.Webhook{}
+
+	cursor, err := repository.webhooks.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "createdat", Value: 1}, {Key: "id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cursor.All(ctx, &webhooks); err != nil {
+		return nil, err
+	}
+
+	return &webhooks, nil
+}
+
+// FindById returns nil when the webhook does not exist.
+func (repository *WebhookRepository) FindById(ctx context.Context, id string) (*model.Webhook, error) {
+	webhook := &model.Webhook{}
+
+	err := repository.webhooks.FindOne(ctx, bson.M{"id": id}).Decode(webhook)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// DeleteById removes the webhook along with its delivery log.
+func (repository *WebhookRepository) DeleteById(ctx context.Context, id string) error {
+	result, err := repository.webhooks.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	_, err = repository.deliveries.DeleteMany(ctx, bson.M{"webhookid": id})
+
+	return err
+}
+
+// AddDeliveries skips the deliveries already added, so scheduling an event again is harmless.
+func (repository *WebhookRepository) AddDeliveries(ctx context.Context, deliveries ...model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"id": delivery.ID}).
+			SetUpdate(bson.M{"$setOnInsert": delivery}).
+			SetUpsert(true))
+	}
+
+	_, err := repository.deliveries.BulkWrite(ctx, writes)
+
+	return err
+}
+
+// FindDueDeliveries returns the pending deliveries whose next attempt is due, the most overdue first.
+func (repository *WebhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) (*[]model.WebhookDelivery, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "nextattemptat", Value: 1}, {Key: "id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	return repository.findDeliveries(ctx, bson.M{"status": model.WebhookDeliveryPending, "nextattemptat": bson.M{"$lte": now}}, opts)
+}
+
+// FindDeliveries returns the deliveries matching the query, newest first.
+func (repository *WebhookRepository) FindDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*[]model.WebhookDelivery, error) {
+	filter := bson.M{}
+	if query.WebhookId != "" {
+		filter["webhookid"] = query.WebhookId
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdat", Value: -1}, {Key: "id", Value: -1}}).
+		SetLimit(int64(query.Limit))
+
+	return repository.findDeliveries(ctx, filter, opts)
+}
+
+func (repository *WebhookRepository) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	_, err := repository.deliveries.ReplaceOne(ctx, bson.M{"id": delivery.ID}, delivery)
+
+	return err
+}
+
+// PurgeDelivered removes the deliveries that succeeded before the given instant.
+func (repository *WebhookRepository) PurgeDelivered(ctx context.Context, before time.Time) (int64, error) {
+	result, err := repository.deliveries.DeleteMany(ctx, bson.M{
+		"status":      model.WebhookDeliveryDelivered,
+		"deliveredat": bson.M{"$lte": before},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
+
+func (repository *WebhookRepository) findDeliveries(ctx context.Context, filter bson.M, opts *options.FindOptions) (*[]model.WebhookDelivery, error) {
+	cursor, err := repository.deliveries.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := []model.WebhookDelivery{}
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+
+	return &deliveries, nil
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// WebhookMemoryRepository keeps the webhooks and their deliveries in memory.
+type WebhookMemoryRepository struct {
+	mutex      sync.Mutex
+	webhooks   map[string]model.Webhook
+	deliveries map[string]model.WebhookDelivery
+}
+
+func NewWebhookMemoryRepository() IWebhookRepository {
+	return &WebhookMemoryRepository{
+		webhooks:   map[string]model.Webhook{},
+		deliveries: map[string]model.WebhookDelivery{},
+	}
+}
+
+func (repository *WebhookMemoryRepository) Create(ctx context.Context, webhook *model.Webhook) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	repository.webhooks[webhook.ID] = copyWebhook(*webhook)
+
+	return nil
+}
+
+func (repository *WebhookMemoryRepository) FindAll(ctx context.Context) (*[]model.Webhook, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	webhooks := []model.Webhook{}
+	for _, webhook := range repository.webhooks {
+		webhooks = append(webhooks, copyWebhook(webhook))
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		if !webhooks[i].CreatedAt.Equal(webhooks[j].CreatedAt) {
+			return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+		}
+		return webhooks[i].ID < webhooks[j].ID
+	})
+
+	return &webhooks, nil
+}
+
+// FindById returns nil when the webhook does not exist.
+func (repository *WebhookMemoryRepository) FindById(ctx context.Context, id string) (*model.Webhook, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	webhook, ok := repository.webhooks[id]
+	if !ok {
+		return nil, nil
+	}
+
+	found := copyWebhook(webhook)
+
+	return &found, nil
+}
+
+// DeleteById removes the webhook along with its delivery log.
+func (repository *WebhookMemoryRepository) DeleteById(ctx context.Context, id string) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.webhooks[id]; !ok {
+		return ErrNotFound
+	}
+
+	delete(repository.webhooks, id)
+	for deliveryId, delivery := range repository.deliveries {
+		if delivery.WebhookId == id {
+			delete(repository.deliveries, deliveryId)
+		}
+	}
+
+	return nil
+}
+
+// AddDeliveries skips the deliveries already added, so scheduling an event again is harmless.
+func (repository *WebhookMemoryRepository) AddDeliveries(ctx context.Context, deliveries ...model.WebhookDelivery) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	for _, delivery := range deliveries {
+		if _, ok := repository.deliveries[delivery.ID]; !ok {
+			repository.deliveries[delivery.ID] = copyWebhookDelivery(delivery)
+		}
+	}
+
+	return nil
+}
+
+// FindDueDeliveries returns the pending deliveries whose next attempt is due, the most overdue first.
+func (repository *WebhookMemoryRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) (*[]model.WebhookDelivery, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	deliveries := []model.WebhookDelivery{}
+	for _, delivery := range repository.deliveries {
+		if delivery.Status == model.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) {
+			deliveries = append(deliveries, copyWebhookDelivery(delivery))
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		if order := deliveries[i].NextAttemptAt.Compare(deliveries[j].NextAttemptAt); order != 0 {
+			return order < 0
+		}
+		return deliveries[i].ID < deliveries[j].ID
+	})
+
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+
+	return &deliveries, nil
+}
+
+// FindDeliveries returns the deliveries matching the query, newest first.
+func (repository *WebhookMemoryRepository) FindDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*[]model.WebhookDelivery, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	deliveries := []model.WebhookDelivery{}
+	for _, delivery := range repository.deliveries {
+		if query.WebhookId != "" && delivery.WebhookId != query.WebhookId {
+			continue
+		}
+		if query.Status != "" && delivery.Status != query.Status {
+			continue
+		}
+		deliveries = append(deliveries, copyWebhookDelivery(delivery))
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		if order := deliveries[i].CreatedAt.Compare(deliveries[j].CreatedAt); order != 0 {
+			return order > 0
+		}
+		return strings.Compare(deliveries[i].ID, deliveries[j].ID) > 0
+	})
+
+	if query.Limit > 0 && len(deliveries) > query.Limit {
+		deliveries = deliveries[:query.Limit]
+	}
+
+	return &deliveries, nil
+}
+
+func (repository *WebhookMemoryRepository) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, ok := repository.deliveries[delivery.ID]; ok {
+		repository.deliveries[delivery.ID] = copyWebhookDelivery(*delivery)
+	}
+
+	return nil
+}
+
+// PurgeDelivered removes the deliveries that succeeded before the given instant.
+func (repository *WebhookMemoryRepository) PurgeDelivered(ctx context.Context, before time.Time) (int64, error) {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	var purged int64
+	for id, delivery := range repository.deliveries {
+		if delivery.Status == model.WebhookDeliveryDelivered && delivery.DeliveredAt != nil && !delivery.DeliveredAt.After(before) {
+			delete(repository.deliveries, id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+func copyWebhook(webhook model.Webhook) model.Webhook {
+	webhook.EventTypes = append([]string{}, webhook.EventTypes...)
+	return webhook
+}
+
+func copyWebhookDelivery(delivery model.WebhookDelivery) model.WebhookDelivery {
+	if delivery.DeliveredAt != nil {
+		deliveredAt := *delivery.DeliveredAt
+		delivery.DeliveredAt = &deliveredAt
+	}
+	return delivery
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookSQLRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSQLRepository(db *gorm.DB) IWebhookRepository {
+	return &WebhookSQLRepository{
+		db: db,
+	}
+}
+
+func (repository *WebhookSQLRepository) Create(ctx context.Context, webhook *model.Webhook) error {
+	return sqlSession(ctx, repository.db).Create(webhook).Error
+}
+
+func (repository *WebhookSQLRepository) FindAll(ctx context.Context) (*[]model.Webhook, error) {
+	webhooks := []model.Webhook{}
+
+	err := sqlSession(ctx, repository.db).Order("created_at").Order("webhook_id").Find(&webhooks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhooks, nil
+}
+
+// FindById returns nil when the webhook does not exist.
+func (repository *WebhookSQLRepository) FindById(ctx context.Context, id string) (*model.Webhook, error) {
+	webhook := &model.Webhook{}
+
+	err := sqlSession(ctx, repository.db).Where("webhook_id = ?", id).Take(webhook).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// DeleteById removes the webhook along with its delivery log.
+func (repository *WebhookSQLRepository) DeleteById(ctx context.Context, id string) error {
+	return sqlSession(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("webhook_id = ?", id).Delete(&model.Webhook{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return tx.Where("webhook_id = ?", id).Delete(&model.WebhookDelivery{}).Error
+	})
+}
+
+// AddDeliveries skips the deliveries already added, so scheduling an event again is harmless.
+func (repository *WebhookSQLRepository) AddDeliveries(ctx context.Context, deliveries ...model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return sqlSession(ctx, repository.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+// FindDueDeliveries returns the pending deliveries whose next attempt is due, the most overdue first.
+func (repository *WebhookSQLRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) (*[]model.WebhookDelivery, error) {
+	deliveries := []model.WebhookDelivery{}
+
+	err := sqlSession(ctx, repository.db).
+		Where("status = ? AND next_attempt_at <= ?", model.WebhookDeliveryPending, now).
+		Order("next_attempt_at").
+		Order("delivery_id").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliveries, nil
+}
+
+// FindDeliveries returns the deliveries matching the query, newest first.
+func (repository *WebhookSQLRepository) FindDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*[]model.WebhookDelivery, error) {
+	deliveries := []model.WebhookDelivery{}
+
+	tx := sqlSession(ctx, repository.db)
+	if query.WebhookId != "" {
+		tx = tx.Where("webhook_id = ?", query.WebhookId)
+	}
+	if query.Status != "" {
+		tx = tx.Where("status = ?", query.Status)
+	}
+	if query.Limit > 0 {
+		tx = tx.Limit(query.Limit)
+	}
+
+	err := tx.Order("created_at DESC").Order("delivery_id DESC").Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliveries, nil
+}
+
+func (repository *WebhookSQLRepository) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	return sqlSession(ctx, repository.db).
+		Model(&model.WebhookDelivery{}).
+		Where("delivery_id = ?", delivery.ID).
+		Updates(map[string]any{
+			"status":           delivery.Status,
+			"attempts":         delivery.Attempts,
+			"last_status_code": delivery.LastStatusCode,
+			"last_error":       delivery.LastError,
+			"next_attempt_at":  delivery.NextAttemptAt,
+			"delivered_at":     delivery.DeliveredAt,
+		}).Error
+}
+
+// PurgeDelivered removes the deliveries that succeeded before the given instant.
+func (repository *WebhookSQLRepository) PurgeDelivered(ctx context.Context, before time.Time) (int64, error) {
+	result := sqlSession(ctx, repository.db).
+		Where("status = ? AND delivered_at <= ?", model.WebhookDeliveryDelivered, before).
+		Delete(&model.WebhookDelivery{})
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,160 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/infra/database/migration"
+	"gorm.io/gorm"
+)
+
+// Migration is a schema change applied at most once, recorded in the schema_migrations table.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(tx *gorm.DB) error
+	Down        func(tx *gorm.DB) error
+}
+
+type schemaMigration struct {
+	Version     int       `gorm:"column:version;primaryKey"`
+	Description string    `gorm:"column:description"`
+	AppliedAt   time.Time `gorm:"column:applied_at"`
+}
+
+func (schemaMigration) TableName() string {
+	return migration.TableName
+}
+
+// Migrate applies, in version order, every migration not recorded yet.
+// Each migration runs in its own transaction together with its record.
+func Migrate(ctx context.Context, db *gorm.DB) error {
+	slog.InfoContext(ctx, "Initializing migrations...")
+
+	applied, err := appliedVersions(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		if applied[migration.Version] {
+			continue
+		}
+
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{
+				Version:     migration.Version,
+				Description: migration.Description,
+				AppliedAt:   tx.NowFunc(),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+
+		slog.InfoContext(ctx, fmt.Sprintf("Migration %d applied: %s", migration.Version, migration.Description))
+	}
+
+	slog.InfoContext(ctx, "Finished migrations")
+
+	return nil
+}
+
+// MigrateDown reverts the latest steps applied migrations, newest first,
+// each in its own transaction together with the removal of its record.
+func MigrateDown(ctx context.Context, db *gorm.DB, steps int) error {
+	applied, err := appliedVersions(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+		m := migrations[i]
+		if !applied[m.Version] {
+			continue
+		}
+
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := m.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{Version: m.Version}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.Version, m.Description, err)
+		}
+
+		slog.InfoContext(ctx, fmt.Sprintf("Migration %d reverted: %s", m.Version, m.Description))
+		steps--
+	}
+
+	return nil
+}
+
+// MigrationStatus lists every known migration and when it was applied.
+func MigrationStatus(ctx context.Context, db *gorm.DB) ([]migration.State, error) {
+	if _, err := appliedVersions(ctx, db); err != nil {
+		return nil, err
+	}
+
+	records := []schemaMigration{}
+	if err := db.WithContext(ctx).Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	appliedAt := map[int]time.Time{}
+	for _, record := range records {
+		appliedAt[record.Version] = record.AppliedAt
+	}
+
+	states := make([]migration.State, 0, len(migrations))
+	for _, m := range migrations {
+		state := migration.State{Version: m.Version, Description: m.Description}
+		if at, ok := appliedAt[m.Version]; ok {
+			state.AppliedAt = &at
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+func appliedVersions(ctx context.Context, db *gorm.DB) (map[int]bool, error) {
+	err := db.WithContext(ctx).Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		description VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP NOT NULL
+	)`).Error
+	if err != nil {
+		return nil, err
+	}
+
+	records := []schemaMigration{}
+	if err := db.WithContext(ctx).Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	applied := map[int]bool{}
+	for _, record := range records {
+		applied[record.Version] = true
+	}
+
+	return applied, nil
+}
+
+// exec runs the statements in order, stopping at the first failure.
+func exec(statements ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
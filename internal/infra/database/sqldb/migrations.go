@@ -0,0 +1,228 @@
+package sqldb
+
+import (
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// migrations lists every schema change in version order. Released migrations must never be edited,
+// add a new one instead. The SQL is kept portable between SQLite and PostgreSQL.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create product table",
+		Up: exec(
+			`CREATE TABLE product (
+				product_id VARCHAR(64) PRIMARY KEY,
+				name VARCHAR(255) NOT NULL,
+				description TEXT NOT NULL DEFAULT '',
+				category_id INTEGER NOT NULL,
+				amount DOUBLE PRECISION NOT NULL,
+				version BIGINT NOT NULL DEFAULT 1,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+				deleted_at TIMESTAMP NULL
+			)`,
+			`CREATE INDEX idx_product_category_id ON product (category_id)`,
+			`CREATE INDEX idx_product_deleted_at ON product (deleted_at)`,
+		),
+		Down: exec(`DROP TABLE product`),
+	},
+	{
+		Version:     2,
+		Description: "create category table",
+		Up: exec(
+			`CREATE TABLE category (
+				category_id INTEGER PRIMARY KEY,
+				name VARCHAR(255) NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+		),
+		Down: exec(`DROP TABLE category`),
+	},
+	{
+		Version:     3,
+		Description: "seed default categories",
+		Up: func(tx *gorm.DB) error {
+			now := tx.NowFunc()
+			categories := model.DefaultCategories()
+			for i := range categories {
+				categories[i].CreatedAt = now
+				categories[i].UpdatedAt = now
+			}
+			return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&categories).Error
+		},
+		Down: func(tx *gorm.DB) error {
+			ids := []int{}
+			for _, category := range model.DefaultCategories() {
+				ids = append(ids, category.ID)
+			}
+			return tx.Where("category_id IN ?", ids).Delete(&model.Category{}).Error
+		},
+	},
+	{
+		Version:     4,
+		Description: "create outbox table",
+		Up: exec(
+			`CREATE TABLE outbox (
+				event_id VARCHAR(64) PRIMARY KEY,
+				type VARCHAR(64) NOT NULL,
+				aggregate_id VARCHAR(64) NOT NULL,
+				payload TEXT NOT NULL,
+				occurred_at TIMESTAMP NOT NULL,
+				attempts INTEGER NOT NULL DEFAULT 0,
+				last_error TEXT NOT NULL DEFAULT '',
+				published_at TIMESTAMP NULL
+			)`,
+			`CREATE INDEX idx_outbox_pending ON outbox (published_at, occurred_at)`,
+		),
+		Down: exec(`DROP TABLE outbox`),
+	},
+	{
+		Version:     5,
+		Description: "create webhook tables",
+		Up: exec(
+			`CREATE TABLE webhook (
+				webhook_id VARCHAR(64) PRIMARY KEY,
+				url TEXT NOT NULL,
+				event_types TEXT NOT NULL,
+				secret VARCHAR(255) NOT NULL,
+				created_at TIMESTAMP NOT NULL
+			)`,
+			`CREATE TABLE webhook_delivery (
+				delivery_id VARCHAR(140) PRIMARY KEY,
+				webhook_id VARCHAR(64) NOT NULL,
+				event_id VARCHAR(64) NOT NULL,
+				event_type VARCHAR(64) NOT NULL,
+				payload TEXT NOT NULL,
+				status VARCHAR(16) NOT NULL,
+				attempts INTEGER NOT NULL DEFAULT 0,
+				last_status_code INTEGER NOT NULL DEFAULT 0,
+				last_error TEXT NOT NULL DEFAULT '',
+				next_attempt_at TIMESTAMP NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				delivered_at TIMESTAMP NULL
+			)`,
+			`CREATE INDEX idx_webhook_delivery_due ON webhook_delivery (status, next_attempt_at)`,
+			`CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery (webhook_id, created_at)`,
+		),
+		Down: exec(`DROP TABLE webhook_delivery`, `DROP TABLE webhook`),
+	},
+	{
+		Version:     6,
+		Description: "add product images",
+		Up:          exec(`ALTER TABLE product ADD COLUMN images TEXT NOT NULL DEFAULT '[]'`),
+		Down:        exec(`ALTER TABLE product DROP COLUMN images`),
+	},
+	{
+		Version:     7,
+		Description: "add product variants",
+		Up: exec(
+			`ALTER TABLE product ADD COLUMN variants_enabled BOOLEAN NOT NULL DEFAULT FALSE`,
+			`ALTER TABLE product ADD COLUMN variants TEXT NOT NULL DEFAULT '[]'`,
+		),
+		Down: exec(
+			`ALTER TABLE product DROP COLUMN variants`,
+			`ALTER TABLE product DROP COLUMN variants_enabled`,
+		),
+	},
+	{
+		Version:     8,
+		Description: "create modifier group table",
+		Up: exec(
+			`CREATE TABLE modifier_group (
+				modifier_group_id VARCHAR(64) PRIMARY KEY,
+				name VARCHAR(255) NOT NULL,
+				required BOOLEAN NOT NULL DEFAULT FALSE,
+				min_selections INTEGER NOT NULL DEFAULT 0,
+				max_selections INTEGER NOT NULL DEFAULT 1,
+				options TEXT NOT NULL DEFAULT '[]',
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+			`ALTER TABLE product ADD COLUMN modifier_groups TEXT NOT NULL DEFAULT '[]'`,
+		),
+		Down: exec(`ALTER TABLE product DROP COLUMN modifier_groups`, `DROP TABLE modifier_group`),
+	},
+	{
+		Version:     9,
+		Description: "add product components",
+		Up:          exec(`ALTER TABLE product ADD COLUMN components TEXT NOT NULL DEFAULT '[]'`),
+		Down:        exec(`ALTER TABLE product DROP COLUMN components`),
+	},
+	{
+		Version:     10,
+		Description: "create stock table",
+		Up: exec(
+			`CREATE TABLE stock (
+				product_id VARCHAR(64) PRIMARY KEY,
+				on_hand INTEGER NOT NULL DEFAULT 0,
+				reserved INTEGER NOT NULL DEFAULT 0,
+				low_stock_threshold INTEGER NOT NULL DEFAULT 0,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+		),
+		Down: exec(`DROP TABLE stock`),
+	},
+	{
+		Version:     11,
+		Description: "create reservation table",
+		Up: exec(
+			`CREATE TABLE reservation (
+				reservation_id VARCHAR(64) PRIMARY KEY,
+				items TEXT NOT NULL DEFAULT '[]',
+				status VARCHAR(16) NOT NULL,
+				expires_at TIMESTAMP NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+			`CREATE INDEX idx_reservation_expired ON reservation (status, expires_at)`,
+		),
+		Down: exec(`DROP TABLE reservation`),
+	},
+	{
+		Version:     12,
+		Description: "add product unavailability and its audit log",
+		Up: exec(
+			`ALTER TABLE product ADD COLUMN unavailable_since TIMESTAMP NULL`,
+			`ALTER TABLE product ADD COLUMN unavailable_until TIMESTAMP NULL`,
+			`ALTER TABLE product ADD COLUMN unavailable_reason VARCHAR(255) NOT NULL DEFAULT ''`,
+			`ALTER TABLE product ADD COLUMN unavailable_by VARCHAR(255) NOT NULL DEFAULT ''`,
+			`CREATE TABLE product_availability_log (
+				change_id VARCHAR(64) PRIMARY KEY,
+				product_id VARCHAR(64) NOT NULL,
+				available BOOLEAN NOT NULL,
+				reason VARCHAR(255) NOT NULL DEFAULT '',
+				restore_at TIMESTAMP NULL,
+				changed_by VARCHAR(255) NOT NULL,
+				changed_at TIMESTAMP NOT NULL
+			)`,
+			`CREATE INDEX idx_product_availability_log_product_id ON product_availability_log (product_id, changed_at)`,
+		),
+		Down: exec(
+			`DROP TABLE product_availability_log`,
+			`ALTER TABLE product DROP COLUMN unavailable_by`,
+			`ALTER TABLE product DROP COLUMN unavailable_reason`,
+			`ALTER TABLE product DROP COLUMN unavailable_until`,
+			`ALTER TABLE product DROP COLUMN unavailable_since`,
+		),
+	},
+	{
+		Version:     13,
+		Description: "create daypart table",
+		Up: exec(
+			`CREATE TABLE daypart (
+				daypart_id VARCHAR(64) PRIMARY KEY,
+				name VARCHAR(255) NOT NULL,
+				schedules TEXT NOT NULL DEFAULT '[]',
+				product_ids TEXT NOT NULL DEFAULT '[]',
+				category_ids TEXT NOT NULL DEFAULT '[]',
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+		),
+		Down: exec(`DROP TABLE daypart`),
+	},
+}
@@ -0,0 +1,80 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+type SQLConf struct {
+	Driver string
+	Dsn    string
+	// Clock is the time gorm stamps the rows with, as the migrations do
+	Clock clock.Clock
+}
+
+func New(conf SQLConf) (*gorm.DB, error) {
+
+	var dialector gorm.Dialector
+	switch conf.Driver {
+	case DriverSQLite:
+		dialector = sqlite.Open(conf.Dsn)
+	case DriverPostgres:
+		dialector = postgres.Open(conf.Dsn)
+	default:
+		return nil, fmt.Errorf("unsupported sql driver %q", conf.Driver)
+	}
+
+	slog.InfoContext(context.Background(), "Connecting to "+conf.Driver+"...")
+
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.New(log.New(os.Stdout, "", log.LstdFlags), logger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  logger.Warn,
+			IgnoreRecordNotFoundError: true,
+		}),
+		NowFunc: func() time.Time {
+			return conf.Clock.Now().UTC()
+		},
+	})
+	if err != nil {
+		slog.ErrorContext(context.Background(), err.Error())
+		return nil, err
+	}
+
+	if conf.Driver == DriverSQLite {
+		// SQLite allows a single writer, sharing one connection avoids "database is locked" errors
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
+
+	slog.InfoContext(context.Background(), "✅ Connected to "+conf.Driver)
+
+	return db, nil
+}
+
+// Close releases the connections of the pool.
+func Close(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
@@ -0,0 +1,41 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+// WebhookSender posts the webhook deliveries once each, the delivery job owns the retries.
+// Redirects are not followed, the signed payload only goes to the subscribed url.
+type WebhookSender struct {
+	client *Client
+}
+
+func NewWebhookSender(timeout time.Duration) gateway.IWebhookSender {
+	return &WebhookSender{
+		client: New().
+			SetTimeout(timeout).
+			SetRedirectPolicy(resty.NoRedirectPolicy()),
+	}
+}
+
+func (sender *WebhookSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	response, err := sender.client.R().
+		SetContext(ctx).
+		SetHeaders(headers).
+		SetBody(body).
+		Post(url)
+	if err != nil {
+		return 0, err
+	}
+
+	if !response.IsSuccess() {
+		return response.StatusCode(), fmt.Errorf("webhook answered %s", response.Status())
+	}
+
+	return response.StatusCode(), nil
+}
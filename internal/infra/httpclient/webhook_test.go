@@ -0,0 +1,63 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSender_Send(t *testing.T) {
+	var request *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender(time.Second)
+
+	status, err := sender.Send(context.Background(), server.URL+"/hooks",
+		map[string]string{"Content-Type": "application/cloudevents+json", "X-Signature": "sha256=abc"}, []byte(`{"id":"1"}`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, http.MethodPost, request.Method)
+	assert.Equal(t, "/hooks", request.URL.Path)
+	assert.Equal(t, "application/cloudevents+json", request.Header.Get("Content-Type"))
+	assert.Equal(t, "sha256=abc", request.Header.Get("X-Signature"))
+	assert.Equal(t, `{"id":"1"}`, string(body))
+}
+
+func TestWebhookSender_Send_Failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	status, err := NewWebhookSender(time.Second).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, status)
+}
+
+func TestWebhookSender_Send_NoRedirect(t *testing.T) {
+	followed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/elsewhere" {
+			followed = true
+			return
+		}
+		http.Redirect(w, r, "/elsewhere", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	_, err := NewWebhookSender(time.Second).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	assert.Error(t, err)
+	assert.False(t, followed)
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type CategoryCreateRestController struct {
+	controller *ctl.CreateCategoryController
+}
+
+func NewCategoryCreateRestController(container *container.Container) httpserver.IController {
+	return &CategoryCreateRestController{
+		controller: ctl.NewCreateCategoryController(container),
+	}
+}
+
+func (ctl *CategoryCreateRestController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	categoryRequest := dto.CreateCategory{}
+
+	errBody := request.ParseBody(ctx, &categoryRequest)
+	if errBody != nil {
+		return httpserver.HandleError(ctx, errBody)
+	}
+
+	err := validator.Validate(categoryRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	output, err := ctl.controller.Execute(ctx, categoryRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Created(output)
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestCategoryCreateRestController_Handle(t *testing.T) {
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewCategoryCreateRestController(container)
+
+	inputBytes, _ := json.Marshal(dto.CreateCategory{Name: "Combo"})
+	req := httpserver.Request{Body: inputBytes}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 201, resp.Code)
+	output, ok := resp.Body.(dto.Category)
+	assert.True(t, ok)
+	assert.Equal(t, "Combo", output.Name)
+}
+
+func TestCategoryCreateRestController_Handle_MissingName(t *testing.T) {
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewCategoryCreateRestController(container)
+
+	req := httpserver.Request{Body: []byte(`{"name":""}`)}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 400, resp.Code)
+}
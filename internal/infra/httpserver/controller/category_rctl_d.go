@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type CategoryDeleteController struct {
+	controller *ctl.DeleteCategoryController
+}
+
+func NewCategoryDeleteByIdRestController(container *container.Container) httpserver.IController {
+	return &CategoryDeleteController{
+		controller: ctl.NewDeleteCategoryController(container),
+	}
+}
+
+func (controller *CategoryDeleteController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	categoryId := request.ParseParamInt("categoryId")
+
+	err := controller.controller.Execute(ctx, categoryId)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.NoContent()
+}
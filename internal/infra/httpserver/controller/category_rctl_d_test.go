@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestCategoryDeleteRestController_Handle_NoContent(t *testing.T) {
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepoInterface{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewCategoryDeleteByIdRestController(container)
+
+	req := httpserver.Request{Params: map[string]string{"categoryId": "5"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, httpserver.NoContent(), resp)
+}
+
+func TestCategoryDeleteRestController_Handle_InUse(t *testing.T) {
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			CountFunc: func(ctx context.Context, query model.ProductQuery) (int64, error) {
+				return 1, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewCategoryDeleteByIdRestController(container)
+
+	req := httpserver.Request{Params: map[string]string{"categoryId": "1"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 422, resp.Code)
+}
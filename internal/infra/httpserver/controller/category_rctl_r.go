@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type CategoryFindController struct {
+	controller *ctl.FindCategoryController
+}
+
+func NewCategoryFindRestController(container *container.Container) httpserver.IController {
+	return &CategoryFindController{
+		controller: ctl.NewFindCategoryController(container),
+	}
+}
+
+func (controller *CategoryFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	categories, err := controller.controller.Execute(ctx)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(categories)
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type CategoryFindOneController struct {
+	controller *ctl.FindOneCategoryController
+}
+
+func NewCategoryFindOneRestController(container *container.Container) httpserver.IController {
+	return &CategoryFindOneController{
+		controller: ctl.NewFindOneCategoryController(container),
+	}
+}
+
+func (controller *CategoryFindOneController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	categoryId := request.ParseParamInt("categoryId")
+
+	category, err := controller.controller.Execute(ctx, categoryId)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(category)
+}
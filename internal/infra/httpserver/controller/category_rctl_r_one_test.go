@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestCategoryFindOneRestController_Handle_Success(t *testing.T) {
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewCategoryFindOneRestController(container)
+
+	req := httpserver.Request{Params: map[string]string{"categoryId": "1"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+}
+
+func TestCategoryFindOneRestController_Handle_NotFound(t *testing.T) {
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoNotFound{},
+	}
+	ctrl := NewCategoryFindOneRestController(container)
+
+	req := httpserver.Request{Params: map[string]string{"categoryId": "42"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 404, resp.Code)
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestCategoryFindRestController_Handle(t *testing.T) {
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewCategoryFindRestController(container)
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{})
+
+	assert.Equal(t, 200, resp.Code)
+	output, ok := resp.Body.(dto.CategoryContent)
+	assert.True(t, ok)
+	assert.Len(t, output.Content, 1)
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type CategoryUpdateController struct {
+	controller *ctl.UpdateCategoryController
+}
+
+func NewCategoryUpdateByIdRestController(container *container.Container) httpserver.IController {
+	return &CategoryUpdateController{
+		controller: ctl.NewUpdateCategoryController(container),
+	}
+}
+
+func (controller *CategoryUpdateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.UpdateCategory{}
+
+	errBody := request.ParseBody(ctx, &command)
+	if errBody != nil {
+		return httpserver.HandleError(ctx, errBody)
+	}
+
+	err := validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	command.CategoryId = request.ParseParamInt("categoryId")
+
+	category, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(category)
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestCategoryUpdateRestController_Handle_Success(t *testing.T) {
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewCategoryUpdateByIdRestController(container)
+
+	req := httpserver.Request{
+		Params: map[string]string{"categoryId": "1"},
+		Body:   []byte(`{"name":"Lanches"}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	output, ok := resp.Body.(dto.Category)
+	assert.True(t, ok)
+	assert.Equal(t, 1, output.ID)
+	assert.Equal(t, "Lanches", output.Name)
+}
+
+func TestCategoryUpdateRestController_Handle_NotFound(t *testing.T) {
+	container := &container.Container{
+		CategoryRepository: &repository.MockCategoryRepoNotFound{},
+	}
+	ctrl := NewCategoryUpdateByIdRestController(container)
+
+	req := httpserver.Request{
+		Params: map[string]string{"categoryId": "42"},
+		Body:   []byte(`{"name":"Lanches"}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 404, resp.Code)
+}
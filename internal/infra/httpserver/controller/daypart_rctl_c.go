@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type DaypartCreateController struct {
+	controller *ctl.CreateDaypartController
+}
+
+func NewDaypartCreateRestController(container *container.Container) httpserver.IController {
+	return &DaypartCreateController{
+		controller: ctl.NewCreateDaypartController(container),
+	}
+}
+
+func (controller *DaypartCreateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	document := dto.DaypartDocument{}
+
+	err := request.ParseBody(ctx, &document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	daypart, err := controller.controller.Execute(ctx, document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Created(daypart)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type DaypartDeleteController struct {
+	controller *ctl.DeleteDaypartController
+}
+
+func NewDaypartDeleteRestController(container *container.Container) httpserver.IController {
+	return &DaypartDeleteController{
+		controller: ctl.NewDeleteDaypartController(container),
+	}
+}
+
+func (controller *DaypartDeleteController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	err := controller.controller.Execute(ctx, request.ParseParamString("daypartId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.NoContent()
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type DaypartFindController struct {
+	controller *ctl.FindDaypartController
+}
+
+func NewDaypartFindRestController(container *container.Container) httpserver.IController {
+	return &DaypartFindController{
+		controller: ctl.NewFindDaypartController(container),
+	}
+}
+
+func (controller *DaypartFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	dayparts, err := controller.controller.Execute(ctx)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(dayparts)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type DaypartFindOneController struct {
+	controller *ctl.FindOneDaypartController
+}
+
+func NewDaypartFindOneRestController(container *container.Container) httpserver.IController {
+	return &DaypartFindOneController{
+		controller: ctl.NewFindOneDaypartController(container),
+	}
+}
+
+func (controller *DaypartFindOneController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	daypart, err := controller.controller.Execute(ctx, request.ParseParamString("daypartId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(daypart)
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type DaypartUpdateController struct {
+	controller *ctl.UpdateDaypartController
+}
+
+func NewDaypartUpdateRestController(container *container.Container) httpserver.IController {
+	return &DaypartUpdateController{
+		controller: ctl.NewUpdateDaypartController(container),
+	}
+}
+
+// Handle replaces the whole daypart, its schedules and assignments.
+func (controller *DaypartUpdateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	document := dto.DaypartDocument{}
+
+	err := request.ParseBody(ctx, &document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	daypart, err := controller.controller.Execute(ctx, dto.UpdateDaypart{
+		DaypartId:       request.ParseParamString("daypartId"),
+		DaypartDocument: document,
+	})
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(daypart)
+}
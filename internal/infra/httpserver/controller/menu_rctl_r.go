@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+type MenuFindController struct {
+	controller *ctl.FindMenuController
+}
+
+func NewMenuFindRestController(container *container.Container) httpserver.IController {
+	return &MenuFindController{
+		controller: ctl.NewFindMenuController(container),
+	}
+}
+
+// Handle lists what can be ordered now, or at the RFC 3339 instant given by at.
+func (controller *MenuFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	vErr := xerrors.NewValidationError("Invalid Query")
+
+	query := dto.MenuQuery{
+		At: parseTime(request, "at", &vErr),
+	}
+	if len(vErr.Fields) > 0 {
+		return httpserver.HandleError(ctx, vErr)
+	}
+
+	menu, err := controller.controller.Execute(ctx, query)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(menu)
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ModifierGroupCreateController struct {
+	controller *ctl.CreateModifierGroupController
+}
+
+func NewModifierGroupCreateRestController(container *container.Container) httpserver.IController {
+	return &ModifierGroupCreateController{
+		controller: ctl.NewCreateModifierGroupController(container),
+	}
+}
+
+func (controller *ModifierGroupCreateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	document := dto.ModifierGroupDocument{}
+
+	err := request.ParseBody(ctx, &document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	group, err := controller.controller.Execute(ctx, document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Created(group)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ModifierGroupDeleteController struct {
+	controller *ctl.DeleteModifierGroupController
+}
+
+func NewModifierGroupDeleteRestController(container *container.Container) httpserver.IController {
+	return &ModifierGroupDeleteController{
+		controller: ctl.NewDeleteModifierGroupController(container),
+	}
+}
+
+func (controller *ModifierGroupDeleteController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	err := controller.controller.Execute(ctx, request.ParseParamString("groupId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.NoContent()
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ModifierGroupFindController struct {
+	controller *ctl.FindModifierGroupController
+}
+
+func NewModifierGroupFindRestController(container *container.Container) httpserver.IController {
+	return &ModifierGroupFindController{
+		controller: ctl.NewFindModifierGroupController(container),
+	}
+}
+
+func (controller *ModifierGroupFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	groups, err := controller.controller.Execute(ctx)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(groups)
+}
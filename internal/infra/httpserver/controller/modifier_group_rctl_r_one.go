@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ModifierGroupFindOneController struct {
+	controller *ctl.FindOneModifierGroupController
+}
+
+func NewModifierGroupFindOneRestController(container *container.Container) httpserver.IController {
+	return &ModifierGroupFindOneController{
+		controller: ctl.NewFindOneModifierGroupController(container),
+	}
+}
+
+func (controller *ModifierGroupFindOneController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	group, err := controller.controller.Execute(ctx, request.ParseParamString("groupId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(group)
+}
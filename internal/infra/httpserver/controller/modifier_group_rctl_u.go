@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ModifierGroupUpdateController struct {
+	controller *ctl.UpdateModifierGroupController
+}
+
+func NewModifierGroupUpdateRestController(container *container.Container) httpserver.IController {
+	return &ModifierGroupUpdateController{
+		controller: ctl.NewUpdateModifierGroupController(container),
+	}
+}
+
+// Handle replaces the whole group, the products it is attached to get the new version of it.
+func (controller *ModifierGroupUpdateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	document := dto.ModifierGroupDocument{}
+
+	err := request.ParseBody(ctx, &document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	group, err := controller.controller.Execute(ctx, dto.UpdateModifierGroup{
+		GroupId:               request.ParseParamString("groupId"),
+		ModifierGroupDocument: document,
+	})
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(group)
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+const (
+	defaultPage      = 1
+	defaultPageSize  = 20
+	defaultSort      = "createdAt"
+	defaultDirection = "asc"
+)
+
+// parsePageQuery fills the paging and sorting attributes of the query from the query string,
+// keeping the defaults for absent parameters.
+func parsePageQuery(request httpserver.Request, query *dto.ProductQuery) error {
+	vErr := xerrors.NewValidationError("Invalid Query")
+
+	query.Page = defaultPage
+	query.Size = defaultPageSize
+	query.Sort = defaultSort
+	query.Direction = defaultDirection
+
+	if value, ok := request.Query["page"]; ok {
+		page, err := strconv.Atoi(value)
+		if err != nil {
+			vErr = vErr.AddField("page", xerrors.ReasonTypeInvalidValue)
+		}
+		query.Page = page
+	}
+	if value, ok := request.Query["size"]; ok {
+		size, err := strconv.Atoi(value)
+		if err != nil {
+			vErr = vErr.AddField("size", xerrors.ReasonTypeInvalidValue)
+		}
+		query.Size = size
+	}
+	if value, ok := request.Query["sort"]; ok {
+		query.Sort = value
+	}
+	if value, ok := request.Query["direction"]; ok {
+		query.Direction = value
+	}
+
+	if len(vErr.Fields) > 0 {
+		return vErr
+	}
+
+	return nil
+}
+
+// buildPageLinks points to the current, next and previous pages keeping every other query parameter.
+func buildPageLinks(request httpserver.Request, page int, totalPages int) dto.PageLinks {
+	links := dto.PageLinks{
+		Self: pageLink(request, page),
+	}
+
+	if page < totalPages {
+		links.Next = pageLink(request, page+1)
+	}
+	if page > 1 {
+		links.Prev = pageLink(request, min(page-1, max(totalPages, 1)))
+	}
+
+	return links
+}
+
+func pageLink(request httpserver.Request, page int) string {
+	values := url.Values{}
+	for key, value := range request.Query {
+		values.Set(key, value)
+	}
+	values.Set("page", strconv.Itoa(page))
+
+	return request.Path + "?" + values.Encode()
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductAvailabilityChangeController struct {
+	controller *ctl.ChangeProductAvailabilityController
+}
+
+func NewProductAvailabilityChangeRestController(container *container.Container) httpserver.IController {
+	return &ProductAvailabilityChangeController{
+		controller: ctl.NewChangeProductAvailabilityController(container),
+	}
+}
+
+// Handle marks the product unavailable or available again and answers with the product,
+// an If-Match header makes it conditional on the product version.
+func (controller *ProductAvailabilityChangeController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.ChangeProductAvailability{}
+
+	err := request.ParseBody(ctx, &command.ProductAvailabilityDocument)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(command.ProductAvailabilityDocument)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	command.Version, err = request.ParseIfMatch()
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+	command.ProductId = request.ParseParamString("productId")
+
+	product, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, command.Version)
+	}
+
+	return httpserver.Ok(product).WithHeader("ETag", httpserver.ETag(product.Version))
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductAvailabilityFindController struct {
+	controller *ctl.FindProductAvailabilityController
+}
+
+func NewProductAvailabilityFindRestController(container *container.Container) httpserver.IController {
+	return &ProductAvailabilityFindController{
+		controller: ctl.NewFindProductAvailabilityController(container),
+	}
+}
+
+// Handle answers with the availability of the product and who changed it lately.
+func (controller *ProductAvailabilityFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	availability, err := controller.controller.Execute(ctx, request.ParseParamString("productId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(availability)
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductBulkController struct {
+	controller *ctl.BulkProductController
+}
+
+func NewProductBulkRestController(container *container.Container) httpserver.IController {
+	return &ProductBulkController{
+		controller: ctl.NewBulkProductController(container),
+	}
+}
+
+// Handle answers with the report of every product written, with 422 when the failures left
+// nothing written.
+func (controller *ProductBulkController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.BulkProducts{}
+
+	errBody := request.ParseBody(ctx, &command)
+	if errBody != nil {
+		return httpserver.HandleError(ctx, errBody)
+	}
+
+	err := validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	report, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	if !report.Applied && report.Failed > 0 {
+		return httpserver.UnprocessableEntity(report)
+	}
+
+	return httpserver.Ok(report)
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+// rollbackTransactor stands for a database transactor, the mocks write nothing to roll back.
+type rollbackTransactor struct{}
+
+func (rollbackTransactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (rollbackTransactor) RollsBack() bool {
+	return true
+}
+
+func newBulkContainer(writes *[]model.ProductWrite, errs []error) *container.Container {
+	return &container.Container{
+		Transactor: rollbackTransactor{},
+		ProductRepository: &repository.MockProductRepo{
+			FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+				if id == "missing" {
+					return nil, dbrepository.ErrNotFound
+				}
+				return &model.Product{ID: id, Name: "Suco", Description: "Laranja", CategoryId: 1, Amount: 8, Version: 2}, nil
+			},
+			BulkWriteFunc: func(ctx context.Context, w []model.ProductWrite) ([]error, error) {
+				*writes = append(*writes, w...)
+				return errs, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+}
+
+func TestProductBulkController_Handle_Success(t *testing.T) {
+	writes := []model.ProductWrite{}
+	ctrl := NewProductBulkRestController(newBulkContainer(&writes, []error{nil, nil}))
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Body: []byte(`{"operations":[
+		{"type":"update","id":"prod1","version":2,"amount":9.5},
+		{"type":"delete","id":"prod2"},
+		{"type":"delete","id":"missing"}]}`)})
+
+	assert.Equal(t, 200, resp.Code)
+	require.Len(t, writes, 2)
+	assert.Equal(t, 9.5, writes[0].Product.Amount)
+	assert.Equal(t, "Suco", writes[0].Product.Name)
+	assert.Equal(t, int64(2), writes[0].Product.Version)
+	assert.True(t, writes[1].Delete)
+
+	report, ok := resp.Body.(dto.BulkReport)
+	require.True(t, ok)
+	assert.Equal(t, 2, report.Succeeded)
+	assert.Equal(t, dto.BulkItemFailed, report.Results[2].Status)
+}
+
+func TestProductBulkController_Handle_AtomicConflict(t *testing.T) {
+	writes := []model.ProductWrite{}
+	ctrl := NewProductBulkRestController(newBulkContainer(&writes, []error{nil, dbrepository.ErrVersionConflict}))
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Body: []byte(`{"atomic":true,"operations":[
+		{"type":"update","id":"prod1","name":"Suco de uva"},
+		{"type":"update","id":"prod2","description":"Uva"}]}`)})
+
+	assert.Equal(t, 422, resp.Code)
+
+	report, ok := resp.Body.(dto.BulkReport)
+	require.True(t, ok)
+	assert.False(t, report.Applied)
+	assert.Equal(t, dto.BulkItemSkipped, report.Results[0].Status)
+	assert.Nil(t, report.Results[0].Product)
+	assert.Equal(t, "TL-PRODUCT-003", report.Results[1].Error.Code)
+}
+
+func TestProductBulkController_Handle_AtomicWithoutRollback(t *testing.T) {
+	writes := []model.ProductWrite{}
+	c := newBulkContainer(&writes, []error{nil, dbrepository.ErrVersionConflict})
+	c.Transactor = dbrepository.NewMemoryTransactor()
+	ctrl := NewProductBulkRestController(c)
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Body: []byte(`{"atomic":true,"operations":[
+		{"type":"update","id":"prod1","name":"Suco de uva"},
+		{"type":"update","id":"prod2","description":"Uva"}]}`)})
+
+	assert.Equal(t, 400, resp.Code)
+	assert.Empty(t, writes)
+}
+
+func TestProductBulkController_Handle_InvalidOperation(t *testing.T) {
+	writes := []model.ProductWrite{}
+	ctrl := NewProductBulkRestController(newBulkContainer(&writes, nil))
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Body: []byte(`{"operations":[
+		{"type":"adjustPrice","categoryId":1,"percentage":5,"delta":1}]}`)})
+
+	assert.Equal(t, 422, resp.Code)
+	assert.Empty(t, writes)
+
+	resp = ctrl.Handle(context.Background(), httpserver.Request{Body: []byte(`{"operations":[]}`)})
+	assert.Equal(t, 400, resp.Code)
+}
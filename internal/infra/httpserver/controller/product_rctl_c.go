@@ -18,7 +18,7 @@ type ProductCreateRestController struct {
 type ProductCreateRequest struct {
 	Name        string  `json:"name" validate:"required"`
 	Description string  `json:"description" validate:"required"`
-	CategoryId  int     `json:"categoryId" validate:"required,oneof='1' '2' '3' '4'"`
+	CategoryId  int     `json:"categoryId" validate:"required"`
 	Amount      float64 `json:"amount" validate:"required"`
 }
 
@@ -2,9 +2,9 @@ package controller
 
 import (
 	"context"
-	"log/slog"
 
 	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
 )
@@ -23,17 +23,15 @@ func (controller *ProductDeleteController) Handle(ctx context.Context, request h
 
 	product_id := request.ParseParamString("productId")
 
-	output, err := controller.controller.Execute(ctx, product_id)
+	version, err := request.ParseIfMatch()
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
 
-	if err != nil || output == "" {
-		if err.Error() == "record not found" {
-			slog.ErrorContext(ctx, (err.Error() + " id: " + product_id))
-			return httpserver.NotFound(err)
-		}
-		slog.ErrorContext(ctx, err.Error())
-		return httpserver.UnprocessableEntity(err)
+	_, err = controller.controller.Execute(ctx, dto.DeleteProduct{ProductId: product_id, Version: version})
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, version)
 	}
 
 	return httpserver.NoContent()
-
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
 	"github.com/tbtec/tremligeiro/test/repository"
 )
@@ -49,8 +51,8 @@ func TestProductDeleteController_Handle_RecordNotFound(t *testing.T) {
 			ExecuteFunc: func(ctx context.Context, productId string) (string, error) {
 				return "", assert.AnError
 			},
-			DeleteByIdFunc: func(ctx context.Context, id string) (*model.Product, error) {
-				return nil, errors.New("record not found")
+			DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+				return nil, dbrepository.ErrNotFound
 			},
 		},
 	}
@@ -59,17 +61,17 @@ func TestProductDeleteController_Handle_RecordNotFound(t *testing.T) {
 
 	resp := ctrl.Handle(context.Background(), req)
 
-	assert.Equal(t, httpserver.NotFound(errors.New("record not found")), resp)
+	assert.Equal(t, httpserver.NotFound(httpserver.NewErrorMessage("404", "Product not found")), resp)
 }
 
-func TestProductDeleteController_Handle_UnprocessableEntity(t *testing.T) {
+func TestProductDeleteController_Handle_InternalServerError(t *testing.T) {
 	container := &container.Container{
 		ProductRepository: &repository.MockProductRepo{
 			ExecuteFunc: func(ctx context.Context, productId string) (string, error) {
 				return "", assert.AnError
 			},
-			DeleteByIdFunc: func(ctx context.Context, id string) (*model.Product, error) {
-				return nil, errors.New("Unprocessable")
+			DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+				return nil, errors.New("connection reset")
 			},
 		},
 	}
@@ -78,5 +80,27 @@ func TestProductDeleteController_Handle_UnprocessableEntity(t *testing.T) {
 
 	resp := ctrl.Handle(context.Background(), req)
 
-	assert.Equal(t, 422, resp.Code)
+	assert.Equal(t, 500, resp.Code)
+}
+
+func TestProductDeleteController_Handle_IfMatch(t *testing.T) {
+	var requested int64
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			DeleteByIdFunc: func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+				requested = version
+				return nil, dbrepository.ErrVersionConflict
+			},
+		},
+	}
+	ctrl := NewProductDeleteByIdRestController(container)
+	req := httpserver.Request{
+		Params:  map[string]string{"productId": "123"},
+		Headers: map[string]string{"if-match": `"5"`},
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 412, resp.Code)
+	assert.Equal(t, int64(5), requested)
 }
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+var exportContentTypes = map[string]string{
+	dto.ExportFormatCSV:    "text/csv; charset=utf-8",
+	dto.ExportFormatJSON:   "application/json",
+	dto.ExportFormatNDJSON: "application/x-ndjson",
+}
+
+type ProductExportController struct {
+	controller *ctl.ExportProductController
+}
+
+func NewProductExportRestController(container *container.Container) httpserver.IController {
+	return &ProductExportController{
+		controller: ctl.NewExportProductController(container),
+	}
+}
+
+// Handle streams the catalog as an attachment, in JSON unless format asks otherwise.
+// categoryId filters like in the listing. The errors found before the export starts are answered
+// as usual, the ones while streaming can only cut the body short.
+func (controller *ProductExportController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.ExportProducts{Format: dto.ExportFormatJSON}
+
+	if value, ok := request.Query["format"]; ok {
+		command.Format = value
+	}
+
+	filter := dto.ProductQuery{}
+	err := parseProductFilter(request, &filter)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+	command.CategoryIds = filter.CategoryIds
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	export, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(httpserver.Stream(export)).
+		WithHeader("Content-Type", exportContentTypes[command.Format]).
+		WithHeader("Content-Disposition", `attachment; filename="products.`+command.Format+`"`)
+}
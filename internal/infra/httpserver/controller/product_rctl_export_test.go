@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestProductExportController_Handle_NDJSON(t *testing.T) {
+	query := model.ProductQuery{}
+	ctrl := NewProductExportRestController(&container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			StreamFunc: func(ctx context.Context, q model.ProductQuery, fn func(product model.Product) error) error {
+				query = q
+				if err := fn(model.Product{ID: "prod1", Name: "Suco", CategoryId: 1, Amount: 8.5}); err != nil {
+					return err
+				}
+				return fn(model.Product{ID: "prod2", Name: "Chá", CategoryId: 7, Amount: 5})
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	})
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{
+		Query: map[string]string{"format": "ndjson", "categoryId": "1,7"},
+	})
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "application/x-ndjson", resp.Headers["Content-Type"])
+
+	stream, ok := resp.Body.(httpserver.Stream)
+	require.True(t, ok)
+
+	body := bytes.Buffer{}
+	require.NoError(t, stream(&body))
+	assert.Equal(t, []int{1, 7}, query.CategoryIds)
+	assert.Contains(t, body.String(), `"id":"prod1","name":"Suco"`)
+	assert.Contains(t, body.String(), `"category":{"id":7,"name":""}`)
+	assert.Equal(t, 2, bytes.Count(body.Bytes(), []byte("\n")))
+}
+
+func TestProductExportController_Handle_DatabaseError(t *testing.T) {
+	streamed := false
+	ctrl := NewProductExportRestController(&container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			CountFunc: func(ctx context.Context, query model.ProductQuery) (int64, error) {
+				return 0, errors.New("connection refused")
+			},
+			StreamFunc: func(ctx context.Context, q model.ProductQuery, fn func(product model.Product) error) error {
+				streamed = true
+				return nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	})
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{})
+
+	assert.Equal(t, 500, resp.Code)
+	assert.False(t, streamed)
+	_, ok := resp.Body.(httpserver.Stream)
+	assert.False(t, ok)
+}
+
+func TestProductExportController_Handle_InvalidFormat(t *testing.T) {
+	ctrl := NewProductExportRestController(&container.Container{
+		ProductRepository:  &repository.MockProductRepo{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	})
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Query: map[string]string{"format": "xlsx"}})
+
+	assert.Equal(t, 400, resp.Code)
+}
@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/imaging"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+// imageFormField is the multipart field carrying the image.
+const imageFormField = "file"
+
+type ProductImageUploadController struct {
+	controller *ctl.UploadProductImageController
+}
+
+func NewProductImageUploadRestController(container *container.Container) httpserver.IController {
+	return &ProductImageUploadController{
+		controller: ctl.NewUploadProductImageController(container),
+	}
+}
+
+// Handle adds the image to the product, sent either as the whole body with its own Content-Type
+// or as the "file" part of a multipart/form-data body. It answers 201 with the product.
+func (controller *ProductImageUploadController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	mediaType, params, err := mime.ParseMediaType(request.ParseHeader("content-type"))
+	if err != nil {
+		return unsupportedImageType()
+	}
+
+	command := dto.UploadProductImage{
+		ProductId:   request.ParseParamString("productId"),
+		ContentType: mediaType,
+		Content:     request.Body,
+	}
+
+	if mediaType == "multipart/form-data" {
+		command.ContentType, command.Content, err = readImagePart(request.Body, params["boundary"])
+		if err != nil {
+			return httpserver.HandleError(ctx, err)
+		}
+	}
+
+	if _, ok := imaging.ContentTypes[command.ContentType]; !ok {
+		return unsupportedImageType()
+	}
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	product, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Created(product)
+}
+
+// readImagePart returns the content type and the content of the image part of a multipart body.
+func readImagePart(body []byte, boundary string) (string, []byte, error) {
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return "", nil, xerrors.NewValidationError("Invalid Body").
+				AddField(imageFormField, xerrors.ReasonRequiredAttributeMissing)
+		}
+		if err != nil {
+			return "", nil, xerrors.NewValidationError("Invalid Body").
+				AddField("content", xerrors.ReasonTypeInvalidValue)
+		}
+		if part.FormName() != imageFormField {
+			continue
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return "", nil, xerrors.NewValidationError("Invalid Body").
+				AddField(imageFormField, xerrors.ReasonTypeInvalidValue)
+		}
+
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		return contentType, content, nil
+	}
+}
+
+func unsupportedImageType() httpserver.Response {
+	return httpserver.UnsupportedMediaType(
+		httpserver.NewErrorMessage("415", "Content-Type must be image/jpeg, image/png or image/webp"))
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductImageDeleteController struct {
+	controller *ctl.DeleteProductImageController
+}
+
+func NewProductImageDeleteRestController(container *container.Container) httpserver.IController {
+	return &ProductImageDeleteController{
+		controller: ctl.NewDeleteProductImageController(container),
+	}
+}
+
+func (controller *ProductImageDeleteController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.DeleteProductImage{
+		ProductId: request.ParseParamString("productId"),
+		ImageId:   request.ParseParamString("imageId"),
+	}
+
+	err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.NoContent()
+}
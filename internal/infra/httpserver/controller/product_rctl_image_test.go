@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/infra/storage"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func newImageContainer(t *testing.T, product *model.Product) *container.Container {
+	return &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+				return product, nil
+			},
+			UpdateByIdFunc: func(ctx context.Context, updated *model.Product) error {
+				*product = *updated
+				return nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+		ImageStorage:       storage.NewLocalStorage(t.TempDir(), "http://cdn.example.com"),
+		ImagePolicy:        entity.ProductImagePolicy{MaxBytes: 1 << 20, MaxImages: 1, ThumbnailSizes: []int{4}},
+	}
+}
+
+func pngContent(t *testing.T) []byte {
+	buffer := bytes.Buffer{}
+	require.NoError(t, png.Encode(&buffer, image.NewGray(image.Rect(0, 0, 8, 6))))
+	return buffer.Bytes()
+}
+
+func TestProductImageUploadController_Handle_Success(t *testing.T) {
+	product := &model.Product{ID: "prod1", CategoryId: 1, Version: 2}
+	ctrl := NewProductImageUploadRestController(newImageContainer(t, product))
+
+	req := httpserver.Request{
+		Params:  map[string]string{"productId": "prod1"},
+		Headers: map[string]string{"content-type": "image/png"},
+		Body:    pngContent(t),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 201, resp.Code)
+	body, ok := resp.Body.(dto.Product)
+	require.True(t, ok)
+	require.Len(t, body.Images, 1)
+	assert.Equal(t, 8, body.Images[0].Width)
+	assert.Equal(t, []dto.ProductThumbnail{{Size: 4, Width: 4, Height: 3,
+		Url: "http://cdn.example.com/products/prod1/" + body.Images[0].ImageId + "/4.png"}}, body.Images[0].Thumbnails)
+	assert.Len(t, product.Images, 1)
+}
+
+func TestProductImageUploadController_Handle_TooManyImages(t *testing.T) {
+	product := &model.Product{ID: "prod1", CategoryId: 1, Images: model.ProductImages{{ID: "img1"}}}
+	ctrl := NewProductImageUploadRestController(newImageContainer(t, product))
+
+	req := httpserver.Request{
+		Params:  map[string]string{"productId": "prod1"},
+		Headers: map[string]string{"content-type": "image/png"},
+		Body:    pngContent(t),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 422, resp.Code)
+}
+
+func TestProductImageUploadController_Handle_UnsupportedMediaType(t *testing.T) {
+	product := &model.Product{ID: "prod1", CategoryId: 1}
+	ctrl := NewProductImageUploadRestController(newImageContainer(t, product))
+
+	req := httpserver.Request{
+		Params:  map[string]string{"productId": "prod1"},
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    []byte(`{}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 415, resp.Code)
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"mime"
+	"strconv"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+var importFormats = map[string]string{
+	"text/csv":         dto.ImportFormatCSV,
+	"application/json": dto.ImportFormatJSON,
+}
+
+type ProductImportController struct {
+	controller *ctl.ImportProductController
+}
+
+func NewProductImportRestController(container *container.Container) httpserver.IController {
+	return &ProductImportController{
+		controller: ctl.NewImportProductController(container),
+	}
+}
+
+// Handle imports the products in the body, whose format the Content-Type tells. The report comes
+// with 422 when the import saved nothing, a dry run always answers 200.
+func (controller *ProductImportController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	mediaType, _, err := mime.ParseMediaType(request.ParseHeader("content-type"))
+	format, ok := importFormats[mediaType]
+	if err != nil || !ok {
+		return httpserver.UnsupportedMediaType(
+			httpserver.NewErrorMessage("415", "Content-Type must be text/csv or application/json"))
+	}
+
+	command := dto.ImportProducts{
+		Format:  format,
+		Mode:    dto.ImportAllOrNothing,
+		Content: request.Body,
+	}
+
+	if value, ok := request.Query["mode"]; ok {
+		command.Mode = value
+	}
+
+	if value, ok := request.Query["dryRun"]; ok {
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			return httpserver.HandleError(ctx, xerrors.NewValidationError("Invalid Query").
+				AddField("dryRun", xerrors.ReasonTypeInvalidValue))
+		}
+		command.DryRun = dryRun
+	}
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	report, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	if !report.Applied && !report.DryRun {
+		return httpserver.UnprocessableEntity(report)
+	}
+
+	return httpserver.Ok(report)
+}
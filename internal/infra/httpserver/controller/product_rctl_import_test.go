@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func newImportContainer(created *[]model.Product) *container.Container {
+	return &container.Container{
+		Transactor: rollbackTransactor{},
+		ProductRepository: &repository.MockProductRepo{
+			CreateFunc: func(ctx context.Context, product *model.Product) error {
+				*created = append(*created, *product)
+				return nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+}
+
+// commitTransactor runs fn again after it fails, as Mongo does on a transient error,
+// then fails the commit with err when there is one.
+type commitTransactor struct {
+	retries int
+	err     error
+}
+
+func (transactor commitTransactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := fn(ctx)
+	for i := 0; err != nil && i < transactor.retries; i++ {
+		err = fn(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	return transactor.err
+}
+
+func (commitTransactor) RollsBack() bool {
+	return true
+}
+
+func importRequest(contentType string, query map[string]string, body string) httpserver.Request {
+	return httpserver.Request{
+		Headers: map[string]string{"content-type": contentType},
+		Query:   query,
+		Body:    []byte(body),
+	}
+}
+
+func TestProductImportController_Handle_CSV(t *testing.T) {
+	created := []model.Product{}
+	ctrl := NewProductImportRestController(newImportContainer(&created))
+
+	resp := ctrl.Handle(context.Background(), importRequest("text/csv; charset=utf-8", nil,
+		"\xef\xbb\xbfname;description;categoryId;amount\nPastel;Pastel de carne;1;12,50\nCoxinha;Coxinha de frango;1;8\n"))
+
+	assert.Equal(t, 200, resp.Code)
+	require.Len(t, created, 2)
+	assert.Equal(t, "Pastel", created[0].Name)
+	assert.Equal(t, 12.5, created[0].Amount)
+
+	report, ok := resp.Body.(dto.ImportReport)
+	require.True(t, ok)
+	assert.True(t, report.Applied)
+	assert.Equal(t, 2, report.Created)
+	assert.Equal(t, dto.ImportRowCreated, report.Rows[1].Status)
+	assert.Equal(t, created[1].ID, report.Rows[1].ProductId)
+}
+
+func TestProductImportController_Handle_AllOrNothing(t *testing.T) {
+	created := []model.Product{}
+	ctrl := NewProductImportRestController(newImportContainer(&created))
+
+	resp := ctrl.Handle(context.Background(), importRequest("application/json", nil,
+		`[{"name":"Pastel","description":"Pastel de carne","categoryId":1,"amount":12.5},
+		  {"name":"Coxinha","categoryId":9,"amount":-1,"size":"G"}]`))
+
+	assert.Equal(t, 422, resp.Code)
+	assert.Empty(t, created)
+
+	report, ok := resp.Body.(dto.ImportReport)
+	require.True(t, ok)
+	assert.False(t, report.Applied)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, dto.ImportRowSkipped, report.Rows[0].Status)
+	assert.Equal(t, dto.ImportRowFailed, report.Rows[1].Status)
+	assert.Equal(t, "400", report.Rows[1].Error.Code)
+	assert.Equal(t, "size", report.Rows[1].Error.Details[0].Attribute)
+}
+
+func TestProductImportController_Handle_AllOrNothingCommitFailed(t *testing.T) {
+	created := []model.Product{}
+	c := newImportContainer(&created)
+	c.Transactor = commitTransactor{err: errors.New("commit failed")}
+	ctrl := NewProductImportRestController(c)
+
+	resp := ctrl.Handle(context.Background(), importRequest("text/csv", nil,
+		"name,description,categoryId,amount\nPastel,Pastel de carne,1,12.5\nCoxinha,Coxinha de frango,1,8\n"))
+
+	assert.Equal(t, 422, resp.Code)
+
+	report, ok := resp.Body.(dto.ImportReport)
+	require.True(t, ok)
+	assert.False(t, report.Applied)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, dto.ImportRowFailed, report.Rows[0].Status)
+	assert.Equal(t, dto.ImportRowFailed, report.Rows[1].Status)
+}
+
+func TestProductImportController_Handle_AllOrNothingRetried(t *testing.T) {
+	created := []model.Product{}
+	c := newImportContainer(&created)
+	c.Transactor = commitTransactor{retries: 1}
+	failures := 1
+	creates := c.ProductRepository.(*repository.MockProductRepo).CreateFunc
+	c.ProductRepository.(*repository.MockProductRepo).CreateFunc = func(ctx context.Context, product *model.Product) error {
+		if product.Name == "Coxinha" && failures > 0 {
+			failures--
+			created = created[:0]
+			return errors.New("transient error")
+		}
+		return creates(ctx, product)
+	}
+	ctrl := NewProductImportRestController(c)
+
+	resp := ctrl.Handle(context.Background(), importRequest("text/csv", nil,
+		"name,description,categoryId,amount\nPastel,Pastel de carne,1,12.5\nCoxinha,Coxinha de frango,1,8\n"))
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Len(t, created, 2)
+
+	report, ok := resp.Body.(dto.ImportReport)
+	require.True(t, ok)
+	assert.True(t, report.Applied)
+	assert.Equal(t, 2, report.Created)
+	assert.Equal(t, dto.ImportRowCreated, report.Rows[0].Status)
+	assert.Equal(t, dto.ImportRowCreated, report.Rows[1].Status)
+}
+
+func TestProductImportController_Handle_AllOrNothingWithoutRollback(t *testing.T) {
+	created := []model.Product{}
+	c := newImportContainer(&created)
+	c.Transactor = dbrepository.NewMemoryTransactor()
+	ctrl := NewProductImportRestController(c)
+
+	body := "name,description,categoryId,amount\nPastel,Pastel de carne,1,12.5\n"
+
+	resp := ctrl.Handle(context.Background(), importRequest("text/csv", nil, body))
+	assert.Equal(t, 400, resp.Code)
+	assert.Empty(t, created)
+
+	resp = ctrl.Handle(context.Background(), importRequest("text/csv", map[string]string{"dryRun": "true"}, body))
+	assert.Equal(t, 200, resp.Code)
+
+	resp = ctrl.Handle(context.Background(), importRequest("text/csv", map[string]string{"mode": "best-effort"}, body))
+	assert.Equal(t, 200, resp.Code)
+	assert.Len(t, created, 1)
+}
+
+func TestProductImportController_Handle_BestEffortDryRun(t *testing.T) {
+	created := []model.Product{}
+	ctrl := NewProductImportRestController(newImportContainer(&created))
+
+	resp := ctrl.Handle(context.Background(), importRequest("application/json",
+		map[string]string{"mode": "best-effort", "dryRun": "true"},
+		`[{"name":"Pastel","description":"Pastel de carne","categoryId":1,"amount":12.5},
+		  {"name":"Coxinha","description":"Coxinha de frango","categoryId":9,"amount":8}]`))
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Empty(t, created)
+
+	report, ok := resp.Body.(dto.ImportReport)
+	require.True(t, ok)
+	assert.False(t, report.Applied)
+	assert.Equal(t, 1, report.Created)
+	assert.Equal(t, "TL-PRODUCT-001", report.Rows[1].Error.Code)
+}
+
+func TestProductImportController_Handle_InvalidRequest(t *testing.T) {
+	created := []model.Product{}
+	ctrl := NewProductImportRestController(newImportContainer(&created))
+
+	resp := ctrl.Handle(context.Background(), importRequest("application/xml", nil, `<products/>`))
+	assert.Equal(t, 415, resp.Code)
+
+	resp = ctrl.Handle(context.Background(), importRequest("application/json", map[string]string{"mode": "some"}, `[]`))
+	assert.Equal(t, 400, resp.Code)
+
+	resp = ctrl.Handle(context.Background(), importRequest("text/csv", nil, "name,price\nPastel,10\n"))
+	assert.Equal(t, 400, resp.Code)
+
+	resp = ctrl.Handle(context.Background(), importRequest("application/json", nil, `[]`))
+	assert.Equal(t, 400, resp.Code)
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductModifierGroupsController struct {
+	controller *ctl.AttachModifierGroupsController
+}
+
+func NewProductModifierGroupsRestController(container *container.Container) httpserver.IController {
+	return &ProductModifierGroupsController{
+		controller: ctl.NewAttachModifierGroupsController(container),
+	}
+}
+
+// Handle replaces the modifier groups attached to the product and answers with the product,
+// an If-Match header makes it conditional on the product version.
+func (controller *ProductModifierGroupsController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.AttachModifierGroups{}
+
+	err := request.ParseBody(ctx, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	command.Version, err = request.ParseIfMatch()
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+	command.ProductId = request.ParseParamString("productId")
+
+	product, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, command.Version)
+	}
+
+	return httpserver.Ok(product).WithHeader("ETag", httpserver.ETag(product.Version))
+}
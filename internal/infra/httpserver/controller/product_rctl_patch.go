@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+	"mime"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/mergepatch"
+)
+
+type ProductPatchController struct {
+	controller *ctl.PatchProductController
+}
+
+func NewProductPatchByIdRestController(container *container.Container) httpserver.IController {
+	return &ProductPatchController{
+		controller: ctl.NewPatchProductController(container),
+	}
+}
+
+// Handle applies a JSON Merge Patch (RFC 7396): only the sent attributes change and null clears one.
+func (controller *ProductPatchController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	mediaType, _, err := mime.ParseMediaType(request.ParseHeader("content-type"))
+	if err != nil || mediaType != mergepatch.ContentType {
+		return httpserver.UnsupportedMediaType(
+			httpserver.NewErrorMessage("415", "Content-Type must be "+mergepatch.ContentType))
+	}
+
+	version, err := request.ParseIfMatch()
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	command := dto.PatchProduct{
+		ProductId: request.ParseParamString("productId"),
+		Patch:     request.Body,
+		Version:   version,
+	}
+
+	product, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, version)
+	}
+
+	return httpserver.Ok(product).WithHeader("ETag", httpserver.ETag(product.Version))
+}
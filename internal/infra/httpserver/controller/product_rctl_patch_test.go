@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func newPatchContainer(saved *model.Product) *container.Container {
+	return &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+				if id != "prod1" {
+					return nil, nil
+				}
+				return &model.Product{
+					ID:          "prod1",
+					Name:        "Suco",
+					Description: "Laranja",
+					Amount:      8.5,
+					CategoryId:  3,
+					CreatedAt:   time.Now(),
+				}, nil
+			},
+			UpdateByIdFunc: func(ctx context.Context, product *model.Product) error {
+				*saved = *product
+				return nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+}
+
+func patchRequest(body string) httpserver.Request {
+	return httpserver.Request{
+		Params:  map[string]string{"productId": "prod1"},
+		Headers: map[string]string{"content-type": "application/merge-patch+json"},
+		Body:    []byte(body),
+	}
+}
+
+func TestProductPatchController_Handle_Success(t *testing.T) {
+	saved := model.Product{}
+	ctrl := NewProductPatchByIdRestController(newPatchContainer(&saved))
+
+	resp := ctrl.Handle(context.Background(), patchRequest(`{"amount":0,"description":null}`))
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "Suco", saved.Name)
+	assert.Equal(t, "", saved.Description)
+	assert.Equal(t, 0.0, saved.Amount)
+	assert.Equal(t, 3, saved.CategoryId)
+
+	product, ok := resp.Body.(dto.Product)
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, product.Amount)
+}
+
+func TestProductPatchController_Handle_UnsupportedMediaType(t *testing.T) {
+	saved := model.Product{}
+	ctrl := NewProductPatchByIdRestController(newPatchContainer(&saved))
+
+	req := patchRequest(`{"amount":0}`)
+	req.Headers = map[string]string{"content-type": "application/json"}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 415, resp.Code)
+	assert.Empty(t, saved.ID)
+}
+
+func TestProductPatchController_Handle_MediaTypeWithParameters(t *testing.T) {
+	saved := model.Product{}
+	ctrl := NewProductPatchByIdRestController(newPatchContainer(&saved))
+
+	req := patchRequest(`{"name":"Suco Natural"}`)
+	req.Headers = map[string]string{"content-type": "application/merge-patch+json; charset=utf-8"}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "Suco Natural", saved.Name)
+}
+
+func TestProductPatchController_Handle_InvalidPatch(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"unknown member", `{"color":"red"}`},
+		{"required member removed", `{"amount":null}`},
+		{"wrong type", `{"amount":"free"}`},
+		{"negative amount", `{"amount":-1}`},
+		{"malformed json", `{"amount":`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := model.Product{}
+			ctrl := NewProductPatchByIdRestController(newPatchContainer(&saved))
+
+			resp := ctrl.Handle(context.Background(), patchRequest(tt.body))
+
+			assert.Equal(t, 400, resp.Code)
+			assert.Empty(t, saved.ID)
+		})
+	}
+}
+
+func TestProductPatchController_Handle_IfMatch(t *testing.T) {
+	saved := model.Product{}
+	ctrl := NewProductPatchByIdRestController(newPatchContainer(&saved))
+
+	req := patchRequest(`{"amount":9}`)
+	req.Headers["if-match"] = `"7"`
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 412, resp.Code)
+	assert.Empty(t, saved.ID)
+}
+
+func TestProductPatchController_Handle_NotFound(t *testing.T) {
+	saved := model.Product{}
+	ctrl := NewProductPatchByIdRestController(newPatchContainer(&saved))
+
+	req := patchRequest(`{"amount":1}`)
+	req.Params = map[string]string{"productId": "missing"}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 404, resp.Code)
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductPriceController struct {
+	controller *ctl.PriceProductController
+}
+
+func NewProductPriceRestController(container *container.Container) httpserver.IController {
+	return &ProductPriceController{
+		controller: ctl.NewPriceProductController(container),
+	}
+}
+
+// Handle quotes the product with the variant and the modifiers in the body.
+func (controller *ProductPriceController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.PriceProduct{}
+
+	err := request.ParseBody(ctx, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+	command.ProductId = request.ParseParamString("productId")
+
+	price, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(price)
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+func newPricedProduct() *model.Product {
+	return &model.Product{ID: "prod1", CategoryId: 1, Amount: 20,
+		ModifierGroups: model.ModifierGroups{{ID: "extras", MaxSelections: 1,
+			Options: model.ModifierOptions{{ID: "bacon", Name: "Bacon", Amount: 4.5}}}}}
+}
+
+func TestProductPriceController_Handle_Success(t *testing.T) {
+	ctrl := NewProductPriceRestController(newVariantContainer(newPricedProduct()))
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod1"},
+		Body:   []byte(`{"modifiers":[{"groupId":"extras","optionId":"bacon"}]}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	body, ok := resp.Body.(dto.ProductPrice)
+	require.True(t, ok)
+	assert.Equal(t, 24.5, body.Total)
+	require.Len(t, body.Modifiers, 1)
+	assert.Equal(t, "Bacon", body.Modifiers[0].OptionName)
+}
+
+func TestProductPriceController_Handle_InvalidSelection(t *testing.T) {
+	ctrl := NewProductPriceRestController(newVariantContainer(newPricedProduct()))
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod1"},
+		Body:   []byte(`{"modifiers":[{"groupId":"extras","optionId":"bacon"},{"groupId":"extras","optionId":"bacon"}]}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 400, resp.Code)
+}
@@ -3,10 +3,15 @@ package controller
 import (
 	"context"
 	"strconv"
+	"strings"
+	"time"
 
 	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+	"github.com/tbtec/tremligeiro/internal/validator"
 )
 
 type ProductFindController struct {
@@ -21,7 +26,24 @@ func NewProductFindByCategoryRestController(container *container.Container) http
 
 func (controller *ProductFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
 
-	command, err := strconv.Atoi(request.Query["categoryId"])
+	command := dto.ProductQuery{}
+
+	err := parseProductFilter(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = parsePageQuery(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	command.HideUnavailable, err = parseHideUnavailable(request)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(command)
 	if err != nil {
 		return httpserver.HandleError(ctx, err)
 	}
@@ -31,5 +53,87 @@ func (controller *ProductFindController) Handle(ctx context.Context, request htt
 		return httpserver.HandleError(ctx, err)
 	}
 
+	product.Links = buildPageLinks(request, product.Page, product.TotalPages)
+
 	return httpserver.Ok(product)
 }
+
+// parseProductFilter reads the optional listing filters. categoryId accepts a comma separated list,
+// dates are RFC 3339 timestamps.
+func parseProductFilter(request httpserver.Request, query *dto.ProductQuery) error {
+	vErr := xerrors.NewValidationError("Invalid Query")
+
+	if value := request.ParseQuery("categoryId"); value != "" {
+		for _, item := range strings.Split(value, ",") {
+			categoryId, err := strconv.Atoi(strings.TrimSpace(item))
+			if err != nil {
+				vErr = vErr.AddField("categoryId", xerrors.ReasonTypeInvalidValue)
+				break
+			}
+			query.CategoryIds = append(query.CategoryIds, categoryId)
+		}
+	}
+
+	query.MinAmount = parseAmount(request, "minAmount", &vErr)
+	query.MaxAmount = parseAmount(request, "maxAmount", &vErr)
+	if query.MinAmount != nil && query.MaxAmount != nil && *query.MinAmount > *query.MaxAmount {
+		vErr = vErr.AddField("maxAmount", xerrors.ReasonTypeInvalidValue)
+	}
+
+	query.CreatedAfter = parseTime(request, "createdAfter", &vErr)
+	query.UpdatedAfter = parseTime(request, "updatedAfter", &vErr)
+	query.Name = request.ParseQuery("name")
+
+	if len(vErr.Fields) > 0 {
+		return vErr
+	}
+
+	return nil
+}
+
+// parseHideUnavailable tells whether the products that cannot be sold are left out of a customer
+// facing listing, which is unless includeUnavailable=true is given.
+func parseHideUnavailable(request httpserver.Request) (bool, error) {
+	value := request.ParseQuery("includeUnavailable")
+	if value == "" {
+		return true, nil
+	}
+
+	include, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, xerrors.NewValidationError("Invalid Query").
+			AddField("includeUnavailable", xerrors.ReasonTypeInvalidValue)
+	}
+
+	return !include, nil
+}
+
+func parseAmount(request httpserver.Request, name string, vErr *xerrors.ValidationError) *float64 {
+	value := request.ParseQuery(name)
+	if value == "" {
+		return nil
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		*vErr = vErr.AddField(name, xerrors.ReasonTypeInvalidValue)
+		return nil
+	}
+
+	return &amount
+}
+
+func parseTime(request httpserver.Request, name string, vErr *xerrors.ValidationError) *time.Time {
+	value := request.ParseQuery(name)
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		*vErr = vErr.AddField(name, xerrors.ReasonTypeInvalidValue)
+		return nil
+	}
+
+	return &parsed
+}
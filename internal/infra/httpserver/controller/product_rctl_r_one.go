@@ -27,5 +27,5 @@ func (controller *ProductFindOneController) Handle(ctx context.Context, request
 		return httpserver.HandleError(ctx, err)
 	}
 
-	return httpserver.Ok(product)
+	return httpserver.Ok(product).WithHeader("ETag", httpserver.ETag(product.Version))
 }
@@ -8,9 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
 	"github.com/tbtec/tremligeiro/test/repository"
 )
@@ -45,6 +45,7 @@ func TestProductFindOneController_Handle_Success(t *testing.T) {
 		Description: "Description 1",
 		Amount:      100.0,
 		CategoryId:  1,
+		Version:     3,
 		CreatedAt:   time.Now(),
 	}
 
@@ -59,11 +60,11 @@ func TestProductFindOneController_Handle_Success(t *testing.T) {
 		},
 
 		CategoryRepository: &repository.MockCategoryRepo{
-			FindByIdFunc: func(id int) *entity.Category {
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
 				if id == 1 {
-					return &entity.Category{ID: 1, Name: "Category 1"}
+					return &model.Category{ID: 1, Name: "Category 1"}, nil
 				}
-				return nil
+				return nil, nil
 			},
 		},
 	}
@@ -75,6 +76,7 @@ func TestProductFindOneController_Handle_Success(t *testing.T) {
 	resp := ctrl.Handle(context.Background(), req)
 
 	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, `"3"`, resp.Headers["ETag"])
 }
 
 func TestProductFindOneController_Handle_Error(t *testing.T) {
@@ -94,3 +96,23 @@ func TestProductFindOneController_Handle_Error(t *testing.T) {
 
 	assert.NotEqual(t, 200, resp.Code)
 }
+
+func TestProductFindOneController_Handle_NotFound(t *testing.T) {
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+				return nil, dbrepository.ErrNotFound
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductFindOneRestController(container)
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod-404"},
+	}
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 404, resp.Code)
+	assert.Empty(t, resp.Headers["ETag"])
+}
@@ -3,11 +3,12 @@ package controller
 import (
 	"context"
 	"errors"
-	"strconv"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
@@ -24,8 +25,8 @@ func TestHandle_Success(t *testing.T) {
 
 	container := &container.Container{
 		ProductRepository: &repository.MockProductRepo{
-			FindByCategoryFunc: func(ctx context.Context, categoryId int) (*[]model.Product, error) {
-				if categoryId == 10 {
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+				if slices.Contains(query.CategoryIds, 10) {
 
 					return mockProducts, nil
 				}
@@ -35,11 +36,11 @@ func TestHandle_Success(t *testing.T) {
 		},
 
 		CategoryRepository: &repository.MockCategoryRepo{
-			FindByIdFunc: func(id int) *entity.Category {
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
 				if id == 10 {
-					return &entity.Category{ID: 10, Name: "Category 10"}
+					return &model.Category{ID: 10, Name: "Category 10"}, nil
 				}
-				return nil
+				return nil, nil
 			},
 		},
 	}
@@ -57,7 +58,7 @@ func TestHandle_InvalidCategoryId(t *testing.T) {
 
 	container := &container.Container{
 		ProductRepository: &repository.MockProductRepo{
-			FindByCategoryFunc: func(ctx context.Context, categoryId int) (*[]model.Product, error) {
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
 
 				return nil, nil
 			},
@@ -70,26 +71,22 @@ func TestHandle_InvalidCategoryId(t *testing.T) {
 	}
 	resp := ctrl.Handle(context.Background(), req)
 
-	assert.Equal(t, httpserver.HandleError(context.Background(), &strconv.NumError{
-		Func: "Atoi",
-		Num:  "abc",
-		Err:  errors.New("invalid syntax"),
-	}).Code, resp.Code)
+	assert.Equal(t, 400, resp.Code)
 }
 
 func TestHandle_ExecuteError(t *testing.T) {
 
 	container := &container.Container{
 		ProductRepository: &repository.MockProductRepo{
-			FindByCategoryFunc: func(ctx context.Context, categoryId int) (*[]model.Product, error) {
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
 
 				return nil, errors.New("record not found")
 			},
 		},
 
 		CategoryRepository: &repository.MockCategoryRepo{
-			FindByIdFunc: func(id int) *entity.Category {
-				return nil
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+				return nil, nil
 			},
 		},
 	}
@@ -103,3 +100,151 @@ func TestHandle_ExecuteError(t *testing.T) {
 	assert.Equal(t, 422, resp.Code)
 
 }
+
+func TestHandle_Paginated(t *testing.T) {
+
+	var received model.ProductQuery
+
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+				received = query
+				return &[]model.Product{{ID: "prod3", Name: "Product 3", CategoryId: 10}}, nil
+			},
+			CountFunc: func(ctx context.Context, query model.ProductQuery) (int64, error) {
+				return 5, nil
+			},
+		},
+
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductFindByCategoryRestController(container)
+
+	req := httpserver.Request{
+		Path:  "/api/v1/product",
+		Query: map[string]string{"categoryId": "10", "page": "2", "size": "2", "sort": "amount", "direction": "desc"},
+	}
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	assert.NotNil(t, received.AvailableAt, "unavailable products are hidden by default")
+	received.AvailableAt = nil
+	assert.Equal(t, model.ProductQuery{CategoryIds: []int{10}, Page: 2, Size: 2, Sort: "amount", Descending: true}, received)
+
+	output, ok := resp.Body.(dto.ProductContent)
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), output.TotalElements)
+	assert.Equal(t, 3, output.TotalPages)
+	assert.Equal(t, "/api/v1/product?categoryId=10&direction=desc&page=2&size=2&sort=amount", output.Links.Self)
+	assert.Equal(t, "/api/v1/product?categoryId=10&direction=desc&page=3&size=2&sort=amount", output.Links.Next)
+	assert.Equal(t, "/api/v1/product?categoryId=10&direction=desc&page=1&size=2&sort=amount", output.Links.Prev)
+}
+
+func TestHandle_InvalidPagination(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepoInterface{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductFindByCategoryRestController(container)
+
+	for _, query := range []map[string]string{
+		{"categoryId": "10", "page": "x"},
+		{"categoryId": "10", "size": "500"},
+		{"categoryId": "10", "sort": "price"},
+		{"categoryId": "10", "direction": "up"},
+	} {
+		resp := ctrl.Handle(context.Background(), httpserver.Request{Query: query})
+
+		assert.Equal(t, 400, resp.Code, query)
+	}
+}
+
+func TestHandle_AllCategories(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+				return &[]model.Product{
+					{ID: "prod1", Name: "X-Burger", CategoryId: 1},
+					{ID: "prod2", Name: "Suco", CategoryId: 3},
+				}, nil
+			},
+		},
+
+		CategoryRepository: &repository.MockCategoryRepo{
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
+				names := map[int]string{1: "Lanche", 3: "Bebida"}
+				return &model.Category{ID: id, Name: names[id]}, nil
+			},
+		},
+	}
+	ctrl := NewProductFindByCategoryRestController(container)
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Query: map[string]string{}})
+
+	assert.Equal(t, 200, resp.Code)
+	output, ok := resp.Body.(dto.ProductContent)
+	assert.True(t, ok)
+	assert.Len(t, output.Content, 2)
+	assert.Equal(t, "Lanche", output.Content[0].Category.Name)
+	assert.Equal(t, "Bebida", output.Content[1].Category.Name)
+}
+
+func TestHandle_CombinedFilters(t *testing.T) {
+
+	var received model.ProductQuery
+
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+				received = query
+				return &[]model.Product{}, nil
+			},
+		},
+
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductFindByCategoryRestController(container)
+
+	req := httpserver.Request{
+		Query: map[string]string{
+			"categoryId":   "1,3",
+			"minAmount":    "5",
+			"maxAmount":    "20.5",
+			"createdAfter": "2025-01-01T00:00:00Z",
+			"updatedAfter": "2025-02-01T10:00:00-03:00",
+			"name":         "X-",
+		},
+	}
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, []int{1, 3}, received.CategoryIds)
+	assert.Equal(t, 5.0, *received.MinAmount)
+	assert.Equal(t, 20.5, *received.MaxAmount)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), received.CreatedAfter.UTC())
+	assert.Equal(t, time.Date(2025, 2, 1, 13, 0, 0, 0, time.UTC), received.UpdatedAfter.UTC())
+	assert.Equal(t, "X-", received.NamePrefix)
+}
+
+func TestHandle_InvalidFilters(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepoInterface{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductFindByCategoryRestController(container)
+
+	for _, query := range []map[string]string{
+		{"minAmount": "abc"},
+		{"minAmount": "10", "maxAmount": "5"},
+		{"maxAmount": "-1"},
+		{"createdAfter": "yesterday"},
+		{"categoryId": "1,x"},
+	} {
+		resp := ctrl.Handle(context.Background(), httpserver.Request{Query: query})
+
+		assert.Equal(t, 400, resp.Code, query)
+	}
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductRestoreController struct {
+	controller *ctl.RestoreProductController
+}
+
+func NewProductRestoreRestController(container *container.Container) httpserver.IController {
+	return &ProductRestoreController{
+		controller: ctl.NewRestoreProductController(container),
+	}
+}
+
+func (controller *ProductRestoreController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	product_id := request.ParseParamString("productId")
+
+	product, err := controller.controller.Execute(ctx, product_id)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(product).WithHeader("ETag", httpserver.ETag(product.Version))
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestProductRestoreController_Handle_Success(t *testing.T) {
+	var restored string
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			RestoreFunc: func(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+				restored = id
+				return &model.Product{ID: id, CategoryId: 1, Version: 4}, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductRestoreRestController(container)
+
+	req := httpserver.Request{Params: map[string]string{"productId": "prod1"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "prod1", restored)
+	assert.Equal(t, `"4"`, resp.Headers["ETag"])
+}
+
+func TestProductRestoreController_Handle_NotInTrash(t *testing.T) {
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepo{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductRestoreRestController(container)
+
+	req := httpserver.Request{Params: map[string]string{"productId": "prod1"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 404, resp.Code)
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductSearchController struct {
+	controller *ctl.SearchProductController
+}
+
+func NewProductSearchRestController(container *container.Container) httpserver.IController {
+	return &ProductSearchController{
+		controller: ctl.NewSearchProductController(container),
+	}
+}
+
+func (controller *ProductSearchController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.ProductSearch{
+		Query: strings.TrimSpace(request.ParseQuery("q")),
+		Limit: defaultPageSize,
+	}
+
+	if value, ok := request.Query["size"]; ok {
+		size, err := strconv.Atoi(value)
+		if err != nil {
+			return httpserver.HandleError(ctx, xerrors.NewValidationError("Invalid Query").
+				AddField("size", xerrors.ReasonTypeInvalidValue))
+		}
+		command.Limit = size
+	}
+
+	hide, err := parseHideUnavailable(request)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+	command.HideUnavailable = hide
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	result, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(result)
+}
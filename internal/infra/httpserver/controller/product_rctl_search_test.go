@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestProductSearchRestController_Handle_Success(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			SearchFunc: func(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+				return &[]model.ProductMatch{
+					{Product: model.Product{ID: "prod1", Name: "Suco de Maçã", CategoryId: 3}, Score: 7},
+				}, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductSearchRestController(container)
+
+	req := httpserver.Request{Query: map[string]string{"q": "MACA"}}
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	output, ok := resp.Body.(dto.ProductSearchContent)
+	assert.True(t, ok)
+	assert.Len(t, output.Content, 1)
+	assert.Equal(t, "Suco de <em>Maçã</em>", output.Content[0].Highlights["name"])
+}
+
+func TestProductSearchRestController_Handle_MissingQuery(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepoInterface{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductSearchRestController(container)
+
+	for _, query := range []map[string]string{
+		{},
+		{"q": "   "},
+		{"q": "pao", "size": "x"},
+		{"q": "pao", "size": "1000"},
+	} {
+		resp := ctrl.Handle(context.Background(), httpserver.Request{Query: query})
+
+		assert.Equal(t, 400, resp.Code, query)
+	}
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+// ProductStockChangeController serves the increment, decrement, reserve and release of the stock,
+// which only differ in the units they change and in which direction.
+type ProductStockChangeController struct {
+	controller *ctl.ChangeProductStockController
+	sign       int
+	reserve    bool
+}
+
+func NewProductStockIncrementRestController(container *container.Container) httpserver.IController {
+	return &ProductStockChangeController{controller: ctl.NewChangeProductStockController(container), sign: 1}
+}
+
+func NewProductStockDecrementRestController(container *container.Container) httpserver.IController {
+	return &ProductStockChangeController{controller: ctl.NewChangeProductStockController(container), sign: -1}
+}
+
+func NewProductStockReserveRestController(container *container.Container) httpserver.IController {
+	return &ProductStockChangeController{controller: ctl.NewChangeProductStockController(container), sign: 1, reserve: true}
+}
+
+func NewProductStockReleaseRestController(container *container.Container) httpserver.IController {
+	return &ProductStockChangeController{controller: ctl.NewChangeProductStockController(container), sign: -1, reserve: true}
+}
+
+// Handle answers with the stock after the change, or 422 when there are not enough units.
+func (controller *ProductStockChangeController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	quantity := dto.StockQuantity{}
+
+	err := request.ParseBody(ctx, &quantity)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(quantity)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	stock, err := controller.controller.Execute(ctx, dto.ChangeProductStock{
+		ProductId: request.ParseParamString("productId"),
+		Delta:     controller.sign * quantity.Quantity,
+		Reserve:   controller.reserve,
+	})
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(stock)
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductStockDeleteController struct {
+	controller *ctl.DeleteProductStockController
+}
+
+func NewProductStockDeleteRestController(container *container.Container) httpserver.IController {
+	return &ProductStockDeleteController{
+		controller: ctl.NewDeleteProductStockController(container),
+	}
+}
+
+// Handle stops tracking the stock, the product is available again whatever was left.
+func (controller *ProductStockDeleteController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	err := controller.controller.Execute(ctx, request.ParseParamString("productId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.NoContent()
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductStockFindController struct {
+	controller *ctl.FindProductStockController
+}
+
+func NewProductStockFindRestController(container *container.Container) httpserver.IController {
+	return &ProductStockFindController{
+		controller: ctl.NewFindProductStockController(container),
+	}
+}
+
+func (controller *ProductStockFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	stock, err := controller.controller.Execute(ctx, request.ParseParamString("productId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(stock)
+}
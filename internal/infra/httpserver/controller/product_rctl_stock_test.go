@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+func TestProductStockChangeController_Handle_InvalidQuantity(t *testing.T) {
+	for _, body := range []string{`{"quantity":0}`, `{"quantity":-2}`, `{}`} {
+		ctrl := NewProductStockDecrementRestController(&container.Container{})
+
+		req := httpserver.Request{
+			Params: map[string]string{"productId": "prod1"},
+			Body:   []byte(body),
+		}
+
+		resp := ctrl.Handle(context.Background(), req)
+
+		assert.Equal(t, 400, resp.Code, body)
+	}
+}
+
+func TestProductStockSaveController_Handle_MissingOnHand(t *testing.T) {
+	ctrl := NewProductStockSaveRestController(&container.Container{})
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod1"},
+		Body:   []byte(`{"lowStockThreshold":2}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 400, resp.Code)
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductStockSaveController struct {
+	controller *ctl.SaveProductStockController
+}
+
+func NewProductStockSaveRestController(container *container.Container) httpserver.IController {
+	return &ProductStockSaveController{
+		controller: ctl.NewSaveProductStockController(container),
+	}
+}
+
+// Handle sets the units on hand and the low stock threshold, tracking the stock from then on.
+func (controller *ProductStockSaveController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	document := dto.ProductStockDocument{}
+
+	err := request.ParseBody(ctx, &document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	stock, err := controller.controller.Execute(ctx, dto.SaveProductStock{
+		ProductId:            request.ParseParamString("productId"),
+		ProductStockDocument: document,
+	})
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(stock)
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductTrashController struct {
+	controller *ctl.FindProductController
+}
+
+func NewProductTrashRestController(container *container.Container) httpserver.IController {
+	return &ProductTrashController{
+		controller: ctl.NewFindProductController(container),
+	}
+}
+
+// Handle lists the deleted products that were not purged yet, accepting the same filters as the listing.
+func (controller *ProductTrashController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.ProductQuery{Trashed: true}
+
+	err := parseProductFilter(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = parsePageQuery(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	product, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	product.Links = buildPageLinks(request, product.Page, product.TotalPages)
+
+	return httpserver.Ok(product)
+}
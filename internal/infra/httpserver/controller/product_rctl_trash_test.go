@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func TestProductTrashController_Handle(t *testing.T) {
+	deletedAt := time.Now().UTC()
+	var received model.ProductQuery
+
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindFunc: func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+				received = query
+				return &[]model.Product{{ID: "prod1", CategoryId: 1, DeletedAt: &deletedAt}}, nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductTrashRestController(container)
+
+	req := httpserver.Request{
+		Path:  "/api/v1/product/trash",
+		Query: map[string]string{"size": "10"},
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 200, resp.Code)
+	assert.True(t, received.Trashed)
+	assert.Equal(t, 10, received.Size)
+
+	content, ok := resp.Body.(dto.ProductContent)
+	assert.True(t, ok)
+	assert.Len(t, content.Content, 1)
+	assert.Equal(t, &deletedAt, content.Content[0].DeletedAt)
+	assert.Contains(t, content.Links.Self, "/api/v1/product/trash")
+}
+
+func TestProductTrashController_Handle_InvalidPage(t *testing.T) {
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepoInterface{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductTrashRestController(container)
+
+	req := httpserver.Request{Query: map[string]string{"page": "0"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 400, resp.Code)
+}
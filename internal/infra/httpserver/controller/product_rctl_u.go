@@ -9,20 +9,13 @@ import (
 	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
 )
 
 type ProductUpdateController struct {
 	controller *ctl.UpdateProductController
 }
 
-type ProductUpdateRequest struct {
-	ProductId   string  `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	CategoryId  int     `json:"categoryId"`
-	Amount      float64 `json:"amount"`
-}
-
 type ProductUpdateResponse struct {
 	ProductId   string           `json:"id"`
 	Name        string           `json:"name"`
@@ -44,9 +37,11 @@ func NewProductUpdateByIdController(container *container.Container) httpserver.I
 	}
 }
 
+// Handle replaces the whole product, so every required attribute must be sent.
+// An If-Match header makes the replacement conditional on the product version.
 func (controller *ProductUpdateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
 
-	productRequest := ProductUpdateRequest{}
+	productRequest := dto.ProductDocument{}
 
 	product_id := request.ParseParamString("productId")
 
@@ -55,23 +50,34 @@ func (controller *ProductUpdateController) Handle(ctx context.Context, request h
 		return httpserver.HandleError(ctx, errBody)
 	}
 
-	command := productRequest.toCommand(product_id)
+	err := validator.Validate(productRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
 
-	product, err := controller.controller.Execute(ctx, command)
+	version, err := request.ParseIfMatch()
 	if err != nil {
 		return httpserver.HandleError(ctx, err)
 	}
 
-	return httpserver.Ok(product)
+	command := toUpdateCommand(product_id, productRequest)
+	command.Version = version
+
+	product, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, version)
+	}
+
+	return httpserver.Ok(product).WithHeader("ETag", httpserver.ETag(product.Version))
 }
 
-func (request *ProductUpdateRequest) toCommand(productId string) dto.UpdateProduct {
+func toUpdateCommand(productId string, document dto.ProductDocument) dto.UpdateProduct {
 	return dto.UpdateProduct{
 		ProductId:   productId,
-		Name:        request.Name,
-		Description: request.Description,
-		CategoryId:  request.CategoryId,
-		Amount:      request.Amount,
+		Name:        document.Name,
+		Description: document.Description,
+		CategoryId:  document.CategoryId,
+		Amount:      *document.Amount,
 	}
 }
 
@@ -8,10 +8,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
 	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/dto"
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
 	"github.com/tbtec/tremligeiro/test/repository"
 )
@@ -65,21 +66,21 @@ func TestProductUpdateController_Handle_Success(t *testing.T) {
 		},
 
 		CategoryRepository: &repository.MockCategoryRepo{
-			FindByIdFunc: func(id int) *entity.Category {
+			FindByIdFunc: func(ctx context.Context, id int) (*model.Category, error) {
 				if id == 1 {
-					return &entity.Category{ID: 1, Name: "Category 1"}
+					return &model.Category{ID: 1, Name: "Category 1"}, nil
 				}
-				return nil
+				return nil, nil
 			},
 		},
 	}
 	ctrl := NewProductUpdateByIdController(container)
 
-	input := ProductUpdateRequest{
+	input := dto.ProductDocument{
 		Name:        "Produto Teste",
 		Description: "Descrição",
 		CategoryId:  1,
-		Amount:      10.0,
+		Amount:      amountOf(10.0),
 	}
 	inputBytes, _ := json.Marshal(input)
 	req := httpserver.Request{
@@ -102,14 +103,16 @@ func TestProductUpdateController_Handle_ExecuteError(t *testing.T) {
 				return nil, errors.New("not found")
 			},
 		},
+
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
 	}
 	ctrl := NewProductUpdateByIdController(container)
 
-	input := ProductUpdateRequest{
+	input := dto.ProductDocument{
 		Name:        "Produto Teste",
 		Description: "Descrição",
 		CategoryId:  1,
-		Amount:      10.0,
+		Amount:      amountOf(10.0),
 	}
 	inputBytes, _ := json.Marshal(input)
 	req := httpserver.Request{
@@ -125,3 +128,126 @@ func TestProductUpdateController_Handle_ExecuteError(t *testing.T) {
 	assert.True(t, ok)
 	assert.Contains(t, errMsg.Error.Description, "Internal Server Error")
 }
+
+func TestProductUpdateController_Handle_MissingAmount(t *testing.T) {
+
+	container := &container.Container{
+		ProductRepository:  &repository.MockProductRepoInterface{},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductUpdateByIdController(container)
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod1"},
+		Body:   []byte(`{"name":"Produto Teste","categoryId":1}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 400, resp.Code)
+}
+
+func amountOf(amount float64) *float64 {
+	return &amount
+}
+
+func TestProductUpdateController_Handle_IfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		code    int
+		etag    string
+	}{
+		{"no precondition", "", 200, `"3"`},
+		{"any version", "*", 200, `"3"`},
+		{"current version", `"2"`, 200, `"3"`},
+		{"stale version", `"1"`, 412, ""},
+		{"weak tag", `W/"2"`, 412, ""},
+		{"malformed tag", "2", 412, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := &container.Container{
+				ProductRepository: &repository.MockProductRepo{
+					FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+						return &model.Product{ID: id, Name: "Suco", CategoryId: 1, Amount: 8.5, Version: 2}, nil
+					},
+					UpdateByIdFunc: func(ctx context.Context, product *model.Product) error {
+						product.Version++
+						return nil
+					},
+				},
+				CategoryRepository: &repository.MockCategoryRepoInterface{},
+			}
+			ctrl := NewProductUpdateByIdController(container)
+
+			req := httpserver.Request{
+				Params:  map[string]string{"productId": "prod1"},
+				Headers: map[string]string{},
+				Body:    []byte(`{"name":"Suco","categoryId":1,"amount":9}`),
+			}
+			if tt.ifMatch != "" {
+				req.Headers["if-match"] = tt.ifMatch
+			}
+
+			resp := ctrl.Handle(context.Background(), req)
+
+			assert.Equal(t, tt.code, resp.Code)
+			assert.Equal(t, tt.etag, resp.Headers["ETag"])
+		})
+	}
+}
+
+func TestProductUpdateController_Handle_ConcurrentWrite(t *testing.T) {
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+				return &model.Product{ID: id, Name: "Suco", CategoryId: 1, Amount: 8.5, Version: 2}, nil
+			},
+			UpdateByIdFunc: func(ctx context.Context, product *model.Product) error {
+				return dbrepository.ErrVersionConflict
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductUpdateByIdController(container)
+
+	req := httpserver.Request{
+		Params:  map[string]string{"productId": "prod1"},
+		Headers: map[string]string{},
+		Body:    []byte(`{"name":"Suco","categoryId":1,"amount":9}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 409, resp.Code, "no precondition was sent")
+	assert.Equal(t, httpserver.NewErrorMessage("TL-PRODUCT-003", "Product was changed by another request"), resp.Body)
+
+	req.Headers["if-match"] = `"2"`
+	resp = ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 412, resp.Code)
+	assert.Equal(t, httpserver.NewErrorMessage("TL-PRODUCT-003", "Product was changed by another request"), resp.Body)
+}
+
+func TestProductUpdateController_Handle_NotFound(t *testing.T) {
+	container := &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+				return nil, dbrepository.ErrNotFound
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+	ctrl := NewProductUpdateByIdController(container)
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod-404"},
+		Body:   []byte(`{"name":"Suco","categoryId":1,"amount":9}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 404, resp.Code)
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductUnavailableController struct {
+	controller *ctl.FindProductController
+}
+
+func NewProductUnavailableRestController(container *container.Container) httpserver.IController {
+	return &ProductUnavailableController{
+		controller: ctl.NewFindProductController(container),
+	}
+}
+
+// Handle lists the products currently marked unavailable, accepting the same filters as the listing.
+// The sold out products are not listed unless they were marked unavailable too.
+func (controller *ProductUnavailableController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.ProductQuery{Unavailable: true}
+
+	err := parseProductFilter(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = parsePageQuery(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	product, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	product.Links = buildPageLinks(request, product.Page, product.TotalPages)
+
+	return httpserver.Ok(product)
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductVariantCreateController struct {
+	controller *ctl.CreateProductVariantController
+}
+
+func NewProductVariantCreateRestController(container *container.Container) httpserver.IController {
+	return &ProductVariantCreateController{
+		controller: ctl.NewCreateProductVariantController(container),
+	}
+}
+
+// Handle adds the variant and answers 201 with the product, an If-Match header makes it
+// conditional on the product version.
+func (controller *ProductVariantCreateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	document := dto.ProductVariantDocument{}
+
+	err := request.ParseBody(ctx, &document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	version, err := request.ParseIfMatch()
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	product, err := controller.controller.Execute(ctx, dto.CreateProductVariant{
+		ProductId: request.ParseParamString("productId"),
+		Sku:       document.Sku,
+		Label:     document.Label,
+		Amount:    *document.Amount,
+		Version:   version,
+	})
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, version)
+	}
+
+	return httpserver.Created(product).WithHeader("ETag", httpserver.ETag(product.Version))
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductVariantDeleteController struct {
+	controller *ctl.DeleteProductVariantController
+}
+
+func NewProductVariantDeleteRestController(container *container.Container) httpserver.IController {
+	return &ProductVariantDeleteController{
+		controller: ctl.NewDeleteProductVariantController(container),
+	}
+}
+
+// Handle deletes the variant or, on the variants collection, every variant of the product,
+// which disables them.
+func (controller *ProductVariantDeleteController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	version, err := request.ParseIfMatch()
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = controller.controller.Execute(ctx, dto.DeleteProductVariant{
+		ProductId: request.ParseParamString("productId"),
+		VariantId: request.ParseParamString("variantId"),
+		Version:   version,
+	})
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, version)
+	}
+
+	return httpserver.NoContent()
+}
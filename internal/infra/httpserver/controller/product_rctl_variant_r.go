@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductVariantFindController struct {
+	controller *ctl.FindProductVariantController
+}
+
+func NewProductVariantFindRestController(container *container.Container) httpserver.IController {
+	return &ProductVariantFindController{
+		controller: ctl.NewFindProductVariantController(container),
+	}
+}
+
+func (controller *ProductVariantFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	variants, err := controller.controller.Execute(ctx, request.ParseParamString("productId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(variants)
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ProductVariantFindOneController struct {
+	controller *ctl.FindOneProductVariantController
+}
+
+func NewProductVariantFindOneRestController(container *container.Container) httpserver.IController {
+	return &ProductVariantFindOneController{
+		controller: ctl.NewFindOneProductVariantController(container),
+	}
+}
+
+func (controller *ProductVariantFindOneController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	variant, err := controller.controller.Execute(ctx,
+		request.ParseParamString("productId"), request.ParseParamString("variantId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(variant)
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/test/repository"
+)
+
+func newVariantContainer(product *model.Product) *container.Container {
+	return &container.Container{
+		ProductRepository: &repository.MockProductRepo{
+			FindOneFunc: func(ctx context.Context, id string) (*model.Product, error) {
+				return product, nil
+			},
+			UpdateByIdFunc: func(ctx context.Context, updated *model.Product) error {
+				updated.Version++
+				*product = *updated
+				return nil
+			},
+		},
+		CategoryRepository: &repository.MockCategoryRepoInterface{},
+	}
+}
+
+func TestProductVariantCreateController_Handle_Success(t *testing.T) {
+	product := &model.Product{ID: "prod1", CategoryId: 1, Version: 3}
+	ctrl := NewProductVariantCreateRestController(newVariantContainer(product))
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod1"},
+		Body:   []byte(`{"sku":"REF-P","label":"P","amount":6.5}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 201, resp.Code)
+	assert.Equal(t, `"4"`, resp.Headers["ETag"])
+	body, ok := resp.Body.(dto.Product)
+	require.True(t, ok)
+	assert.True(t, body.VariantsEnabled)
+	require.Len(t, body.Variants, 1)
+	assert.Equal(t, 6.5, body.Variants[0].Amount)
+}
+
+func TestProductVariantCreateController_Handle_InvalidBody(t *testing.T) {
+	product := &model.Product{ID: "prod1", CategoryId: 1}
+	ctrl := NewProductVariantCreateRestController(newVariantContainer(product))
+
+	req := httpserver.Request{
+		Params: map[string]string{"productId": "prod1"},
+		Body:   []byte(`{"label":"P","amount":-1}`),
+	}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 400, resp.Code)
+}
+
+func TestProductVariantDeleteController_Handle_LastVariant(t *testing.T) {
+	product := &model.Product{ID: "prod1", CategoryId: 1, VariantsEnabled: true,
+		Variants: model.ProductVariants{{ID: "var1", Sku: "REF-P", Label: "P", Amount: 6}}}
+	ctrl := NewProductVariantDeleteRestController(newVariantContainer(product))
+
+	req := httpserver.Request{Params: map[string]string{"productId": "prod1", "variantId": "var1"}}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 422, resp.Code)
+	assert.Len(t, product.Variants, 1)
+}
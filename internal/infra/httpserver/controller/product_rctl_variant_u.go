@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ProductVariantUpdateController struct {
+	controller *ctl.UpdateProductVariantController
+}
+
+func NewProductVariantUpdateRestController(container *container.Container) httpserver.IController {
+	return &ProductVariantUpdateController{
+		controller: ctl.NewUpdateProductVariantController(container),
+	}
+}
+
+// Handle replaces the whole variant and answers with the product.
+func (controller *ProductVariantUpdateController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	document := dto.ProductVariantDocument{}
+
+	err := request.ParseBody(ctx, &document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(document)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	version, err := request.ParseIfMatch()
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	product, err := controller.controller.Execute(ctx, dto.UpdateProductVariant{
+		ProductId: request.ParseParamString("productId"),
+		VariantId: request.ParseParamString("variantId"),
+		Sku:       document.Sku,
+		Label:     document.Label,
+		Amount:    *document.Amount,
+		Version:   version,
+	})
+	if err != nil {
+		return httpserver.HandleConditionalError(ctx, err, version)
+	}
+
+	return httpserver.Ok(product).WithHeader("ETag", httpserver.ETag(product.Version))
+}
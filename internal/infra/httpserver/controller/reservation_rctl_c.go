@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type ReservationCreateRestController struct {
+	controller *ctl.CreateReservationController
+}
+
+func NewReservationCreateRestController(container *container.Container) httpserver.IController {
+	return &ReservationCreateRestController{
+		controller: ctl.NewCreateReservationController(container),
+	}
+}
+
+// Handle answers 422 when any product is short of stock, nothing is reserved then.
+func (controller *ReservationCreateRestController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	reservationRequest := dto.CreateReservation{}
+
+	err := request.ParseBody(ctx, &reservationRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	err = validator.Validate(reservationRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	reservation, err := controller.controller.Execute(ctx, reservationRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Created(reservation)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ReservationConfirmController struct {
+	controller *ctl.ConfirmReservationController
+}
+
+func NewReservationConfirmRestController(container *container.Container) httpserver.IController {
+	return &ReservationConfirmController{
+		controller: ctl.NewConfirmReservationController(container),
+	}
+}
+
+func (controller *ReservationConfirmController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	reservation, err := controller.controller.Execute(ctx, request.ParseParamString("reservationId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(reservation)
+}
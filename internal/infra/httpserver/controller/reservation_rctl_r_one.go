@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type ReservationFindController struct {
+	controller *ctl.FindOneReservationController
+}
+
+func NewReservationFindRestController(container *container.Container) httpserver.IController {
+	return &ReservationFindController{
+		controller: ctl.NewFindOneReservationController(container),
+	}
+}
+
+func (controller *ReservationFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	reservation, err := controller.controller.Execute(ctx, request.ParseParamString("reservationId"))
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(reservation)
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type WebhookCreateRestController struct {
+	controller *ctl.CreateWebhookController
+}
+
+func NewWebhookCreateRestController(container *container.Container) httpserver.IController {
+	return &WebhookCreateRestController{
+		controller: ctl.NewCreateWebhookController(container),
+	}
+}
+
+func (ctl *WebhookCreateRestController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	webhookRequest := dto.CreateWebhook{}
+
+	errBody := request.ParseBody(ctx, &webhookRequest)
+	if errBody != nil {
+		return httpserver.HandleError(ctx, errBody)
+	}
+
+	err := validator.Validate(webhookRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	output, err := ctl.controller.Execute(ctx, webhookRequest)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Created(output)
+}
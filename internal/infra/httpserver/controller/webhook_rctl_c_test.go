@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+func TestWebhookCreateRestController_Handle(t *testing.T) {
+	container := &container.Container{
+		WebhookRepository: dbrepository.NewWebhookMemoryRepository(),
+	}
+	ctrl := NewWebhookCreateRestController(container)
+
+	req := httpserver.Request{Body: []byte(`{"url":"https://kiosk.example.com/hooks","eventTypes":["ProductPriceChanged"],"secret":"kiosk-secret-0001"}`)}
+
+	resp := ctrl.Handle(context.Background(), req)
+
+	assert.Equal(t, 201, resp.Code)
+	output, ok := resp.Body.(dto.Webhook)
+	assert.True(t, ok)
+	assert.Equal(t, "https://kiosk.example.com/hooks", output.Url)
+	assert.Equal(t, []string{"ProductPriceChanged"}, output.EventTypes)
+}
+
+func TestWebhookCreateRestController_Handle_Invalid(t *testing.T) {
+	container := &container.Container{
+		WebhookRepository: dbrepository.NewWebhookMemoryRepository(),
+	}
+	ctrl := NewWebhookCreateRestController(container)
+
+	tests := map[string]string{
+		"url":          `{"url":"ftp://kiosk.example.com","eventTypes":["ProductCreated"],"secret":"kiosk-secret-0001"}`,
+		"no events":    `{"url":"https://kiosk.example.com","eventTypes":[],"secret":"kiosk-secret-0001"}`,
+		"unknown":      `{"url":"https://kiosk.example.com","eventTypes":["ProductSold"],"secret":"kiosk-secret-0001"}`,
+		"short secret": `{"url":"https://kiosk.example.com","eventTypes":["ProductCreated"],"secret":"short"}`,
+	}
+
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := ctrl.Handle(context.Background(), httpserver.Request{Body: []byte(body)})
+			assert.Equal(t, 400, resp.Code)
+		})
+	}
+}
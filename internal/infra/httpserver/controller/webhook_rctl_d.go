@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type WebhookDeleteController struct {
+	controller *ctl.DeleteWebhookController
+}
+
+func NewWebhookDeleteByIdRestController(container *container.Container) httpserver.IController {
+	return &WebhookDeleteController{
+		controller: ctl.NewDeleteWebhookController(container),
+	}
+}
+
+func (controller *WebhookDeleteController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	webhookId := request.ParseParamString("webhookId")
+
+	err := controller.controller.Execute(ctx, webhookId)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.NoContent()
+}
@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+func TestWebhookDeleteController_Handle(t *testing.T) {
+	webhookRepo := dbrepository.NewWebhookMemoryRepository()
+	_ = webhookRepo.Create(context.Background(), &model.Webhook{ID: "kiosk", Url: "https://kiosk.example.com"})
+	ctrl := NewWebhookDeleteByIdRestController(&container.Container{WebhookRepository: webhookRepo})
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Params: map[string]string{"webhookId": "kiosk"}})
+	assert.Equal(t, 204, resp.Code)
+
+	resp = ctrl.Handle(context.Background(), httpserver.Request{Params: map[string]string{"webhookId": "kiosk"}})
+	assert.Equal(t, 404, resp.Code)
+}
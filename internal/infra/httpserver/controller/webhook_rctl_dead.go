@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type WebhookDeadLetterController struct {
+	controller *ctl.FindDeadWebhookDeliveriesController
+}
+
+func NewWebhookDeadLetterRestController(container *container.Container) httpserver.IController {
+	return &WebhookDeadLetterController{
+		controller: ctl.NewFindDeadWebhookDeliveriesController(container),
+	}
+}
+
+// Handle lists the deliveries given up after exhausting their attempts, the latest first.
+func (controller *WebhookDeadLetterController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.WebhookDeliveryQuery{}
+
+	err := parseDeliveryQuery(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	deliveries, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(deliveries)
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+	"github.com/tbtec/tremligeiro/internal/validator"
+)
+
+type WebhookDeliveriesController struct {
+	controller *ctl.FindWebhookDeliveriesController
+}
+
+func NewWebhookDeliveriesRestController(container *container.Container) httpserver.IController {
+	return &WebhookDeliveriesController{
+		controller: ctl.NewFindWebhookDeliveriesController(container),
+	}
+}
+
+// Handle returns the delivery log of the webhook, its latest deliveries first.
+func (controller *WebhookDeliveriesController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	command := dto.WebhookDeliveryQuery{WebhookId: request.ParseParamString("webhookId")}
+
+	err := parseDeliveryQuery(request, &command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	deliveries, err := controller.controller.Execute(ctx, command)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(deliveries)
+}
+
+// parseDeliveryQuery reads how many deliveries to return from the size parameter, validating the query.
+func parseDeliveryQuery(request httpserver.Request, query *dto.WebhookDeliveryQuery) error {
+	query.Size = defaultPageSize
+
+	if value, ok := request.Query["size"]; ok {
+		size, err := strconv.Atoi(value)
+		if err != nil {
+			return xerrors.NewValidationError("Invalid Query").AddField("size", xerrors.ReasonTypeInvalidValue)
+		}
+		query.Size = size
+	}
+
+	return validator.Validate(*query)
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	dbrepository "github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+func newWebhookDeliveriesContainer(t *testing.T) *container.Container {
+	ctx := context.Background()
+	webhookRepo := dbrepository.NewWebhookMemoryRepository()
+	require.NoError(t, webhookRepo.Create(ctx, &model.Webhook{ID: "kiosk", Url: "https://kiosk.example.com"}))
+
+	now := time.Now().UTC()
+	require.NoError(t, webhookRepo.AddDeliveries(ctx,
+		model.WebhookDelivery{ID: "kiosk.1", WebhookId: "kiosk", EventId: "1", Payload: `{}`, Status: "DELIVERED", CreatedAt: now.Add(-time.Minute)},
+		model.WebhookDelivery{ID: "kiosk.2", WebhookId: "kiosk", EventId: "2", Payload: `{}`, Status: "DEAD", CreatedAt: now},
+	))
+
+	return &container.Container{WebhookRepository: webhookRepo}
+}
+
+func TestWebhookDeliveriesController_Handle(t *testing.T) {
+	ctrl := NewWebhookDeliveriesRestController(newWebhookDeliveriesContainer(t))
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Params: map[string]string{"webhookId": "kiosk"}})
+
+	assert.Equal(t, 200, resp.Code)
+	output, ok := resp.Body.(dto.WebhookDeliveryContent)
+	require.True(t, ok)
+	require.Len(t, output.Content, 2)
+	assert.Equal(t, "kiosk.2", output.Content[0].ID)
+	assert.Equal(t, "kiosk.1", output.Content[1].ID)
+}
+
+func TestWebhookDeliveriesController_Handle_Errors(t *testing.T) {
+	ctrl := NewWebhookDeliveriesRestController(newWebhookDeliveriesContainer(t))
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Params: map[string]string{"webhookId": "missing"}})
+	assert.Equal(t, 404, resp.Code)
+
+	resp = ctrl.Handle(context.Background(), httpserver.Request{
+		Params: map[string]string{"webhookId": "kiosk"},
+		Query:  map[string]string{"size": "1000"},
+	})
+	assert.Equal(t, 400, resp.Code)
+}
+
+func TestWebhookDeadLetterController_Handle(t *testing.T) {
+	ctrl := NewWebhookDeadLetterRestController(newWebhookDeliveriesContainer(t))
+
+	resp := ctrl.Handle(context.Background(), httpserver.Request{Query: map[string]string{"size": "5"}})
+
+	assert.Equal(t, 200, resp.Code)
+	output, ok := resp.Body.(dto.WebhookDeliveryContent)
+	require.True(t, ok)
+	require.Len(t, output.Content, 1)
+	assert.Equal(t, "kiosk.2", output.Content[0].ID)
+	assert.Equal(t, "DEAD", output.Content[0].Status)
+}
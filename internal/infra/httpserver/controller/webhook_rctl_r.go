@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+
+	ctl "github.com/tbtec/tremligeiro/internal/core/controller"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/httpserver"
+)
+
+type WebhookFindController struct {
+	controller *ctl.FindWebhookController
+}
+
+func NewWebhookFindRestController(container *container.Container) httpserver.IController {
+	return &WebhookFindController{
+		controller: ctl.NewFindWebhookController(container),
+	}
+}
+
+func (controller *WebhookFindController) Handle(ctx context.Context, request httpserver.Request) httpserver.Response {
+
+	webhooks, err := controller.controller.Execute(ctx)
+	if err != nil {
+		return httpserver.HandleError(ctx, err)
+	}
+
+	return httpserver.Ok(webhooks)
+}
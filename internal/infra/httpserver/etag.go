@@ -0,0 +1,37 @@
+package httpserver
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tbtec/tremligeiro/internal/types/xerrors"
+)
+
+var ErrPreconditionFailed = xerrors.NewPreconditionFailedError("412", "If-Match does not match the current version")
+
+// ETag renders a resource version as a strong entity tag.
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseIfMatch returns the version required by the If-Match header, zero when
+// the header is absent or "*". If-Match uses strong comparison, so weak tags and
+// tags this server could not have issued never match. Versions start at one, the
+// products written before versioning are read as version one.
+func (req Request) ParseIfMatch() (int64, error) {
+	value := strings.TrimSpace(req.ParseHeader("if-match"))
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return 0, ErrPreconditionFailed
+	}
+
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+	if err != nil || version < 1 {
+		return 0, ErrPreconditionFailed
+	}
+
+	return version, nil
+}
@@ -20,7 +20,20 @@ func HandleError(ctx context.Context, err error) Response {
 		return UnprocessableEntity(NewErrorMessage(codError.Code, codError.Description))
 	case xerrors.NotFoundError:
 		return NotFound(NewErrorMessage("404", codError.Description))
+	case xerrors.ConflictError:
+		return Conflict(NewErrorMessage(codError.Code, codError.Description))
+	case xerrors.PreconditionFailedError:
+		return PreconditionFailed(NewErrorMessage(codError.Code, codError.Description))
 	default:
 		return InternalServerError(NewErrorMessage("500", "Internal Server Error"))
 	}
 }
+
+// HandleConditionalError converts the error of a write made conditional by If-Match,
+// a version other than zero, where a conflict means the precondition failed.
+func HandleConditionalError(ctx context.Context, err error, version int64) Response {
+	if codError, ok := err.(xerrors.ConflictError); ok && version != 0 {
+		return PreconditionFailed(NewErrorMessage(codError.Code, codError.Description))
+	}
+	return HandleError(ctx, err)
+}
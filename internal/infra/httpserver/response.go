@@ -1,5 +1,7 @@
 package httpserver
 
+import "io"
+
 // Response is the type tha represents a http response.
 type Response struct {
 	Code    int
@@ -7,6 +9,11 @@ type Response struct {
 	Headers map[string]string
 }
 
+// Stream is a body written straight to the connection after the headers, for bodies too
+// large to hold in memory. Once it starts the status can no longer change, so its errors
+// are only logged and cut the body short.
+type Stream func(w io.Writer) error
+
 // Ok returns a 200 response with the given body.
 func Ok(body any) Response {
 	return Response{200, body, nil}
@@ -42,6 +49,16 @@ func Conflict(body any) Response {
 	return Response{409, body, nil}
 }
 
+// PreconditionFailed returns a 412 with a body
+func PreconditionFailed(body any) Response {
+	return Response{412, body, nil}
+}
+
+// UnsupportedMediaType returns a 415 with a body
+func UnsupportedMediaType(body any) Response {
+	return Response{415, body, nil}
+}
+
 // UnprocessableEntity returns a 422 response with a body
 func UnprocessableEntity(body any) Response {
 	return Response{422, body, nil}
@@ -56,3 +73,14 @@ func InternalServerError(body any) Response {
 func ServiceUnavailable(body any) Response {
 	return Response{503, body, nil}
 }
+
+// WithHeader returns a copy of the response carrying the given header.
+func (r Response) WithHeader(name string, value string) Response {
+	headers := map[string]string{}
+	for k, v := range r.Headers {
+		headers[k] = v
+	}
+	headers[name] = value
+	r.Headers = headers
+	return r
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"bufio"
+	"fmt"
 	"log/slog"
 	"strings"
 
@@ -20,16 +22,39 @@ func adapt(ctrl httpserver.IController) func(c *fiber.Ctx) error {
 			Params:  getParams(ctx),
 			Query:   getQuery(ctx),
 		}
-		slog.Info("Request receveid:["+request.Host+request.Path+"]", slog.Any("request", string(request.Body)))
+		slog.Info("Request receveid:["+request.Host+request.Path+"]", slog.Any("request", loggedBody(request)))
 		response := ctrl.Handle(
 			ctx.UserContext(),
 			request)
 
+		for name, value := range response.Headers {
+			ctx.Set(name, value)
+		}
+
+		if stream, ok := response.Body.(httpserver.Stream); ok {
+			ctx.Status(response.Code)
+			ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+				if err := stream(w); err != nil {
+					slog.Error("Error streaming response:[" + request.Host + request.Path + "] " + err.Error())
+				}
+			})
+			return nil
+		}
+
 		return ctx.Status(response.Code).
 			JSON(response.Body)
 	}
 }
 
+// loggedBody leaves the binary bodies, as the images, out of the logs.
+func loggedBody(request httpserver.Request) string {
+	contentType := request.Headers["content-type"]
+	if strings.HasPrefix(contentType, "image/") || strings.HasPrefix(contentType, "multipart/") {
+		return fmt.Sprintf("<%d bytes>", len(request.Body))
+	}
+	return string(request.Body)
+}
+
 func getHeaders(headers map[string][]string) map[string]string {
 	newHeaders := map[string]string{}
 	for k, v := range headers {
@@ -51,10 +76,14 @@ func getQuery(c *fiber.Ctx) map[string]string {
 	values := map[string]string{}
 	args := c.Context().QueryArgs()
 
+	// repeated parameters are joined with commas, so ?a=1&a=2 reads as a=1,2
 	args.VisitAll(func(key, value []byte) {
 		k := string(key)
 		v := string(value)
 
+		if previous, ok := values[k]; ok {
+			v = previous + "," + v
+		}
 		values[k] = v
 	})
 
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+func TestServer_Menu(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			c := newTestContainer(t, driver)
+			c.MenuLocation = saoPaulo
+			c.Clock = clock.Fixed(time.Date(2026, 10, 19, 9, 30, 0, 0, saoPaulo))
+			server := New(c, c.Config)
+
+			burger := createProduct(t, server, `{"name":"X-Burger","description":"Pão, carne e queijo","categoryId":1,"amount":25}`)
+			cheeseBread := createProduct(t, server, `{"name":"Pão de queijo","description":"Porção com 6","categoryId":2,"amount":9}`)
+			coffee := createProduct(t, server, `{"name":"Café","description":"Expresso","categoryId":3,"amount":5}`)
+			sundae := createProduct(t, server, `{"name":"Sundae","description":"Sorvete com calda","categoryId":4,"amount":12}`)
+
+			for _, body := range []string{
+				`{"schedules":[{"weekdays":["monday"],"start":"06:00","end":"11:00"}]}`,
+				`{"name":"Café da manhã","schedules":[]}`,
+				`{"name":"Café da manhã","schedules":[{"weekdays":["funday"],"start":"06:00","end":"11:00"}]}`,
+				`{"name":"Café da manhã","schedules":[{"weekdays":["monday"],"start":"6h","end":"11:00"}]}`,
+				`{"name":"Café da manhã","schedules":[{"weekdays":["monday"],"start":"24:00","end":"11:00"}]}`,
+				`{"name":"Café da manhã","schedules":[{"weekdays":["monday"],"start":"11:00","end":"11:00"}]}`,
+			} {
+				response, content := call(t, server, http.MethodPost, "/api/v1/dayparts", body, nil)
+				assert.Equal(t, http.StatusBadRequest, response.StatusCode, body+" "+string(content))
+			}
+			response, _ := call(t, server, http.MethodPost, "/api/v1/dayparts",
+				`{"name":"Café da manhã","schedules":[{"weekdays":["monday"],"start":"06:00","end":"11:00"}],"productIds":["missing"]}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+			response, _ = call(t, server, http.MethodPost, "/api/v1/dayparts",
+				`{"name":"Almoço","schedules":[{"weekdays":["monday"],"start":"11:00","end":"15:00"}],"categoryIds":[99]}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			weekdays := `["monday","tuesday","wednesday","thursday","friday"]`
+			response, content := call(t, server, http.MethodPost, "/api/v1/dayparts",
+				fmt.Sprintf(`{"name":"Café da manhã","schedules":[{"weekdays":%s,"start":"06:00","end":"11:00"}],"productIds":[%q]}`,
+					weekdays, cheeseBread.ProductId), nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			breakfast := dto.Daypart{}
+			require.NoError(t, json.Unmarshal(content, &breakfast))
+			assert.NotEmpty(t, breakfast.DaypartId)
+			assert.Equal(t, "06:00", breakfast.Schedules[0].Start)
+			assert.Equal(t, "11:00", breakfast.Schedules[0].End)
+			assert.Len(t, breakfast.Schedules[0].Weekdays, 5)
+			assert.True(t, breakfast.CreatedAt.Equal(c.Clock.Now()), "created at the time of the clock")
+
+			response, content = call(t, server, http.MethodPost, "/api/v1/dayparts",
+				`{"name":"Almoço","schedules":[{"weekdays":["sunday","monday"],"start":"11:00","end":"24:00"}],"categoryIds":[1]}`, nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			lunch := dto.Daypart{}
+			require.NoError(t, json.Unmarshal(content, &lunch))
+
+			menu := func(path string) map[int][]string {
+				response, content := call(t, server, http.MethodGet, path, "", nil)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+				result := dto.Menu{}
+				require.NoError(t, json.Unmarshal(content, &result))
+				assert.Equal(t, "America/Sao_Paulo", result.TimeZone)
+				sections := map[int][]string{}
+				for _, section := range result.Sections {
+					for _, product := range section.Products {
+						sections[section.Category.ID] = append(sections[section.Category.ID], product.ProductId)
+					}
+				}
+				return sections
+			}
+
+			assert.Equal(t, map[int][]string{
+				2: {cheeseBread.ProductId},
+				3: {coffee.ProductId},
+				4: {sundae.ProductId},
+			}, menu("/api/v1/menu"), "breakfast time on the clock")
+			assert.Equal(t, map[int][]string{
+				1: {burger.ProductId},
+				3: {coffee.ProductId},
+				4: {sundae.ProductId},
+			}, menu("/api/v1/menu?at=2026-10-19T11:00:00-03:00"), "breakfast is over at 11:00")
+			assert.Equal(t, map[int][]string{
+				2: {cheeseBread.ProductId},
+				3: {coffee.ProductId},
+				4: {sundae.ProductId},
+			}, menu("/api/v1/menu?at=2026-10-19T13:59:00Z"), "read in the menu time zone")
+			assert.Equal(t, map[int][]string{
+				3: {coffee.ProductId},
+				4: {sundae.ProductId},
+			}, menu("/api/v1/menu?at=2026-10-24T09:30:00-03:00"), "no breakfast on saturdays")
+
+			response, _ = call(t, server, http.MethodGet, "/api/v1/menu?at=tomorrow", "", nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPut, "/api/v1/dayparts/"+breakfast.DaypartId,
+				fmt.Sprintf(`{"name":"Café da manhã","schedules":[{"weekdays":%s,"start":"06:00","end":"10:00"}],"productIds":[%q,%q]}`,
+					weekdays, cheeseBread.ProductId, burger.ProductId), nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, map[int][]string{
+				1: {burger.ProductId},
+				2: {cheeseBread.ProductId},
+				3: {coffee.ProductId},
+				4: {sundae.ProductId},
+			}, menu("/api/v1/menu?at=2026-10-19T09:59:00-03:00"), "the product daypart rules over the category one")
+			assert.Equal(t, map[int][]string{
+				3: {coffee.ProductId},
+				4: {sundae.ProductId},
+			}, menu("/api/v1/menu?at=2026-10-19T10:30:00-03:00"))
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/dayparts", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			dayparts := dto.DaypartContent{}
+			require.NoError(t, json.Unmarshal(content, &dayparts))
+			require.Len(t, dayparts.Content, 2)
+			assert.Equal(t, lunch.DaypartId, dayparts.Content[0].DaypartId, "ordered by name")
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/dayparts/"+breakfast.DaypartId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			found := dto.Daypart{}
+			require.NoError(t, json.Unmarshal(content, &found))
+			assert.Equal(t, "10:00", found.Schedules[0].End)
+			assert.ElementsMatch(t, []string{cheeseBread.ProductId, burger.ProductId}, found.ProductIds)
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/dayparts/"+breakfast.DaypartId, "", nil)
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/dayparts/"+breakfast.DaypartId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			response, _ = call(t, server, http.MethodGet, "/api/v1/dayparts/"+breakfast.DaypartId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			assert.Equal(t, map[int][]string{
+				2: {cheeseBread.ProductId},
+				3: {coffee.ProductId},
+				4: {sundae.ProductId},
+			}, menu("/api/v1/menu?at=2026-10-24T09:30:00-03:00"), "always on the menu without dayparts")
+		})
+	}
+}
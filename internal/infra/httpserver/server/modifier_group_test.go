@@ -0,0 +1,136 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ModifierGroups(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			response, content := call(t, server, http.MethodPost, "/api/v1/modifier-groups",
+				`{"name":"Adicionais","minSelections":0,"maxSelections":2,
+				"options":[{"name":"Bacon","amount":4.5},{"name":"Queijo","amount":3}]}`, nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			extras := dto.ModifierGroup{}
+			require.NoError(t, json.Unmarshal(content, &extras))
+			require.Len(t, extras.Options, 2)
+			bacon, cheese := extras.Options[0], extras.Options[1]
+
+			response, content = call(t, server, http.MethodPost, "/api/v1/modifier-groups",
+				`{"name":"Ponto","required":true,"minSelections":1,"maxSelections":1,
+				"options":[{"name":"Mal passado","amount":0},{"name":"Ao ponto","amount":0}]}`, nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			doneness := dto.ModifierGroup{}
+			require.NoError(t, json.Unmarshal(content, &doneness))
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/modifier-groups",
+				`{"name":"Molhos","required":true,"minSelections":0,"maxSelections":1,"options":[{"name":"Barbecue","amount":1}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/modifier-groups",
+				`{"name":"Molhos","minSelections":0,"maxSelections":2,"options":[{"name":"Barbecue","amount":1}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			product := createProduct(t, server, `{"name":"X-Burger","description":"Pao e carne","categoryId":1,"amount":20}`)
+			path := "/api/v1/product/" + product.ProductId
+
+			response, _ = call(t, server, http.MethodPut, path+"/modifier-groups", `{"groupIds":["missing"]}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPut, path+"/modifier-groups",
+				`{"groupIds":["`+extras.GroupId+`","`+extras.GroupId+`"]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPut, path+"/modifier-groups",
+				`{"groupIds":["`+doneness.GroupId+`","`+extras.GroupId+`"]}`, map[string]string{"If-Match": `"1"`})
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+			attached := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &attached))
+			require.Len(t, attached.ModifierGroups, 2)
+			assert.Equal(t, doneness.GroupId, attached.ModifierGroups[0].GroupId)
+			assert.Equal(t, extras.GroupId, attached.ModifierGroups[1].GroupId)
+
+			response, _ = call(t, server, http.MethodPut, path+"/modifier-groups", `{"groupIds":[]}`,
+				map[string]string{"If-Match": `"1"`})
+			assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPost, path+"/price",
+				`{"modifiers":[{"groupId":"`+doneness.GroupId+`","optionId":"`+doneness.Options[1].OptionId+`"},
+				{"groupId":"`+extras.GroupId+`","optionId":"`+bacon.OptionId+`"},
+				{"groupId":"`+extras.GroupId+`","optionId":"`+cheese.OptionId+`"}]}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			price := dto.ProductPrice{}
+			require.NoError(t, json.Unmarshal(content, &price))
+			assert.Equal(t, 20.0, price.Base)
+			assert.Equal(t, 27.5, price.Total)
+			require.Len(t, price.Modifiers, 3)
+			assert.Equal(t, dto.PricedModifier{GroupId: extras.GroupId, GroupName: "Adicionais",
+				OptionId: bacon.OptionId, OptionName: "Bacon", Amount: 4.5}, price.Modifiers[1])
+
+			response, _ = call(t, server, http.MethodPost, path+"/price", `{"modifiers":[]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode, "required group without a pick")
+
+			response, _ = call(t, server, http.MethodPost, path+"/price",
+				`{"modifiers":[{"groupId":"`+doneness.GroupId+`","optionId":"`+bacon.OptionId+`"}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode, "option of another group")
+
+			response, _ = call(t, server, http.MethodPost, path+"/price",
+				`{"variantId":"missing","modifiers":[{"groupId":"`+doneness.GroupId+`","optionId":"`+doneness.Options[0].OptionId+`"}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode, "variant of a product without variants")
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product/missing/price", `{}`, nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPut, "/api/v1/modifier-groups/"+extras.GroupId,
+				`{"name":"Extras","minSelections":0,"maxSelections":1,
+				"options":[{"id":"`+bacon.OptionId+`","name":"Bacon","amount":5}]}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			response, _ = call(t, server, http.MethodPut, "/api/v1/modifier-groups/"+extras.GroupId,
+				`{"name":"Extras","minSelections":0,"maxSelections":1,"options":[{"id":"unknown","name":"Ovo","amount":2}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPut, "/api/v1/modifier-groups/missing",
+				`{"name":"Extras","minSelections":0,"maxSelections":1,"options":[{"name":"Ovo","amount":2}]}`, nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, path, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			found := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &found))
+			assert.Equal(t, int64(3), found.Version)
+			require.Len(t, found.ModifierGroups, 2)
+			assert.Equal(t, "Extras", found.ModifierGroups[1].Name)
+			assert.Equal(t, []dto.ModifierOption{{OptionId: bacon.OptionId, Name: "Bacon", Amount: 5}}, found.ModifierGroups[1].Options)
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/modifier-groups/"+extras.GroupId, "", nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPut, path+"/modifier-groups", `{"groupIds":["`+doneness.GroupId+`"]}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/modifier-groups/"+extras.GroupId, "", nil)
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+			response, _ = call(t, server, http.MethodGet, "/api/v1/modifier-groups/"+extras.GroupId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/modifier-groups/"+extras.GroupId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/modifier-groups", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			groups := dto.ModifierGroupContent{}
+			require.NoError(t, json.Unmarshal(content, &groups))
+			require.Len(t, groups.Content, 1)
+			assert.Equal(t, doneness.GroupId, groups.Content[0].GroupId)
+		})
+	}
+}
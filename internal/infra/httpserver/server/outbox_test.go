@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/infra/job"
+)
+
+// recordingPublisher fails the events listed in failures once, recording the ones it accepts.
+type recordingPublisher struct {
+	failures  map[string]bool
+	published []dto.CloudEvent
+}
+
+func (publisher *recordingPublisher) Publish(ctx context.Context, event dto.CloudEvent) error {
+	if publisher.failures[event.ID] {
+		delete(publisher.failures, event.ID)
+		return assert.AnError
+	}
+	publisher.published = append(publisher.published, event)
+	return nil
+}
+
+func pendingEvents(t *testing.T, c *container.Container) []model.OutboxEvent {
+	t.Helper()
+
+	events, err := c.OutboxRepository.FindPending(context.Background(), 100)
+	require.NoError(t, err)
+
+	return *events
+}
+
+func TestServer_OutboxRelay(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			c := newTestContainer(t, driver)
+			server := New(c, c.Config)
+
+			repriced := createProduct(t, server, `{"name":"Pastel","description":"Pastel de queijo","categoryId":1,"amount":10}`)
+			response, content := call(t, server, http.MethodPatch, "/api/v1/product/"+repriced.ProductId, `{"amount":12}`,
+				map[string]string{"Content-Type": "application/merge-patch+json"})
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			response, content = call(t, server, http.MethodDelete, "/api/v1/product/"+repriced.ProductId, "", nil)
+			require.Equal(t, http.StatusNoContent, response.StatusCode, string(content))
+
+			other := createProduct(t, server, `{"name":"Suco","description":"Suco de laranja","categoryId":2,"amount":7}`)
+
+			pending := pendingEvents(t, c)
+			types := map[string][]string{}
+			for _, event := range pending {
+				types[event.AggregateId] = append(types[event.AggregateId], event.Type)
+			}
+			require.Len(t, types[repriced.ProductId], 4)
+			assert.Equal(t, string(entity.ProductCreated), types[repriced.ProductId][0])
+			assert.ElementsMatch(t, []string{string(entity.ProductUpdated), string(entity.ProductPriceChanged)}, types[repriced.ProductId][1:3])
+			assert.Equal(t, string(entity.ProductDeleted), types[repriced.ProductId][3])
+			assert.Equal(t, []string{string(entity.ProductCreated)}, types[other.ProductId])
+
+			publisher := &recordingPublisher{failures: map[string]bool{pending[0].ID: true}}
+			relay := job.NewOutboxRelayJob(c.OutboxRepository, c.WebhookRepository, publisher, 10, time.Hour, c.Clock)
+
+			require.NoError(t, relay.Run(context.Background()))
+			require.Len(t, publisher.published, 1)
+			envelope := publisher.published[0]
+			assert.Equal(t, "1.0", envelope.SpecVersion)
+			assert.Equal(t, "com.tbtec.tremligeiro.ProductCreated.v1", envelope.Type)
+			assert.Equal(t, other.ProductId, envelope.Subject)
+
+			data := dto.ProductEvent{}
+			require.NoError(t, json.Unmarshal(envelope.Data, &data))
+			assert.Equal(t, other.ProductId, data.ProductId)
+			assert.Equal(t, 7.0, data.Amount)
+			assert.Equal(t, "Acompanhamento", data.Category.Name)
+
+			retry := pendingEvents(t, c)
+			require.Len(t, retry, 4)
+			assert.Equal(t, pending[0].ID, retry[0].ID)
+			assert.Equal(t, 1, retry[0].Attempts)
+			assert.NotEmpty(t, retry[0].LastError)
+
+			require.NoError(t, relay.Run(context.Background()))
+			assert.Empty(t, pendingEvents(t, c))
+
+			relayed := []string{}
+			for _, event := range publisher.published[1:] {
+				relayed = append(relayed, event.ID)
+			}
+			assert.Equal(t, []string{retry[0].ID, retry[1].ID, retry[2].ID, retry[3].ID}, relayed)
+		})
+	}
+}
+
+func TestServer_OutboxRollback(t *testing.T) {
+	c := newTestContainer(t, container.DriverSQLite)
+	ctx := context.Background()
+
+	err := c.Transactor.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+		product := &model.Product{ID: "rolled-back", Name: "Pastel", CategoryId: 1, Amount: 10, Version: 1, CreatedAt: now, UpdatedAt: now}
+		if err := c.ProductRepository.Create(ctx, product); err != nil {
+			return err
+		}
+		if err := c.OutboxRepository.Add(ctx, model.OutboxEvent{ID: "event", Type: "ProductCreated", AggregateId: product.ID, Payload: "{}", OccurredAt: now}); err != nil {
+			return err
+		}
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	_, err = c.ProductRepository.FindOne(ctx, "rolled-back")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	assert.Empty(t, pendingEvents(t, c))
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ProductAvailability(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			now := time.Now().UTC()
+			c := newTestContainer(t, driver)
+			c.Clock = func() time.Time { return now }
+			server := New(c, c.Config)
+
+			burger := createProduct(t, server, `{"name":"X-Burger","description":"Pão, carne e queijo","categoryId":1,"amount":25}`)
+			fries := createProduct(t, server, `{"name":"Batata","description":"Batata frita","categoryId":2,"amount":12}`)
+			soda := createProduct(t, server, `{"name":"Refrigerante","description":"Lata 350ml","categoryId":3,"amount":6}`)
+			sundae := createProduct(t, server, `{"name":"Sundae","description":"Sorvete com calda","categoryId":4,"amount":12}`)
+			response, content := call(t, server, http.MethodPut, "/api/v1/product/"+soda.ProductId+"/stock", `{"onHand":0}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			listed := func(path string) []string {
+				response, content := call(t, server, http.MethodGet, path, "", nil)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+				page := dto.ProductContent{}
+				require.NoError(t, json.Unmarshal(content, &page))
+				ids := []string{}
+				for _, product := range page.Content {
+					ids = append(ids, product.ProductId)
+				}
+				return ids
+			}
+			searched := func(path string) int {
+				response, content := call(t, server, http.MethodGet, path, "", nil)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+				result := dto.ProductSearchContent{}
+				require.NoError(t, json.Unmarshal(content, &result))
+				return len(result.Content)
+			}
+
+			path := "/api/v1/product/" + burger.ProductId + "/availability"
+			for _, body := range []string{
+				`{"available":false,"changedBy":"maria"}`,
+				`{"available":false,"reason":"Acabou o pão"}`,
+				`{"reason":"Acabou o pão","changedBy":"maria"}`,
+				`{"available":true,"restoreAt":"2099-01-01T00:00:00Z","changedBy":"maria"}`,
+				`{"available":false,"reason":"Acabou o pão","restoreAt":"2001-01-01T00:00:00Z","changedBy":"maria"}`,
+			} {
+				response, _ = call(t, server, http.MethodPost, path, body, nil)
+				assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+			}
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product/missing/availability", `{"available":false,"reason":"x","changedBy":"maria"}`, nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			response, _ = call(t, server, http.MethodPost, path, `{"available":false,"reason":"x","changedBy":"maria"}`, map[string]string{"If-Match": `"9"`})
+			assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPost, path, `{"available":false,"reason":"Acabou o pão","changedBy":"maria"}`, map[string]string{"If-Match": `"1"`})
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+			product := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &product))
+			assert.False(t, product.Available)
+			require.NotNil(t, product.Unavailability)
+			assert.Equal(t, "Acabou o pão", product.Unavailability.Reason)
+			assert.Equal(t, "maria", product.Unavailability.ChangedBy)
+			assert.Nil(t, product.Unavailability.RestoreAt)
+
+			restoreAt := now.Add(time.Hour).Format(time.RFC3339Nano)
+			response, content = call(t, server, http.MethodPost, "/api/v1/product/"+fries.ProductId+"/availability",
+				fmt.Sprintf(`{"available":false,"reason":"Fritadeira em manutenção","restoreAt":%q,"changedBy":"joão"}`, restoreAt), nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			assert.Equal(t, []string{sundae.ProductId}, listed("/api/v1/product"), "unavailable and sold out products are hidden")
+			assert.Len(t, listed("/api/v1/product?includeUnavailable=true"), 4)
+			assert.ElementsMatch(t, []string{burger.ProductId, fries.ProductId}, listed("/api/v1/product/unavailable"))
+			assert.Equal(t, 0, searched("/api/v1/product/search?q=burger"))
+			assert.Equal(t, 1, searched("/api/v1/product/search?q=burger&includeUnavailable=true"))
+			response, _ = call(t, server, http.MethodGet, "/api/v1/product?includeUnavailable=maybe", "", nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+burger.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &product))
+			assert.False(t, product.Available, "still readable by id")
+
+			response, content = call(t, server, http.MethodPost, path, `{"available":true,"changedBy":"ana"}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			restored := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &restored))
+			assert.True(t, restored.Available)
+			assert.Nil(t, restored.Unavailability)
+
+			response, content = call(t, server, http.MethodGet, path, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			availability := dto.ProductAvailability{}
+			require.NoError(t, json.Unmarshal(content, &availability))
+			assert.True(t, availability.Available)
+			require.Len(t, availability.History, 2)
+			assert.True(t, availability.History[0].Available)
+			assert.Equal(t, "ana", availability.History[0].ChangedBy)
+			assert.False(t, availability.History[1].Available)
+			assert.Equal(t, "maria", availability.History[1].ChangedBy)
+			assert.Equal(t, "Acabou o pão", availability.History[1].Reason)
+
+			updates := 0
+			for _, event := range pendingEvents(t, c) {
+				if event.AggregateId == burger.ProductId && event.Type == string(entity.ProductUpdated) {
+					updates++
+				}
+			}
+			assert.Equal(t, 2, updates)
+
+			now = now.Add(time.Hour + time.Second)
+			assert.ElementsMatch(t, []string{burger.ProductId, fries.ProductId, sundae.ProductId}, listed("/api/v1/product"), "fries restored by themselves")
+			assert.Empty(t, listed("/api/v1/product/unavailable"))
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+fries.ProductId+"/availability", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			availability = dto.ProductAvailability{}
+			require.NoError(t, json.Unmarshal(content, &availability))
+			assert.True(t, availability.Available)
+			assert.Nil(t, availability.Unavailability)
+		})
+	}
+}
+
+// TestServer_SearchUnavailable makes sure the products that cannot be sold do not take the places
+// of the available ones within the size of the search.
+func TestServer_SearchUnavailable(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			c := newTestContainer(t, driver)
+			server := New(c, c.Config)
+
+			burger := createProduct(t, server, `{"name":"X-Burger","description":"Burger de carne","categoryId":1,"amount":25}`)
+			soldOut := createProduct(t, server, `{"name":"Burger duplo","description":"Burger com dois hambúrgueres","categoryId":1,"amount":32}`)
+			veggie := createProduct(t, server, `{"name":"Sanduíche vegano","description":"Burger de grão-de-bico","categoryId":1,"amount":28}`)
+
+			response, content := call(t, server, http.MethodPost, "/api/v1/product/"+burger.ProductId+"/availability",
+				`{"available":false,"reason":"Acabou a carne","changedBy":"maria"}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			response, content = call(t, server, http.MethodPut, "/api/v1/product/"+soldOut.ProductId+"/stock", `{"onHand":0}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			search := func(path string) []string {
+				response, content := call(t, server, http.MethodGet, path, "", nil)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+				result := dto.ProductSearchContent{}
+				require.NoError(t, json.Unmarshal(content, &result))
+				ids := []string{}
+				for _, match := range result.Content {
+					ids = append(ids, match.Product.ProductId)
+				}
+				return ids
+			}
+
+			assert.Equal(t, []string{veggie.ProductId}, search("/api/v1/product/search?q=burger&size=1"))
+			assert.Len(t, search("/api/v1/product/search?q=burger&size=1&includeUnavailable=true"), 1)
+			assert.ElementsMatch(t, []string{burger.ProductId, soldOut.ProductId, veggie.ProductId},
+				search("/api/v1/product/search?q=burger&includeUnavailable=true"))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ProductBulk(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			pastel := createProduct(t, server, `{"name":"Pastel","description":"Pastel de queijo","categoryId":1,"amount":10}`)
+			coxinha := createProduct(t, server, `{"name":"Coxinha","description":"Coxinha de frango","categoryId":1,"amount":7.99}`)
+			batata := createProduct(t, server, `{"name":"Batata","description":"Batata frita","categoryId":2,"amount":9}`)
+			suco := createProduct(t, server, `{"name":"Suco","description":"Laranja","categoryId":3,"amount":8}`)
+
+			bulk := func(body string) (int, dto.BulkReport) {
+				response, content := call(t, server, http.MethodPost, "/api/v1/product/bulk", body, nil)
+				report := dto.BulkReport{}
+				require.NoError(t, json.Unmarshal(content, &report), string(content))
+				return response.StatusCode, report
+			}
+			find := func(id string) dto.Product {
+				response, content := call(t, server, http.MethodGet, "/api/v1/product/"+id, "", nil)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+				product := dto.Product{}
+				require.NoError(t, json.Unmarshal(content, &product))
+				return product
+			}
+
+			status, report := bulk(`{"atomic":true,"operations":[
+				{"type":"adjustPrice","categoryId":1,"percentage":10},
+				{"type":"update","id":"` + batata.ProductId + `","version":7,"name":"Batata rústica"}]}`)
+			if driver == "memory" {
+				assert.Equal(t, http.StatusBadRequest, status, "memory writes cannot be rolled back")
+			} else {
+				assert.Equal(t, http.StatusUnprocessableEntity, status)
+				assert.False(t, report.Applied)
+				require.Len(t, report.Results, 3)
+				assert.Equal(t, dto.BulkItemSkipped, report.Results[0].Status)
+				assert.Equal(t, dto.BulkItemFailed, report.Results[2].Status)
+				assert.Equal(t, "TL-PRODUCT-003", report.Results[2].Error.Code)
+			}
+			assert.Equal(t, 10.0, find(pastel.ProductId).Amount)
+
+			status, report = bulk(`{"operations":[
+				{"type":"adjustPrice","categoryId":1,"percentage":10},
+				{"type":"update","id":"` + batata.ProductId + `","version":1,"name":"Batata rústica","categoryId":9},
+				{"type":"adjustPrice","categoryId":2,"delta":-0.5},
+				{"type":"delete","id":"` + suco.ProductId + `"},
+				{"type":"delete","id":"missing"}]}`)
+			assert.Equal(t, http.StatusOK, status)
+			assert.True(t, report.Applied)
+			assert.Equal(t, 4, report.Succeeded)
+			assert.Equal(t, 2, report.Failed)
+
+			byProduct := map[string]dto.BulkResult{}
+			for _, result := range report.Results {
+				if result.Error == nil {
+					byProduct[result.ProductId] = result
+				}
+			}
+			assert.Equal(t, 11.0, byProduct[pastel.ProductId].Product.Amount)
+			assert.Equal(t, 8.79, byProduct[coxinha.ProductId].Product.Amount)
+			assert.Equal(t, 8.5, byProduct[batata.ProductId].Product.Amount)
+			assert.Equal(t, dto.BulkItemDeleted, byProduct[suco.ProductId].Status)
+			assert.Equal(t, "TL-PRODUCT-001", report.Results[2].Error.Code, "the category does not exist")
+			assert.Equal(t, "TL-PRODUCT-002", report.Results[5].Error.Code, "the product does not exist")
+
+			assert.Equal(t, 11.0, find(pastel.ProductId).Amount)
+			assert.Equal(t, int64(2), find(coxinha.ProductId).Version)
+			assert.Equal(t, "Batata", find(batata.ProductId).Name)
+			response, _ := call(t, server, http.MethodGet, "/api/v1/product/"+suco.ProductId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			_, report = bulk(`{"operations":[{"type":"update","id":"missing","name":"Esfiha"},{"type":"delete","id":"missing"}]}`)
+			require.Len(t, report.Results, 2)
+			assert.Equal(t, "TL-PRODUCT-002", report.Results[0].Error.Code)
+			assert.Equal(t, "TL-PRODUCT-002", report.Results[1].Error.Code)
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product/bulk", `{"operations":[{"type":"rename","id":"x"}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		})
+	}
+}
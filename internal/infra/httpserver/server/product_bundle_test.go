@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ProductBundles(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			burger := createProduct(t, server, `{"name":"X-Burger","description":"Pao e carne","categoryId":1,"amount":20}`)
+			fries := createProduct(t, server, `{"name":"Batata","description":"Frita","categoryId":2,"amount":9.9}`)
+			soda := createProduct(t, server, `{"name":"Refrigerante","description":"Lata","categoryId":3,"amount":6}`)
+			assert.Equal(t, dto.ProductTypeSingle, burger.Type)
+			assert.Nil(t, burger.Bundle)
+
+			combo := createProduct(t, server, `{"name":"Combo X-Burger","description":"Lanche, batata e bebida","categoryId":1,"amount":30,
+				"components":[{"productId":"`+burger.ProductId+`","quantity":1},{"productId":"`+fries.ProductId+`","quantity":1},
+				{"productId":"`+soda.ProductId+`","quantity":2,"swappable":true}]}`)
+			assert.Equal(t, dto.ProductTypeBundle, combo.Type)
+			require.NotNil(t, combo.Bundle)
+			require.Len(t, combo.Bundle.Components, 3)
+			drink := combo.Bundle.Components[2]
+			assert.Equal(t, "Refrigerante", drink.Name)
+			assert.Equal(t, 3, drink.CategoryId)
+			assert.Equal(t, 2, drink.Quantity)
+			assert.True(t, drink.Swappable)
+			require.NotNil(t, combo.Bundle.Savings)
+			assert.Equal(t, 41.9, *combo.Bundle.ComponentsAmount)
+			assert.Equal(t, 11.9, *combo.Bundle.Savings)
+
+			response, _ := call(t, server, http.MethodPost, "/api/v1/product", `{"name":"Combo","description":"Sem lanche","categoryId":1,"amount":10,
+				"components":[{"productId":"missing","quantity":1}]}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product", `{"name":"Combo duplo","description":"Dois combos","categoryId":1,"amount":50,
+				"components":[{"productId":"`+combo.ProductId+`","quantity":2}]}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode, "bundle of bundles")
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product", `{"name":"Combo","description":"Repetido","categoryId":1,"amount":10,
+				"components":[{"productId":"`+soda.ProductId+`","quantity":1},{"productId":"`+soda.ProductId+`","quantity":1}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product", `{"name":"Combo","description":"Vazio","categoryId":1,"amount":10,
+				"components":[{"productId":"`+soda.ProductId+`","quantity":0}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPut, "/api/v1/product/"+soda.ProductId+"/components",
+				`{"components":[{"productId":"`+fries.ProductId+`","quantity":1}]}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode, "component becoming a bundle")
+
+			path := "/api/v1/product/" + combo.ProductId
+			response, _ = call(t, server, http.MethodPut, path+"/components",
+				`{"components":[{"productId":"`+combo.ProductId+`","quantity":1}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode, "bundle of itself")
+
+			response, content := call(t, server, http.MethodPut, path+"/components",
+				`{"components":[{"productId":"`+burger.ProductId+`","quantity":1},{"productId":"`+soda.ProductId+`","quantity":1}]}`,
+				map[string]string{"If-Match": `"1"`})
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+			updated := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &updated))
+			require.Len(t, updated.Bundle.Components, 2)
+			assert.Equal(t, -4.0, *updated.Bundle.Savings)
+
+			response, _ = call(t, server, http.MethodPut, path+"/components", `{"components":[]}`, map[string]string{"If-Match": `"1"`})
+			assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/product/"+soda.ProductId, "", nil)
+			require.Equal(t, http.StatusNoContent, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, path, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			found := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &found))
+			require.Len(t, found.Bundle.Components, 2)
+			assert.Equal(t, "X-Burger", found.Bundle.Components[0].Name)
+			assert.Equal(t, dto.BundleComponent{ProductId: soda.ProductId, Quantity: 1}, found.Bundle.Components[1])
+			assert.Nil(t, found.Bundle.Savings, "component deleted")
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product?categoryId=1", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			listed := dto.ProductContent{}
+			require.NoError(t, json.Unmarshal(content, &listed))
+			for _, product := range listed.Content {
+				if product.ProductId == combo.ProductId {
+					assert.Equal(t, "X-Burger", product.Bundle.Components[0].Name)
+				}
+			}
+
+			response, content = call(t, server, http.MethodPut, path+"/components", `{"components":[]}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			single := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &single))
+			assert.Equal(t, dto.ProductTypeSingle, single.Type)
+			assert.Nil(t, single.Bundle)
+		})
+	}
+}
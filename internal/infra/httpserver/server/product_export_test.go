@@ -0,0 +1,65 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ProductExport(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			pastel := createProduct(t, server, `{"name":"Pastel","description":"Pastel de queijo, com orégano","categoryId":1,"amount":10.5}`)
+			createProduct(t, server, `{"name":"Batata","description":"Batata frita","categoryId":2,"amount":7}`)
+			createProduct(t, server, `{"name":"Guaraná","description":"Lata","categoryId":3,"amount":6}`)
+
+			response, content := call(t, server, http.MethodGet, "/api/v1/product/export", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+			assert.Equal(t, `attachment; filename="products.json"`, response.Header.Get("Content-Disposition"))
+			products := []dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &products), string(content))
+			require.Len(t, products, 3)
+			assert.Equal(t, pastel, products[0])
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/export?format=ndjson&categoryId=1,3", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, "application/x-ndjson", response.Header.Get("Content-Type"))
+			lines := []dto.Product{}
+			scanner := bufio.NewScanner(bytes.NewReader(content))
+			for scanner.Scan() {
+				product := dto.Product{}
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &product))
+				lines = append(lines, product)
+			}
+			require.Len(t, lines, 2)
+			assert.Equal(t, "Guaraná", lines[1].Name)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/export?format=csv&categoryId=1", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, "text/csv; charset=utf-8", response.Header.Get("Content-Type"))
+			records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, 2)
+			assert.Equal(t, dto.ProductExportColumns, records[0])
+			assert.Equal(t, []string{pastel.ProductId, "Pastel", "Pastel de queijo, com orégano", "10.5", "1", pastel.Category.Name},
+				records[1][:6])
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/export?format=csv&categoryId=99", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, "id,name,description,amount,category.id,category.name,version,createdAt,updatedAt\n", string(content))
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/export?format=xml", "", nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode, string(content))
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func pngImage(t *testing.T, width int, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, x*height/width, color.NRGBA{R: 200, A: 255})
+	}
+
+	buffer := bytes.Buffer{}
+	require.NoError(t, png.Encode(&buffer, img))
+
+	return buffer.Bytes()
+}
+
+func TestServer_ProductImages(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+			mediaDir := server.Config.StorageLocalDir
+
+			product := createProduct(t, server, `{"name":"Pastel","description":"Pastel de queijo","categoryId":1,"amount":10.5}`)
+			assert.Empty(t, product.Images)
+			path := "/api/v1/product/" + product.ProductId + "/images"
+
+			response, content := call(t, server, http.MethodPost, path, string(pngImage(t, 64, 48)),
+				map[string]string{"Content-Type": "image/png"})
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			uploaded := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &uploaded))
+			assert.Equal(t, product.Version+1, uploaded.Version)
+			require.Len(t, uploaded.Images, 1)
+			image := uploaded.Images[0]
+			assert.Equal(t, "image/png", image.ContentType)
+			assert.Equal(t, 64, image.Width)
+			assert.Equal(t, 48, image.Height)
+			assert.Equal(t, "http://localhost:8080/media/products/"+product.ProductId+"/"+image.ImageId+"/original.png", image.Url)
+			require.Len(t, image.Thumbnails, 2)
+			assert.Equal(t, dto.ProductThumbnail{Size: 16, Width: 16, Height: 12,
+				Url: "http://localhost:8080/media/products/" + product.ProductId + "/" + image.ImageId + "/16.png"}, image.Thumbnails[0])
+			assert.Equal(t, 32, image.Thumbnails[1].Width)
+
+			thumbnail, err := os.Open(filepath.Join(mediaDir, "products", product.ProductId, image.ImageId, "32.png"))
+			require.NoError(t, err)
+			config, err := png.DecodeConfig(thumbnail)
+			thumbnail.Close()
+			require.NoError(t, err)
+			assert.Equal(t, 24, config.Height)
+
+			response, content = call(t, server, http.MethodGet, strings.TrimPrefix(image.Url, "http://localhost:8080"), "", nil)
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+			assert.Equal(t, pngImage(t, 64, 48), content)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			found := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &found))
+			assert.Equal(t, uploaded.Images, found.Images)
+
+			body := bytes.Buffer{}
+			form := multipart.NewWriter(&body)
+			header := map[string][]string{
+				"Content-Disposition": {`form-data; name="file"; filename="small.png"`},
+				"Content-Type":        {"image/png"},
+			}
+			part, err := form.CreatePart(header)
+			require.NoError(t, err)
+			_, err = part.Write(pngImage(t, 20, 40))
+			require.NoError(t, err)
+			require.NoError(t, form.Close())
+
+			response, content = call(t, server, http.MethodPost, path, body.String(),
+				map[string]string{"Content-Type": form.FormDataContentType()})
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			uploaded = dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &uploaded))
+			require.Len(t, uploaded.Images, 2)
+			require.Len(t, uploaded.Images[1].Thumbnails, 2)
+			assert.Equal(t, 8, uploaded.Images[1].Thumbnails[0].Width)
+			assert.Equal(t, 16, uploaded.Images[1].Thumbnails[1].Width)
+
+			response, _ = call(t, server, http.MethodPost, path, string(pngImage(t, 10, 10)),
+				map[string]string{"Content-Type": "image/png"})
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodDelete, path+"/"+image.ImageId, "", nil)
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+			_, err = os.Stat(filepath.Join(mediaDir, "products", product.ProductId, image.ImageId, "original.png"))
+			assert.ErrorIs(t, err, os.ErrNotExist)
+
+			response, _ = call(t, server, http.MethodDelete, path+"/"+image.ImageId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			found = dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &found))
+			require.Len(t, found.Images, 1)
+			assert.Equal(t, uploaded.Images[1].ImageId, found.Images[0].ImageId)
+		})
+	}
+}
+
+func TestServer_ProductImages_Invalid(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			product := createProduct(t, server, `{"name":"Pastel","description":"Pastel de queijo","categoryId":1,"amount":10.5}`)
+			path := "/api/v1/product/" + product.ProductId + "/images"
+
+			response, _ := call(t, server, http.MethodPost, path, "GIF89a", map[string]string{"Content-Type": "image/gif"})
+			assert.Equal(t, http.StatusUnsupportedMediaType, response.StatusCode)
+
+			response, content := call(t, server, http.MethodPost, path, string(pngImage(t, 8, 8)),
+				map[string]string{"Content-Type": "image/jpeg"})
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+			assert.Contains(t, string(content), "contentType")
+
+			response, content = call(t, server, http.MethodPost, path, "not an image", map[string]string{"Content-Type": "image/png"})
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+			assert.Contains(t, string(content), "content")
+
+			response, content = call(t, server, http.MethodPost, path, string(make([]byte, 1<<20+1)),
+				map[string]string{"Content-Type": "image/png"})
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+			assert.Contains(t, string(content), "size")
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product/missing/images", string(pngImage(t, 8, 8)),
+				map[string]string{"Content-Type": "image/png"})
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Contains(t, string(content), `"images":[]`)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ProductImport(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+			csv := map[string]string{"Content-Type": "text/csv"}
+
+			pastel := createProduct(t, server, `{"name":"Pastel","description":"Pastel de queijo","categoryId":1,"amount":10}`)
+
+			importing := func(query string, body string) (int, dto.ImportReport) {
+				response, content := call(t, server, http.MethodPost, "/api/v1/product/import"+query, body, csv)
+				report := dto.ImportReport{}
+				require.NoError(t, json.Unmarshal(content, &report), string(content))
+				return response.StatusCode, report
+			}
+
+			rows := "id,name,description,categoryId,amount\n" +
+				pastel.ProductId + ",Pastel,Pastel de carne,1,12.5\n" +
+				",Coxinha,,1,8\n" +
+				",Guaraná,Lata 350ml,9,6\n"
+
+			status, report := importing("", rows)
+			if driver == "memory" {
+				assert.Equal(t, http.StatusBadRequest, status, "memory writes cannot be rolled back")
+			} else {
+				assert.Equal(t, http.StatusUnprocessableEntity, status)
+				assert.False(t, report.Applied)
+				assert.Equal(t, []string{dto.ImportRowSkipped, dto.ImportRowSkipped, dto.ImportRowFailed},
+					[]string{report.Rows[0].Status, report.Rows[1].Status, report.Rows[2].Status})
+				assert.Equal(t, 3, report.Rows[2].Row)
+			}
+
+			status, report = importing("?mode=best-effort&dryRun=true", rows)
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, 1, report.Created)
+			assert.Equal(t, 1, report.Updated)
+			assert.Equal(t, pastel.ProductId, report.Rows[0].ProductId)
+
+			response, content := call(t, server, http.MethodGet, "/api/v1/product/"+pastel.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Contains(t, string(content), "Pastel de queijo")
+
+			status, report = importing("?mode=best-effort", rows)
+			assert.Equal(t, http.StatusOK, status)
+			assert.True(t, report.Applied)
+			assert.Equal(t, 1, report.Failed)
+			require.NotEmpty(t, report.Rows[1].ProductId)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+pastel.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			updated := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &updated))
+			assert.Equal(t, "Pastel de carne", updated.Description)
+			assert.Equal(t, 12.5, updated.Amount)
+			assert.Equal(t, int64(2), updated.Version)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+report.Rows[1].ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			created := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &created))
+			assert.Equal(t, "Coxinha", created.Name)
+			assert.Empty(t, created.Description, "the description is optional, as in PUT")
+		})
+	}
+}
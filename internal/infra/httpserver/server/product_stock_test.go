@@ -0,0 +1,115 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ProductStock(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			c := newTestContainer(t, driver)
+			server := New(c, c.Config)
+
+			product := createProduct(t, server, `{"name":"Sundae","description":"Sorvete com calda","categoryId":4,"amount":12}`)
+			assert.True(t, product.Available)
+			assert.Nil(t, product.Stock)
+			path := "/api/v1/product/" + product.ProductId + "/stock"
+
+			response, _ := call(t, server, http.MethodGet, path, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			response, _ = call(t, server, http.MethodPost, path+"/decrement", `{"quantity":1}`, nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			response, _ = call(t, server, http.MethodPut, "/api/v1/product/missing/stock", `{"onHand":1}`, nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			response, _ = call(t, server, http.MethodPut, path, `{"onHand":-1}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, content := call(t, server, http.MethodPut, path, `{"onHand":10,"lowStockThreshold":3}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			response, content = call(t, server, http.MethodPost, path+"/reserve", `{"quantity":4}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			stock := dto.ProductStock{}
+			require.NoError(t, json.Unmarshal(content, &stock))
+			assert.Equal(t, 10, stock.OnHand)
+			assert.Equal(t, 4, stock.Reserved)
+			assert.Equal(t, 6, stock.AvailableQuantity)
+			assert.False(t, stock.Low)
+
+			response, _ = call(t, server, http.MethodPut, path, `{"onHand":3,"lowStockThreshold":3}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode, "fewer units than reserved")
+			response, _ = call(t, server, http.MethodPost, path+"/release", `{"quantity":5}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			var wg sync.WaitGroup
+			codes := make(chan int, 10)
+			for range 10 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					response, _ := call(t, server, http.MethodPost, path+"/decrement", `{"quantity":1}`, nil)
+					codes <- response.StatusCode
+				}()
+			}
+			wg.Wait()
+			close(codes)
+			sold := 0
+			for code := range codes {
+				if code == http.StatusOK {
+					sold++
+				} else {
+					assert.Equal(t, http.StatusUnprocessableEntity, code)
+				}
+			}
+			assert.Equal(t, 6, sold, "only the units not reserved are sold")
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			found := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &found))
+			assert.False(t, found.Available)
+			require.NotNil(t, found.Stock)
+			assert.Equal(t, 4, found.Stock.OnHand)
+			assert.True(t, found.Stock.Low)
+
+			lowStock := 0
+			for _, event := range pendingEvents(t, c) {
+				if event.AggregateId == product.ProductId && event.Type == string(entity.ProductLowStock) {
+					lowStock++
+					payload := dto.ProductEvent{}
+					require.NoError(t, json.Unmarshal([]byte(event.Payload), &payload))
+					assert.Equal(t, 3, payload.Stock.AvailableQuantity)
+				}
+			}
+			assert.Equal(t, 1, lowStock, "reported once, when the stock fell to its threshold")
+
+			response, content = call(t, server, http.MethodPost, path+"/release", `{"quantity":4}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			response, content = call(t, server, http.MethodPost, path+"/increment", `{"quantity":6}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &stock))
+			assert.Equal(t, 10, stock.AvailableQuantity)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product?categoryId=4", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			listed := dto.ProductContent{}
+			require.NoError(t, json.Unmarshal(content, &listed))
+			require.Len(t, listed.Content, 1)
+			assert.True(t, listed.Content[0].Available)
+			assert.Equal(t, 10, listed.Content[0].Stock.OnHand)
+
+			response, _ = call(t, server, http.MethodDelete, path, "", nil)
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+			response, _ = call(t, server, http.MethodDelete, path, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+		})
+	}
+}
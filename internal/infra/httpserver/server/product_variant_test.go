@@ -0,0 +1,120 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func TestServer_ProductVariants(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			product := createProduct(t, server, `{"name":"Refrigerante","description":"Copo","categoryId":3,"amount":6,
+				"variantsEnabled":true,"variants":[{"sku":"REF-P","label":"P","amount":6},{"sku":"REF-M","label":"M","amount":8}]}`)
+			assert.True(t, product.VariantsEnabled)
+			require.Len(t, product.Variants, 2)
+			assert.Equal(t, "REF-M", product.Variants[1].Sku)
+			assert.Equal(t, 8.0, product.Variants[1].Amount)
+			path := "/api/v1/product/" + product.ProductId + "/variants"
+
+			response, content := call(t, server, http.MethodPost, path, `{"sku":"REF-G","label":"G","amount":10}`,
+				map[string]string{"If-Match": `"1"`})
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+			updated := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &updated))
+			require.Len(t, updated.Variants, 3)
+			large := updated.Variants[2]
+			assert.Equal(t, dto.ProductVariant{VariantId: large.VariantId, Sku: "REF-G", Label: "G", Amount: 10}, large)
+
+			response, _ = call(t, server, http.MethodPost, path, `{"sku":"REF-G","label":"GG","amount":12}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPost, path, `{"sku":"REF-GG","label":"GG","amount":12}`,
+				map[string]string{"If-Match": `"1"`})
+			assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPut, path+"/"+large.VariantId, `{"sku":"REF-G","label":"Grande","amount":11}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			response, content = call(t, server, http.MethodGet, path+"/"+large.VariantId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			variant := dto.ProductVariant{}
+			require.NoError(t, json.Unmarshal(content, &variant))
+			assert.Equal(t, "Grande", variant.Label)
+			assert.Equal(t, 11.0, variant.Amount)
+
+			response, _ = call(t, server, http.MethodPut, path+"/missing", `{"sku":"REF-X","label":"X","amount":1}`, nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPut, path+"/"+large.VariantId, `{"sku":"REF-G","label":"Grande"}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			for _, variant := range updated.Variants[:2] {
+				response, _ = call(t, server, http.MethodDelete, path+"/"+variant.VariantId, "", nil)
+				assert.Equal(t, http.StatusNoContent, response.StatusCode)
+			}
+			response, _ = call(t, server, http.MethodDelete, path+"/"+large.VariantId, "", nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, path, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			variants := dto.ProductVariantContent{}
+			require.NoError(t, json.Unmarshal(content, &variants))
+			require.Len(t, variants.Content, 1)
+			assert.Equal(t, large.VariantId, variants.Content[0].VariantId)
+
+			response, _ = call(t, server, http.MethodDelete, path, "", nil)
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			found := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &found))
+			assert.False(t, found.VariantsEnabled)
+			assert.Empty(t, found.Variants)
+			assert.Contains(t, string(content), `"variants":[]`)
+		})
+	}
+}
+
+func TestServer_ProductVariants_Invalid(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			response, content := call(t, server, http.MethodPost, "/api/v1/product",
+				`{"name":"Suco","description":"Copo","categoryId":3,"amount":6,"variantsEnabled":true}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+			assert.Contains(t, string(content), "variants")
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product",
+				`{"name":"Suco","description":"Copo","categoryId":3,"amount":6,"variants":[{"sku":"S-P","label":"P","amount":6}]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/product",
+				`{"name":"Suco","description":"Copo","categoryId":3,"amount":6,"variantsEnabled":true,
+				"variants":[{"sku":"S-P","label":"P","amount":6},{"sku":"S-P","label":"M","amount":8}]}`, nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			product := createProduct(t, server, `{"name":"Suco","description":"Copo","categoryId":3,"amount":6}`)
+			assert.False(t, product.VariantsEnabled)
+
+			response, content = call(t, server, http.MethodPost, "/api/v1/product/"+product.ProductId+"/variants",
+				`{"sku":"S-P","label":"P","amount":6}`, nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			updated := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &updated))
+			assert.True(t, updated.VariantsEnabled)
+
+			response, _ = call(t, server, http.MethodGet, "/api/v1/product/missing/variants", "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+		})
+	}
+}
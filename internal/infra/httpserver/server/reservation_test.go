@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/job"
+)
+
+func TestServer_Reservation(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			c := newTestContainer(t, driver)
+			server := New(c, c.Config)
+
+			burger := createProduct(t, server, `{"name":"X-Burger","description":"Pão, carne e queijo","categoryId":1,"amount":25}`)
+			fries := createProduct(t, server, `{"name":"Batata","description":"Batata frita","categoryId":2,"amount":12}`)
+			soda := createProduct(t, server, `{"name":"Refrigerante","description":"Lata 350ml","categoryId":3,"amount":6}`)
+			response, content := call(t, server, http.MethodPut, "/api/v1/product/"+burger.ProductId+"/stock", `{"onHand":5}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			response, content = call(t, server, http.MethodPut, "/api/v1/product/"+fries.ProductId+"/stock", `{"onHand":2}`, nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			stockOf := func(productId string) dto.ProductStock {
+				response, content := call(t, server, http.MethodGet, "/api/v1/product/"+productId+"/stock", "", nil)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+				stock := dto.ProductStock{}
+				require.NoError(t, json.Unmarshal(content, &stock))
+				return stock
+			}
+			reserve := func(body string) dto.Reservation {
+				response, content := call(t, server, http.MethodPost, "/api/v1/reservations", body, nil)
+				require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+				reservation := dto.Reservation{}
+				require.NoError(t, json.Unmarshal(content, &reservation))
+				return reservation
+			}
+			items := func(quantities ...any) string {
+				return fmt.Sprintf(`{"items":[{"productId":%q,"quantity":%d},{"productId":%q,"quantity":%d}]}`, quantities...)
+			}
+
+			response, _ = call(t, server, http.MethodPost, "/api/v1/reservations", `{"items":[]}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+			response, _ = call(t, server, http.MethodPost, "/api/v1/reservations", items(burger.ProductId, 1, burger.ProductId, 1), nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode, "product listed twice")
+			response, _ = call(t, server, http.MethodPost, "/api/v1/reservations", items(burger.ProductId, 1, "missing", 1), nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+			response, _ = call(t, server, http.MethodPost, "/api/v1/reservations", items(burger.ProductId, 3, fries.ProductId, 3), nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode, "not enough fries")
+			assert.Equal(t, 0, stockOf(burger.ProductId).Reserved, "nothing reserved when an item fails")
+
+			reservation := reserve(fmt.Sprintf(`{"items":[{"productId":%q,"quantity":3},{"productId":%q,"quantity":2},{"productId":%q,"quantity":1}],"ttlSeconds":600}`,
+				burger.ProductId, fries.ProductId, soda.ProductId))
+			assert.Equal(t, string(entity.ReservationPending), reservation.Status)
+			require.Len(t, reservation.Items, 3)
+			assert.True(t, reservation.Items[0].Held)
+			assert.True(t, reservation.Items[1].Held)
+			assert.False(t, reservation.Items[2].Held, "stock of the soda is not tracked")
+			assert.WithinDuration(t, reservation.CreatedAt.Add(10*time.Minute), reservation.ExpiresAt, time.Second)
+			assert.Equal(t, 3, stockOf(burger.ProductId).Reserved)
+			assert.Equal(t, 0, stockOf(fries.ProductId).AvailableQuantity)
+
+			lowStock := 0
+			for _, event := range pendingEvents(t, c) {
+				if event.AggregateId == fries.ProductId && event.Type == string(entity.ProductLowStock) {
+					lowStock++
+				}
+			}
+			assert.Equal(t, 1, lowStock)
+
+			path := "/api/v1/reservations/" + reservation.ID
+			response, content = call(t, server, http.MethodPost, path+"/confirm", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &reservation))
+			assert.Equal(t, string(entity.ReservationConfirmed), reservation.Status)
+			stock := stockOf(burger.ProductId)
+			assert.Equal(t, 2, stock.OnHand)
+			assert.Equal(t, 0, stock.Reserved)
+			response, _ = call(t, server, http.MethodPost, path+"/confirm", "", nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+			response, _ = call(t, server, http.MethodPost, path+"/release", "", nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			released := reserve(fmt.Sprintf(`{"items":[{"productId":%q,"quantity":2}]}`, burger.ProductId))
+			assert.Equal(t, 2, stockOf(burger.ProductId).Reserved)
+			response, content = call(t, server, http.MethodPost, "/api/v1/reservations/"+released.ID+"/release", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &released))
+			assert.Equal(t, string(entity.ReservationReleased), released.Status)
+			assert.Equal(t, 0, stockOf(burger.ProductId).Reserved)
+
+			abandoned := reserve(fmt.Sprintf(`{"items":[{"productId":%q,"quantity":1}],"ttlSeconds":1}`, burger.ProductId))
+			assert.Equal(t, 1, stockOf(burger.ProductId).Reserved)
+			time.Sleep(1100 * time.Millisecond)
+
+			path = "/api/v1/reservations/" + abandoned.ID
+			response, content = call(t, server, http.MethodGet, path, "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &abandoned))
+			assert.Equal(t, string(entity.ReservationExpired), abandoned.Status)
+			response, _ = call(t, server, http.MethodPost, path+"/confirm", "", nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			sweep := job.NewReservationSweepJob(c.ReservationRepository, c.StockRepository, c.Transactor, 10, c.Clock)
+			require.NoError(t, sweep.Run(context.Background()))
+			assert.Equal(t, 0, stockOf(burger.ProductId).Reserved, "stock of the abandoned cart is back")
+			require.NoError(t, sweep.Run(context.Background()))
+			assert.Equal(t, 0, stockOf(burger.ProductId).Reserved, "released once")
+
+			response, _ = call(t, server, http.MethodGet, "/api/v1/reservations/missing", "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+		})
+	}
+}
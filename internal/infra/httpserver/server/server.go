@@ -14,6 +14,7 @@ import (
 	"github.com/tbtec/tremligeiro/internal/infra/container"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver/controller"
 	"github.com/tbtec/tremligeiro/internal/infra/httpserver/middleware"
+	"github.com/tbtec/tremligeiro/internal/infra/storage"
 )
 
 type HTTPServer struct {
@@ -24,7 +25,7 @@ type HTTPServer struct {
 func New(container *container.Container, config env.Config) *HTTPServer {
 	slog.InfoContext(context.Background(), "Creating HTTP Server...")
 
-	app := fiber.New(fiber.Config{ReadBufferSize: 8192})
+	app := fiber.New(fiber.Config{ReadBufferSize: 8192, BodyLimit: bodyLimit(config)})
 
 	trap := make(chan os.Signal, 1)
 	signal.Notify(trap, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
@@ -32,14 +33,83 @@ func New(container *container.Container, config env.Config) *HTTPServer {
 
 	app.Get("/live", adapt(controller.NewLivenessController()))
 
+	if config.StorageKind == storage.KindLocal {
+		app.Static("/media", config.StorageLocalDir)
+	}
+
 	baseRouter := app.Group("/api/v1")
 
 	//Product Routes
 	baseRouter.Post("/product", adapt(controller.NewProductCreateRestController(container)))
 	baseRouter.Get("/product", adapt(controller.NewProductFindByCategoryRestController(container)))
+	baseRouter.Post("/product/import", adapt(controller.NewProductImportRestController(container)))
+	baseRouter.Get("/product/export", adapt(controller.NewProductExportRestController(container)))
+	baseRouter.Post("/product/bulk", adapt(controller.NewProductBulkRestController(container)))
+	baseRouter.Get("/product/search", adapt(controller.NewProductSearchRestController(container)))
+	baseRouter.Get("/product/trash", adapt(controller.NewProductTrashRestController(container)))
+	baseRouter.Get("/product/unavailable", adapt(controller.NewProductUnavailableRestController(container)))
 	baseRouter.Get("/product/:productId", adapt(controller.NewProductFindOneRestController(container)))
 	baseRouter.Delete("/product/:productId", adapt(controller.NewProductDeleteByIdRestController(container)))
 	baseRouter.Put("/product/:productId", adapt(controller.NewProductUpdateByIdController(container)))
+	baseRouter.Patch("/product/:productId", adapt(controller.NewProductPatchByIdRestController(container)))
+	baseRouter.Post("/product/:productId/restore", adapt(controller.NewProductRestoreRestController(container)))
+	baseRouter.Post("/product/:productId/images", adapt(controller.NewProductImageUploadRestController(container)))
+	baseRouter.Delete("/product/:productId/images/:imageId", adapt(controller.NewProductImageDeleteRestController(container)))
+	baseRouter.Get("/product/:productId/variants", adapt(controller.NewProductVariantFindRestController(container)))
+	baseRouter.Post("/product/:productId/variants", adapt(controller.NewProductVariantCreateRestController(container)))
+	baseRouter.Delete("/product/:productId/variants", adapt(controller.NewProductVariantDeleteRestController(container)))
+	baseRouter.Get("/product/:productId/variants/:variantId", adapt(controller.NewProductVariantFindOneRestController(container)))
+	baseRouter.Put("/product/:productId/variants/:variantId", adapt(controller.NewProductVariantUpdateRestController(container)))
+	baseRouter.Delete("/product/:productId/variants/:variantId", adapt(controller.NewProductVariantDeleteRestController(container)))
+	baseRouter.Put("/product/:productId/modifier-groups", adapt(controller.NewProductModifierGroupsRestController(container)))
+	baseRouter.Put("/product/:productId/components", adapt(controller.NewProductComponentsRestController(container)))
+	baseRouter.Get("/product/:productId/stock", adapt(controller.NewProductStockFindRestController(container)))
+	baseRouter.Put("/product/:productId/stock", adapt(controller.NewProductStockSaveRestController(container)))
+	baseRouter.Delete("/product/:productId/stock", adapt(controller.NewProductStockDeleteRestController(container)))
+	baseRouter.Post("/product/:productId/stock/increment", adapt(controller.NewProductStockIncrementRestController(container)))
+	baseRouter.Post("/product/:productId/stock/decrement", adapt(controller.NewProductStockDecrementRestController(container)))
+	baseRouter.Post("/product/:productId/stock/reserve", adapt(controller.NewProductStockReserveRestController(container)))
+	baseRouter.Post("/product/:productId/stock/release", adapt(controller.NewProductStockReleaseRestController(container)))
+	baseRouter.Get("/product/:productId/availability", adapt(controller.NewProductAvailabilityFindRestController(container)))
+	baseRouter.Post("/product/:productId/availability", adapt(controller.NewProductAvailabilityChangeRestController(container)))
+	baseRouter.Post("/product/:productId/price", adapt(controller.NewProductPriceRestController(container)))
+
+	//Category Routes
+	baseRouter.Post("/category", adapt(controller.NewCategoryCreateRestController(container)))
+	baseRouter.Get("/category", adapt(controller.NewCategoryFindRestController(container)))
+	baseRouter.Get("/category/:categoryId", adapt(controller.NewCategoryFindOneRestController(container)))
+	baseRouter.Put("/category/:categoryId", adapt(controller.NewCategoryUpdateByIdRestController(container)))
+	baseRouter.Delete("/category/:categoryId", adapt(controller.NewCategoryDeleteByIdRestController(container)))
+
+	//Modifier Group Routes
+	baseRouter.Post("/modifier-groups", adapt(controller.NewModifierGroupCreateRestController(container)))
+	baseRouter.Get("/modifier-groups", adapt(controller.NewModifierGroupFindRestController(container)))
+	baseRouter.Get("/modifier-groups/:groupId", adapt(controller.NewModifierGroupFindOneRestController(container)))
+	baseRouter.Put("/modifier-groups/:groupId", adapt(controller.NewModifierGroupUpdateRestController(container)))
+	baseRouter.Delete("/modifier-groups/:groupId", adapt(controller.NewModifierGroupDeleteRestController(container)))
+
+	//Daypart Routes
+	baseRouter.Post("/dayparts", adapt(controller.NewDaypartCreateRestController(container)))
+	baseRouter.Get("/dayparts", adapt(controller.NewDaypartFindRestController(container)))
+	baseRouter.Get("/dayparts/:daypartId", adapt(controller.NewDaypartFindOneRestController(container)))
+	baseRouter.Put("/dayparts/:daypartId", adapt(controller.NewDaypartUpdateRestController(container)))
+	baseRouter.Delete("/dayparts/:daypartId", adapt(controller.NewDaypartDeleteRestController(container)))
+
+	//Menu Routes
+	baseRouter.Get("/menu", adapt(controller.NewMenuFindRestController(container)))
+
+	//Reservation Routes
+	baseRouter.Post("/reservations", adapt(controller.NewReservationCreateRestController(container)))
+	baseRouter.Get("/reservations/:reservationId", adapt(controller.NewReservationFindRestController(container)))
+	baseRouter.Post("/reservations/:reservationId/confirm", adapt(controller.NewReservationConfirmRestController(container)))
+	baseRouter.Post("/reservations/:reservationId/release", adapt(controller.NewReservationReleaseRestController(container)))
+
+	//Webhook Routes
+	baseRouter.Post("/webhooks", adapt(controller.NewWebhookCreateRestController(container)))
+	baseRouter.Get("/webhooks", adapt(controller.NewWebhookFindRestController(container)))
+	baseRouter.Get("/webhooks/dead-letters", adapt(controller.NewWebhookDeadLetterRestController(container)))
+	baseRouter.Delete("/webhooks/:webhookId", adapt(controller.NewWebhookDeleteByIdRestController(container)))
+	baseRouter.Get("/webhooks/:webhookId/deliveries", adapt(controller.NewWebhookDeliveriesRestController(container)))
 
 	app.Use(middleware.NewNotFound())
 
@@ -50,6 +120,12 @@ func New(container *container.Container, config env.Config) *HTTPServer {
 
 }
 
+// bodyLimit raises the default limit of fiber when the images may be larger, leaving some room
+// for the multipart headers.
+func bodyLimit(config env.Config) int {
+	return max(fiber.DefaultBodyLimit, int(config.ImageMaxBytes)+64*1024)
+}
+
 func (server *HTTPServer) Listen() {
 	slog.InfoContext(context.Background(), fmt.Sprintf("Starting HTTP Server on port:%v", server.Config.Port))
 	err := server.Server.Listen(fmt.Sprintf(":%v", server.Config.Port))
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/env"
+	"github.com/tbtec/tremligeiro/internal/infra/container"
+	"github.com/tbtec/tremligeiro/internal/infra/database/model"
+)
+
+// drivers are the storage backends the end-to-end tests run against, none needs an external service.
+var drivers = []string{container.DriverMemory, container.DriverSQLite}
+
+func newTestContainer(t *testing.T, driver string) *container.Container {
+	t.Helper()
+
+	config := env.Config{
+		DbDriver:            driver,
+		StorageKind:         "local",
+		StorageLocalDir:     t.TempDir(),
+		StoragePublicUrl:    "http://localhost:8080/media",
+		ImageMaxBytes:       1 << 20,
+		ImageMaxPerProduct:  2,
+		ImageThumbnailSizes: []int{16, 32},
+		ReservationTtl:      time.Minute,
+	}
+	if driver == container.DriverSQLite {
+		config.SqlDsn = "file:" + filepath.Join(t.TempDir(), "tremligeiro.db")
+	}
+
+	c, err := container.New(config)
+	require.NoError(t, err)
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+
+	return c
+}
+
+func newTestServer(t *testing.T, driver string) *HTTPServer {
+	t.Helper()
+
+	c := newTestContainer(t, driver)
+
+	return New(c, c.Config)
+}
+
+func call(t *testing.T, server *HTTPServer, method string, path string, body string, headers map[string]string) (*http.Response, []byte) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = bytes.NewBufferString(body)
+	}
+
+	request := httptest.NewRequest(method, path, reader)
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+
+	response, err := server.Server.Test(request, -1)
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	return response, content
+}
+
+func createProduct(t *testing.T, server *HTTPServer, body string) dto.Product {
+	t.Helper()
+
+	response, content := call(t, server, http.MethodPost, "/api/v1/product", body, nil)
+	require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+	product := dto.Product{}
+	require.NoError(t, json.Unmarshal(content, &product))
+
+	return product
+}
+
+func TestServer_ProductLifecycle(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			product := createProduct(t, server, `{"name":"Açaí","description":"Tigela de açaí","categoryId":4,"amount":18.5}`)
+			assert.Equal(t, "Sobremesa", product.Category.Name)
+			assert.Equal(t, int64(1), product.Version)
+
+			response, content := call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			assert.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, `"1"`, response.Header.Get("ETag"))
+
+			response, content = call(t, server, http.MethodPatch, "/api/v1/product/"+product.ProductId, `{"amount":0,"description":null}`,
+				map[string]string{"Content-Type": "application/merge-patch+json", "If-Match": `"1"`})
+			assert.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+
+			patched := dto.Product{}
+			require.NoError(t, json.Unmarshal(content, &patched))
+			assert.Equal(t, 0.0, patched.Amount)
+			assert.Equal(t, "", patched.Description)
+
+			response, _ = call(t, server, http.MethodPut, "/api/v1/product/"+product.ProductId, `{"name":"Açaí","categoryId":4,"amount":20}`,
+				map[string]string{"If-Match": `"1"`})
+			assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/product/"+product.ProductId, "", nil)
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/product/"+product.ProductId, "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/trash", "", nil)
+			assert.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			trash := dto.ProductContent{}
+			require.NoError(t, json.Unmarshal(content, &trash))
+			require.Len(t, trash.Content, 1)
+			assert.NotNil(t, trash.Content[0].DeletedAt)
+
+			response, content = call(t, server, http.MethodPost, "/api/v1/product/"+product.ProductId+"/restore", "", nil)
+			assert.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			response, _ = call(t, server, http.MethodGet, "/api/v1/product/"+product.ProductId, "", nil)
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+		})
+	}
+}
+
+// TestServer_LegacyProductVersion writes products the way they were stored before versioning, at
+// version zero, and sends back the ETag they are read with.
+func TestServer_LegacyProductVersion(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			c := newTestContainer(t, driver)
+			server := New(c, c.Config)
+
+			now := time.Now().UTC()
+			for _, id := range []string{"legacy-put", "legacy-patch", "legacy-delete"} {
+				require.NoError(t, c.ProductRepository.Create(context.Background(), &model.Product{
+					ID: id, Name: "Pastel", Description: "Pastel de carne", CategoryId: 1, Amount: 8, CreatedAt: now, UpdatedAt: now,
+				}))
+			}
+
+			response, content := call(t, server, http.MethodGet, "/api/v1/product/legacy-put", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			etag := response.Header.Get("ETag")
+			assert.Equal(t, `"1"`, etag)
+
+			response, _ = call(t, server, http.MethodPut, "/api/v1/product/legacy-put", `{"name":"Pastel","categoryId":1,"amount":9}`,
+				map[string]string{"If-Match": `"0"`})
+			assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPut, "/api/v1/product/legacy-put", `{"name":"Pastel","categoryId":1,"amount":9}`,
+				map[string]string{"If-Match": etag})
+			assert.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+
+			response, _ = call(t, server, http.MethodPut, "/api/v1/product/legacy-put", `{"name":"Pastel","categoryId":1,"amount":10}`,
+				map[string]string{"If-Match": etag})
+			assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+			response, content = call(t, server, http.MethodPatch, "/api/v1/product/legacy-patch", `{"amount":9}`,
+				map[string]string{"Content-Type": "application/merge-patch+json", "If-Match": etag})
+			assert.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/product/legacy-delete", "", map[string]string{"If-Match": etag})
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+		})
+	}
+}
+
+func TestServer_ProductListing(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			createProduct(t, server, `{"name":"Pão de queijo","description":"Porção","categoryId":2,"amount":12}`)
+			createProduct(t, server, `{"name":"Batata frita","description":"Porção média","categoryId":2,"amount":9}`)
+			createProduct(t, server, `{"name":"Suco de laranja","description":"Natural","categoryId":3,"amount":8}`)
+
+			response, content := call(t, server, http.MethodGet, "/api/v1/product?categoryId=2&sort=amount&direction=desc&size=1", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			page := dto.ProductContent{}
+			require.NoError(t, json.Unmarshal(content, &page))
+			assert.Equal(t, int64(2), page.TotalElements)
+			assert.Equal(t, 2, page.TotalPages)
+			require.Len(t, page.Content, 1)
+			assert.Equal(t, "Pão de queijo", page.Content[0].Name)
+			assert.NotEmpty(t, page.Links.Next)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product?maxAmount=9", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &page))
+			assert.Equal(t, int64(2), page.TotalElements)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product?name=BAT&createdAfter=2000-01-01T00:00:00Z", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &page))
+			require.Len(t, page.Content, 1)
+			assert.Equal(t, "Batata frita", page.Content[0].Name)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product?createdAfter=2999-01-01T00:00:00Z", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			require.NoError(t, json.Unmarshal(content, &page))
+			assert.Equal(t, int64(0), page.TotalElements)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/product/search?q=pao", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			results := dto.ProductSearchContent{}
+			require.NoError(t, json.Unmarshal(content, &results))
+			require.Len(t, results.Content, 1)
+			assert.Equal(t, "Pão de queijo", results.Content[0].Name)
+		})
+	}
+}
+
+func TestServer_CategoryCatalog(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			server := newTestServer(t, driver)
+
+			response, content := call(t, server, http.MethodPost, "/api/v1/category", `{"name":"Combo"}`, nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+
+			category := dto.Category{}
+			require.NoError(t, json.Unmarshal(content, &category))
+			assert.Equal(t, 5, category.ID)
+
+			createProduct(t, server, `{"name":"Combo 1","description":"Lanche, batata e bebida","categoryId":5,"amount":30}`)
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/category/5", "", nil)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+			response, _ = call(t, server, http.MethodGet, "/api/v1/category/42", "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+		})
+	}
+}
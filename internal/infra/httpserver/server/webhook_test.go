@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/dto"
+	"github.com/tbtec/tremligeiro/internal/infra/httpclient"
+	"github.com/tbtec/tremligeiro/internal/infra/job"
+	"github.com/tbtec/tremligeiro/internal/infra/publisher"
+)
+
+// webhookReceiver answers with status, recording the deliveries whose signature matches secret.
+type webhookReceiver struct {
+	mutex    sync.Mutex
+	secret   string
+	status   int
+	received []dto.CloudEvent
+	invalid  int
+}
+
+func (receiver *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	unix, _ := strconv.ParseInt(r.Header.Get(usecase.WebhookTimestampHeader), 10, 64)
+
+	if r.Header.Get(usecase.WebhookSignatureHeader) != entity.SignWebhookPayload(receiver.secret, time.Unix(unix, 0), body) ||
+		r.Header.Get("Content-Type") != dto.CloudEventContentType {
+		receiver.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	event := dto.CloudEvent{}
+	_ = json.Unmarshal(body, &event)
+	receiver.received = append(receiver.received, event)
+
+	w.WriteHeader(receiver.status)
+}
+
+func TestServer_Webhooks(t *testing.T) {
+	for _, driver := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			c := newTestContainer(t, driver)
+			server := New(c, c.Config)
+			ctx := context.Background()
+
+			partner := &webhookReceiver{secret: "partner-kiosk-secret", status: http.StatusNoContent}
+			partnerServer := httptest.NewServer(partner)
+			defer partnerServer.Close()
+			broken := &webhookReceiver{secret: "broken-kiosk-secret", status: http.StatusServiceUnavailable}
+			brokenServer := httptest.NewServer(broken)
+			defer brokenServer.Close()
+
+			response, _ := call(t, server, http.MethodPost, "/api/v1/webhooks",
+				`{"url":"`+partnerServer.URL+`","eventTypes":["ProductSold"],"secret":"partner-kiosk-secret"}`, nil)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+			response, content := call(t, server, http.MethodPost, "/api/v1/webhooks",
+				`{"url":"`+partnerServer.URL+`","eventTypes":["ProductPriceChanged","ProductCreated","ProductCreated"],"secret":"partner-kiosk-secret"}`, nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			webhook := dto.Webhook{}
+			require.NoError(t, json.Unmarshal(content, &webhook))
+			assert.Equal(t, []string{"ProductPriceChanged", "ProductCreated"}, webhook.EventTypes)
+			assert.NotContains(t, string(content), "partner-kiosk-secret")
+
+			response, content = call(t, server, http.MethodPost, "/api/v1/webhooks",
+				`{"url":"`+brokenServer.URL+`","eventTypes":["ProductCreated"],"secret":"broken-kiosk-secret"}`, nil)
+			require.Equal(t, http.StatusCreated, response.StatusCode, string(content))
+			brokenWebhook := dto.Webhook{}
+			require.NoError(t, json.Unmarshal(content, &brokenWebhook))
+
+			product := createProduct(t, server, `{"name":"Pastel","description":"Pastel de queijo","categoryId":1,"amount":10}`)
+			response, content = call(t, server, http.MethodPatch, "/api/v1/product/"+product.ProductId, `{"description":"Pastel de queijo e orégano"}`,
+				map[string]string{"Content-Type": "application/merge-patch+json"})
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+
+			relay := job.NewOutboxRelayJob(c.OutboxRepository, c.WebhookRepository, publisher.NewLogPublisher(), 10, time.Hour, c.Clock)
+			require.NoError(t, relay.Run(ctx))
+			require.NoError(t, relay.Run(ctx))
+
+			deliveries := func(webhookId string) dto.WebhookDeliveryContent {
+				response, content := call(t, server, http.MethodGet, "/api/v1/webhooks/"+webhookId+"/deliveries", "", nil)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+				result := dto.WebhookDeliveryContent{}
+				require.NoError(t, json.Unmarshal(content, &result))
+				return result
+			}
+
+			pending := deliveries(webhook.ID)
+			require.Len(t, pending.Content, 1, "only the creation is subscribed, relaying twice schedules once")
+			assert.Equal(t, "PENDING", pending.Content[0].Status)
+			assert.Equal(t, "ProductCreated", pending.Content[0].EventType)
+			assert.NotNil(t, pending.Content[0].NextAttemptAt)
+
+			policy := entity.WebhookRetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+			delivery := job.NewWebhookDeliveryJob(c.WebhookRepository, httpclient.NewWebhookSender(time.Second), policy, 10, time.Hour, c.Clock)
+			require.NoError(t, delivery.Run(ctx))
+
+			require.Len(t, partner.received, 1)
+			assert.Equal(t, 0, partner.invalid)
+			assert.Equal(t, "com.tbtec.tremligeiro.ProductCreated.v1", partner.received[0].Type)
+			assert.Equal(t, product.ProductId, partner.received[0].Subject)
+
+			delivered := deliveries(webhook.ID)
+			require.Len(t, delivered.Content, 1)
+			assert.Equal(t, "DELIVERED", delivered.Content[0].Status)
+			assert.Equal(t, 1, delivered.Content[0].Attempts)
+			assert.Equal(t, http.StatusNoContent, delivered.Content[0].LastStatusCode)
+			assert.NotNil(t, delivered.Content[0].DeliveredAt)
+			assert.Nil(t, delivered.Content[0].NextAttemptAt)
+
+			retrying := deliveries(brokenWebhook.ID)
+			require.Len(t, retrying.Content, 1)
+			assert.Equal(t, "PENDING", retrying.Content[0].Status)
+			assert.Equal(t, 1, retrying.Content[0].Attempts)
+			assert.Equal(t, http.StatusServiceUnavailable, retrying.Content[0].LastStatusCode)
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/webhooks/dead-letters", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.JSONEq(t, `{"content":[]}`, string(content))
+
+			time.Sleep(5 * time.Millisecond)
+			require.NoError(t, delivery.Run(ctx))
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/webhooks/dead-letters", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			dead := dto.WebhookDeliveryContent{}
+			require.NoError(t, json.Unmarshal(content, &dead))
+			require.Len(t, dead.Content, 1)
+			assert.Equal(t, brokenWebhook.ID, dead.Content[0].WebhookId)
+			assert.Equal(t, "DEAD", dead.Content[0].Status)
+			assert.Equal(t, 2, dead.Content[0].Attempts)
+			assert.NotEmpty(t, dead.Content[0].LastError)
+			assert.Len(t, partner.received, 1)
+
+			response, _ = call(t, server, http.MethodDelete, "/api/v1/webhooks/"+brokenWebhook.ID, "", nil)
+			assert.Equal(t, http.StatusNoContent, response.StatusCode)
+			response, _ = call(t, server, http.MethodGet, "/api/v1/webhooks/"+brokenWebhook.ID+"/deliveries", "", nil)
+			assert.Equal(t, http.StatusNotFound, response.StatusCode)
+			response, content = call(t, server, http.MethodGet, "/api/v1/webhooks/dead-letters", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			assert.JSONEq(t, `{"content":[]}`, string(content))
+
+			response, content = call(t, server, http.MethodGet, "/api/v1/webhooks", "", nil)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(content))
+			webhooks := dto.WebhookContent{}
+			require.NoError(t, json.Unmarshal(content, &webhooks))
+			require.Len(t, webhooks.Content, 1)
+			assert.Equal(t, webhook.ID, webhooks.Content[0].ID)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+// OutboxRelayJob publishes the pending outbox events in batches until none is left or one fails,
+// scheduling them to the subscribed webhooks, then removes the events published for longer than the retention.
+type OutboxRelayJob struct {
+	usc       *usecase.UscRelayProductEvents
+	batchSize int
+	retention time.Duration
+}
+
+func NewOutboxRelayJob(outboxRepository repository.IOutboxRepository, webhookRepository repository.IWebhookRepository,
+	eventPublisher gateway.IEventPublisher, batchSize int, retention time.Duration, clock clock.Clock) *OutboxRelayJob {
+	return &OutboxRelayJob{
+		usc: usecase.NewUseCaseRelayProductEvents(
+			gateway.NewProductEventGateway(nil, outboxRepository),
+			gateway.NewWebhookGateway(webhookRepository),
+			eventPublisher,
+			presenter.NewEventPresenter(),
+			clock,
+		),
+		batchSize: max(batchSize, 1),
+		retention: retention,
+	}
+}
+
+func (job *OutboxRelayJob) Name() string {
+	return "outbox-relay"
+}
+
+func (job *OutboxRelayJob) Run(ctx context.Context) error {
+	for {
+		published, failed, err := job.usc.Relay(ctx, job.batchSize)
+		if err != nil {
+			return err
+		}
+		if failed > 0 || published < job.batchSize {
+			break
+		}
+	}
+
+	if job.retention <= 0 {
+		return nil
+	}
+
+	purged, err := job.usc.PurgePublished(ctx, job.retention)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		slog.InfoContext(ctx, fmt.Sprintf("Purged %d published events", purged))
+	}
+
+	return nil
+}
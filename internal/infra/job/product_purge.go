@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+// ProductPurgeJob permanently removes the products kept in the trash for longer than the retention.
+type ProductPurgeJob struct {
+	usc       *usecase.UscPurgeProduct
+	retention time.Duration
+}
+
+func NewProductPurgeJob(productRepository repository.IProductRepository, retention time.Duration, clock clock.Clock) *ProductPurgeJob {
+	return &ProductPurgeJob{
+		usc:       usecase.NewUseCasePurgeProduct(gateway.NewProductGateway(productRepository, nil, clock), clock),
+		retention: retention,
+	}
+}
+
+func (job *ProductPurgeJob) Name() string {
+	return "product-purge"
+}
+
+func (job *ProductPurgeJob) Run(ctx context.Context) error {
+	purged, err := job.usc.PurgeDeleted(ctx, job.retention)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		slog.InfoContext(ctx, fmt.Sprintf("Purged %d deleted products", purged))
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/core/presenter"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+// ReservationSweepJob releases the reservations past their expiry in batches until none is left,
+// so the stock held by abandoned carts is for sale again.
+type ReservationSweepJob struct {
+	usc       *usecase.UscReleaseReservation
+	batchSize int
+}
+
+func NewReservationSweepJob(reservationRepository repository.IReservationRepository, stockRepository repository.IStockRepository,
+	transactor repository.ITransactor, batchSize int, clock clock.Clock) *ReservationSweepJob {
+	return &ReservationSweepJob{
+		usc: usecase.NewUseCaseReleaseReservation(
+			gateway.NewReservationGateway(reservationRepository),
+			gateway.NewStockGateway(stockRepository, clock),
+			gateway.NewProductEventGateway(transactor, nil),
+			presenter.NewReservationPresenter(),
+			clock,
+		),
+		batchSize: max(batchSize, 1),
+	}
+}
+
+func (job *ReservationSweepJob) Name() string {
+	return "reservation-sweep"
+}
+
+func (job *ReservationSweepJob) Run(ctx context.Context) error {
+	total := 0
+
+	for {
+		expired, err := job.usc.Expire(ctx, job.batchSize)
+		total += expired
+		if err != nil {
+			return err
+		}
+		if expired < job.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.InfoContext(ctx, fmt.Sprintf("Released %d expired reservations", total))
+	}
+
+	return nil
+}
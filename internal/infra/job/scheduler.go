@@ -0,0 +1,76 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job is a unit of background work run periodically by the Scheduler.
+type Job interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+type entry struct {
+	job      Job
+	interval time.Duration
+}
+
+// Scheduler runs every registered job on its own goroutine until stopped.
+type Scheduler struct {
+	entries []entry
+	cancel  context.CancelFunc
+	running sync.WaitGroup
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers the job to run right after Start and then once per interval.
+// A non positive interval disables the job.
+func (scheduler *Scheduler) Every(interval time.Duration, job Job) {
+	if interval <= 0 {
+		slog.Info("Job disabled: " + job.Name())
+		return
+	}
+	scheduler.entries = append(scheduler.entries, entry{job: job, interval: interval})
+}
+
+func (scheduler *Scheduler) Start(ctx context.Context) {
+	ctx, scheduler.cancel = context.WithCancel(ctx)
+
+	for _, e := range scheduler.entries {
+		scheduler.running.Add(1)
+		go scheduler.loop(ctx, e)
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return.
+func (scheduler *Scheduler) Stop() {
+	if scheduler.cancel != nil {
+		scheduler.cancel()
+	}
+	scheduler.running.Wait()
+}
+
+func (scheduler *Scheduler) loop(ctx context.Context, e entry) {
+	defer scheduler.running.Done()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.job.Run(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Job failed: "+e.job.Name(), slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
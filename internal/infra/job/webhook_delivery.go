@@ -0,0 +1,69 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
+	"github.com/tbtec/tremligeiro/internal/core/domain/usecase"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/infra/database/repository"
+	"github.com/tbtec/tremligeiro/internal/types/clock"
+)
+
+// WebhookDeliveryJob sends the due webhook deliveries in batches until none is left,
+// then removes the deliveries that succeeded longer than the retention ago.
+type WebhookDeliveryJob struct {
+	usc       *usecase.UscDeliverWebhooks
+	batchSize int
+	retention time.Duration
+}
+
+func NewWebhookDeliveryJob(webhookRepository repository.IWebhookRepository, webhookSender gateway.IWebhookSender,
+	retryPolicy entity.WebhookRetryPolicy, batchSize int, retention time.Duration, clock clock.Clock) *WebhookDeliveryJob {
+	return &WebhookDeliveryJob{
+		usc: usecase.NewUseCaseDeliverWebhooks(
+			gateway.NewWebhookGateway(webhookRepository),
+			webhookSender,
+			retryPolicy,
+			clock,
+		),
+		batchSize: max(batchSize, 1),
+		retention: retention,
+	}
+}
+
+func (job *WebhookDeliveryJob) Name() string {
+	return "webhook-delivery"
+}
+
+// Run stops on a short batch: failed deliveries are rescheduled to later, so a full batch
+// always means more deliveries may be due.
+func (job *WebhookDeliveryJob) Run(ctx context.Context) error {
+	for {
+		delivered, failed, err := job.usc.Deliver(ctx, job.batchSize)
+		if err != nil {
+			return err
+		}
+		if delivered+failed < job.batchSize {
+			break
+		}
+	}
+
+	if job.retention <= 0 {
+		return nil
+	}
+
+	purged, err := job.usc.PurgeDelivered(ctx, job.retention)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		slog.InfoContext(ctx, fmt.Sprintf("Purged %d webhook deliveries", purged))
+	}
+
+	return nil
+}
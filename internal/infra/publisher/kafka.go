@@ -0,0 +1,59 @@
+package publisher
+
+import (
+	"context"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+// KafkaPublisher writes every event to one topic, keyed by product id so that each product's
+// events land in the same partition, in order. It waits for every in-sync replica.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaPublisher(brokers []string, topic string) gateway.IEventPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			// the relay publishes one event at a time, do not wait for a batch to fill
+			BatchSize:    1,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+func (publisher *KafkaPublisher) Publish(ctx context.Context, event dto.CloudEvent) error {
+	message, err := kafkaMessage(event)
+	if err != nil {
+		return err
+	}
+
+	return publisher.writer.WriteMessages(ctx, message)
+}
+
+func (publisher *KafkaPublisher) Close() error {
+	return publisher.writer.Close()
+}
+
+// kafkaMessage uses the CloudEvents structured mode, the whole envelope is the value.
+func kafkaMessage(event dto.CloudEvent) (kafka.Message, error) {
+	body, err := encode(event)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	return kafka.Message{
+		Key:   []byte(event.Subject),
+		Value: []byte(body),
+		Headers: []kafka.Header{
+			{Key: "content-type", Value: []byte(dto.CloudEventContentType)},
+		},
+	}, nil
+}
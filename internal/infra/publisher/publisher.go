@@ -0,0 +1,112 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+const (
+	KindLog   = "log"
+	KindSNS   = "sns"
+	KindSQS   = "sqs"
+	KindKafka = "kafka"
+)
+
+// PublisherConf selects the publisher with Kind. An empty AwsEndpointUrl uses the AWS endpoints,
+// set it to a stand-in such as LocalStack for local runs.
+type PublisherConf struct {
+	Kind           string
+	AwsRegion      string
+	AwsEndpointUrl string
+	SnsTopicArn    string
+	SqsQueueUrl    string
+	KafkaBrokers   []string
+	KafkaTopic     string
+}
+
+// New builds the publisher selected by conf.Kind, logging the events when none is set.
+// Publishers holding connections implement io.Closer.
+func New(ctx context.Context, conf PublisherConf) (gateway.IEventPublisher, error) {
+	slog.InfoContext(ctx, "Event publisher: "+conf.Kind)
+
+	switch conf.Kind {
+	case "", KindLog:
+		return NewLogPublisher(), nil
+	case KindSNS:
+		cfg, err := awsConfig(ctx, conf)
+		if err != nil {
+			return nil, err
+		}
+		return NewSNSPublisher(cfg, conf.SnsTopicArn), nil
+	case KindSQS:
+		cfg, err := awsConfig(ctx, conf)
+		if err != nil {
+			return nil, err
+		}
+		return NewSQSPublisher(cfg, conf.SqsQueueUrl), nil
+	case KindKafka:
+		return NewKafkaPublisher(conf.KafkaBrokers, conf.KafkaTopic), nil
+	default:
+		return nil, fmt.Errorf("unsupported EVENT_PUBLISHER %q", conf.Kind)
+	}
+}
+
+// Close releases the publisher connections, if it holds any.
+func Close(publisher gateway.IEventPublisher) error {
+	if closer, ok := publisher.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// awsConfig reads the credentials from the default chain: environment, shared files or the pod role.
+func awsConfig(ctx context.Context, conf PublisherConf) (aws.Config, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(conf.AwsRegion))
+	if err != nil {
+		return cfg, err
+	}
+
+	if conf.AwsEndpointUrl != "" {
+		cfg.BaseEndpoint = aws.String(conf.AwsEndpointUrl)
+	}
+
+	return cfg, nil
+}
+
+// isFifo tells whether the SNS topic or SQS queue is FIFO, requiring a message group.
+func isFifo(target string) bool {
+	return strings.HasSuffix(target, ".fifo")
+}
+
+func encode(event dto.CloudEvent) (string, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// LogPublisher only logs the events, for local runs without a broker.
+type LogPublisher struct{}
+
+func NewLogPublisher() gateway.IEventPublisher {
+	return &LogPublisher{}
+}
+
+func (publisher *LogPublisher) Publish(ctx context.Context, event dto.CloudEvent) error {
+	slog.InfoContext(ctx, "Event published",
+		slog.String("id", event.ID),
+		slog.String("type", event.Type),
+		slog.String("subject", event.Subject))
+
+	return nil
+}
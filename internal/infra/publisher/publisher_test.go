@@ -0,0 +1,146 @@
+package publisher
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+func testEvent() dto.CloudEvent {
+	return dto.CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              "event-1",
+		Source:          "/tremligeiro/product",
+		Type:            "com.tbtec.tremligeiro.ProductPriceChanged.v1",
+		Subject:         "prod-1",
+		Time:            time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		DataContentType: "application/json",
+		Data:            json.RawMessage(`{"id":"prod-1","amount":12.5,"previousAmount":10}`),
+	}
+}
+
+// testAwsConfig points the clients to a stand-in, the way AWS_ENDPOINT_URL points them to LocalStack.
+func testAwsConfig(endpoint string) aws.Config {
+	return aws.Config{
+		Region:       "us-east-1",
+		Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+		BaseEndpoint: aws.String(endpoint),
+	}
+}
+
+func assertEnvelope(t *testing.T, body string) {
+	t.Helper()
+
+	published := dto.CloudEvent{}
+	require.NoError(t, json.Unmarshal([]byte(body), &published))
+	assert.Equal(t, testEvent().ID, published.ID)
+	assert.Equal(t, testEvent().Type, published.Type)
+	assert.JSONEq(t, string(testEvent().Data), string(published.Data))
+}
+
+func TestSNSPublisher_Publish(t *testing.T) {
+	tests := []struct {
+		name     string
+		topicArn string
+		group    string
+	}{
+		{name: "standard topic", topicArn: "arn:aws:sns:us-east-1:000000000000:product-events"},
+		{name: "fifo topic", topicArn: "arn:aws:sns:us-east-1:000000000000:product-events.fifo", group: "prod-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var form url.Values
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, r.ParseForm())
+				form = r.PostForm
+				w.Header().Set("Content-Type", "text/xml")
+				_, _ = io.WriteString(w, `<PublishResponse><PublishResult><MessageId>1</MessageId></PublishResult></PublishResponse>`)
+			}))
+			defer server.Close()
+
+			err := NewSNSPublisher(testAwsConfig(server.URL), tt.topicArn).Publish(context.Background(), testEvent())
+			require.NoError(t, err)
+
+			assert.Equal(t, "Publish", form.Get("Action"))
+			assert.Equal(t, tt.topicArn, form.Get("TopicArn"))
+			assertEnvelope(t, form.Get("Message"))
+			assert.Equal(t, tt.group, form.Get("MessageGroupId"))
+
+			attributes := map[string]string{}
+			for i := 1; form.Has(fmt.Sprintf("MessageAttributes.entry.%d.Name", i)); i++ {
+				prefix := fmt.Sprintf("MessageAttributes.entry.%d", i)
+				attributes[form.Get(prefix+".Name")] = form.Get(prefix + ".Value.StringValue")
+			}
+			assert.Equal(t, testEvent().Type, attributes["ce_type"])
+			assert.Equal(t, dto.CloudEventContentType, attributes["content-type"])
+		})
+	}
+}
+
+func TestSNSPublisher_Publish_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `<ErrorResponse><Error><Type>Sender</Type><Code>NotFound</Code><Message>Topic does not exist</Message></Error></ErrorResponse>`)
+	}))
+	defer server.Close()
+
+	err := NewSNSPublisher(testAwsConfig(server.URL), "arn:aws:sns:us-east-1:000000000000:missing").Publish(context.Background(), testEvent())
+
+	assert.ErrorContains(t, err, "Topic does not exist")
+}
+
+func TestSQSPublisher_Publish(t *testing.T) {
+	queueUrl := "http://sqs.us-east-1.localhost/000000000000/product-events.fifo"
+
+	request := map[string]any{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		checksum := md5.Sum([]byte(request["MessageBody"].(string)))
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"MessageId":        "1",
+			"MD5OfMessageBody": hex.EncodeToString(checksum[:]),
+		})
+	}))
+	defer server.Close()
+
+	err := NewSQSPublisher(testAwsConfig(server.URL), queueUrl).Publish(context.Background(), testEvent())
+	require.NoError(t, err)
+
+	assert.Equal(t, queueUrl, request["QueueUrl"])
+	assertEnvelope(t, request["MessageBody"].(string))
+	assert.Equal(t, "prod-1", request["MessageGroupId"])
+	assert.Equal(t, "event-1", request["MessageDeduplicationId"])
+}
+
+func TestKafkaMessage(t *testing.T) {
+	message, err := kafkaMessage(testEvent())
+	require.NoError(t, err)
+
+	assert.Equal(t, "prod-1", string(message.Key))
+	assertEnvelope(t, string(message.Value))
+	require.Len(t, message.Headers, 1)
+	assert.Equal(t, dto.CloudEventContentType, string(message.Headers[0].Value))
+}
+
+func TestNew_UnsupportedKind(t *testing.T) {
+	_, err := New(context.Background(), PublisherConf{Kind: "carrier-pigeon"})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+// SNSPublisher publishes every event to one topic. The ce_type attribute lets subscriptions
+// filter the event types, on FIFO topics the product id keeps each product's events in order.
+type SNSPublisher struct {
+	client   *sns.Client
+	topicArn string
+}
+
+func NewSNSPublisher(cfg aws.Config, topicArn string) gateway.IEventPublisher {
+	return &SNSPublisher{
+		client:   sns.NewFromConfig(cfg),
+		topicArn: topicArn,
+	}
+}
+
+func (publisher *SNSPublisher) Publish(ctx context.Context, event dto.CloudEvent) error {
+	body, err := encode(event)
+	if err != nil {
+		return err
+	}
+
+	input := &sns.PublishInput{
+		TopicArn: aws.String(publisher.topicArn),
+		Message:  aws.String(body),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"content-type": {DataType: aws.String("String"), StringValue: aws.String(dto.CloudEventContentType)},
+			"ce_type":      {DataType: aws.String("String"), StringValue: aws.String(event.Type)},
+		},
+	}
+	if isFifo(publisher.topicArn) {
+		input.MessageGroupId = aws.String(event.Subject)
+		input.MessageDeduplicationId = aws.String(event.ID)
+	}
+
+	_, err = publisher.client.Publish(ctx, input)
+
+	return err
+}
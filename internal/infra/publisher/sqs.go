@@ -0,0 +1,49 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+	"github.com/tbtec/tremligeiro/internal/dto"
+)
+
+// SQSPublisher sends every event straight to one queue, on FIFO queues the product id
+// keeps each product's events in order.
+type SQSPublisher struct {
+	client   *sqs.Client
+	queueUrl string
+}
+
+func NewSQSPublisher(cfg aws.Config, queueUrl string) gateway.IEventPublisher {
+	return &SQSPublisher{
+		client:   sqs.NewFromConfig(cfg),
+		queueUrl: queueUrl,
+	}
+}
+
+func (publisher *SQSPublisher) Publish(ctx context.Context, event dto.CloudEvent) error {
+	body, err := encode(event)
+	if err != nil {
+		return err
+	}
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(publisher.queueUrl),
+		MessageBody: aws.String(body),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"content-type": {DataType: aws.String("String"), StringValue: aws.String(dto.CloudEventContentType)},
+			"ce_type":      {DataType: aws.String("String"), StringValue: aws.String(event.Type)},
+		},
+	}
+	if isFifo(publisher.queueUrl) {
+		input.MessageGroupId = aws.String(event.Subject)
+		input.MessageDeduplicationId = aws.String(event.ID)
+	}
+
+	_, err = publisher.client.SendMessage(ctx, input)
+
+	return err
+}
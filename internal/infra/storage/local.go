@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+// LocalStorage keeps the objects as files under a directory, for local runs and single
+// instances. The HTTP server serves the directory at the public url.
+type LocalStorage struct {
+	dir       string
+	publicUrl string
+}
+
+func NewLocalStorage(dir string, publicUrl string) gateway.IImageStorage {
+	return &LocalStorage{
+		dir:       dir,
+		publicUrl: strings.TrimSuffix(publicUrl, "/"),
+	}
+}
+
+func (storage *LocalStorage) Put(ctx context.Context, key string, contentType string, content []byte) error {
+	path, err := storage.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
+
+// Delete ignores the objects already gone.
+func (storage *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := storage.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (storage *LocalStorage) URL(key string) string {
+	return storage.publicUrl + "/" + key
+}
+
+// path rejects the keys escaping the directory.
+func (storage *LocalStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", errors.New("invalid storage key " + key)
+	}
+	return filepath.Join(storage.dir, key), nil
+}
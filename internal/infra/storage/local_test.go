@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewLocalStorage(dir, "http://localhost:8080/media/")
+	ctx := context.Background()
+
+	require.NoError(t, storage.Put(ctx, "products/p1/i1/original.png", "image/png", []byte("png")))
+	content, err := os.ReadFile(filepath.Join(dir, "products", "p1", "i1", "original.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "png", string(content))
+	assert.Equal(t, "http://localhost:8080/media/products/p1/i1/original.png", storage.URL("products/p1/i1/original.png"))
+
+	require.NoError(t, storage.Delete(ctx, "products/p1/i1/original.png"))
+	require.NoError(t, storage.Delete(ctx, "products/p1/i1/original.png"))
+	_, err = os.Stat(filepath.Join(dir, "products", "p1", "i1", "original.png"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	assert.Error(t, storage.Put(ctx, "../outside.png", "image/png", []byte("png")))
+}
+
+func TestNew_UnsupportedKind(t *testing.T) {
+	_, err := New(context.Background(), StorageConf{Kind: "ftp"})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+// S3Storage keeps the objects in a bucket, which must allow public reads of them
+// unless a CDN in front of it serves the public url.
+type S3Storage struct {
+	client    *s3.Client
+	bucket    string
+	publicUrl string
+}
+
+func NewS3Storage(cfg aws.Config, bucket string, usePathStyle bool, publicUrl string) gateway.IImageStorage {
+	if publicUrl == "" {
+		publicUrl = bucketUrl(cfg, bucket, usePathStyle)
+	}
+
+	return &S3Storage{
+		client: s3.NewFromConfig(cfg, func(options *s3.Options) {
+			options.UsePathStyle = usePathStyle
+		}),
+		bucket:    bucket,
+		publicUrl: strings.TrimSuffix(publicUrl, "/"),
+	}
+}
+
+// Put marks the objects immutable for the caches, a new upload always gets new keys.
+func (storage *S3Storage) Put(ctx context.Context, key string, contentType string, content []byte) error {
+	_, err := storage.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:       aws.String(storage.bucket),
+		Key:          aws.String(key),
+		Body:         bytes.NewReader(content),
+		ContentType:  aws.String(contentType),
+		CacheControl: aws.String("public, max-age=31536000, immutable"),
+	})
+
+	return err
+}
+
+func (storage *S3Storage) Delete(ctx context.Context, key string) error {
+	_, err := storage.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(storage.bucket),
+		Key:    aws.String(key),
+	})
+
+	return err
+}
+
+func (storage *S3Storage) URL(key string) string {
+	return storage.publicUrl + "/" + key
+}
+
+func bucketUrl(cfg aws.Config, bucket string, usePathStyle bool) string {
+	if cfg.BaseEndpoint != nil {
+		endpoint := strings.TrimSuffix(*cfg.BaseEndpoint, "/")
+		if usePathStyle {
+			return endpoint + "/" + bucket
+		}
+		scheme, host, _ := strings.Cut(endpoint, "://")
+		return scheme + "://" + bucket + "." + host
+	}
+
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, cfg.Region)
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/tbtec/tremligeiro/internal/core/gateway"
+)
+
+const (
+	KindLocal = "local"
+	KindS3    = "s3"
+)
+
+// StorageConf selects the storage with Kind. PublicUrl is the base of the image urls,
+// for S3 it defaults to the bucket url. An empty S3EndpointUrl uses AWS, set it and
+// S3UsePathStyle for MinIO or another S3-compatible server.
+type StorageConf struct {
+	Kind           string
+	LocalDir       string
+	PublicUrl      string
+	AwsRegion      string
+	S3EndpointUrl  string
+	S3Bucket       string
+	S3UsePathStyle bool
+}
+
+// New builds the storage selected by conf.Kind, the local directory when none is set.
+func New(ctx context.Context, conf StorageConf) (gateway.IImageStorage, error) {
+	slog.InfoContext(ctx, "Image storage: "+conf.Kind)
+
+	switch conf.Kind {
+	case "", KindLocal:
+		return NewLocalStorage(conf.LocalDir, conf.PublicUrl), nil
+	case KindS3:
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(conf.AwsRegion))
+		if err != nil {
+			return nil, err
+		}
+		if conf.S3EndpointUrl != "" {
+			cfg.BaseEndpoint = aws.String(conf.S3EndpointUrl)
+		}
+		return NewS3Storage(cfg, conf.S3Bucket, conf.S3UsePathStyle, conf.PublicUrl), nil
+	default:
+		return nil, fmt.Errorf("unsupported STORAGE_KIND %q", conf.Kind)
+	}
+}
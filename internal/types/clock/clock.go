@@ -0,0 +1,27 @@
+// Package clock tells the time to the use cases, so the tests can choose it.
+package clock
+
+import "time"
+
+// Clock returns the current instant. The zero Clock reads the system clock.
+type Clock func() time.Time
+
+// System reads the system clock in UTC.
+func System() time.Time {
+	return time.Now().UTC()
+}
+
+// Fixed always returns the given instant.
+func Fixed(now time.Time) Clock {
+	return func() time.Time {
+		return now
+	}
+}
+
+// Now returns the current instant in UTC.
+func (clock Clock) Now() time.Time {
+	if clock == nil {
+		return System()
+	}
+	return clock().UTC()
+}
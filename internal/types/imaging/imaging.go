@@ -0,0 +1,73 @@
+// Package imaging decodes the uploaded pictures and scales them down into thumbnails.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// MaxPixels bounds the images decoded, a small file can declare a huge picture.
+const MaxPixels = 40_000_000
+
+var ErrUnsupportedImage = errors.New("unsupported image")
+
+// Image is a decoded picture.
+type Image = image.Image
+
+// ContentTypes are the image formats decoded, by content type.
+var ContentTypes = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+// Decode reads a JPEG, PNG or WebP image, returning it along with its content type.
+func Decode(content []byte) (image.Image, string, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, "", ErrUnsupportedImage
+	}
+	if config.Width*config.Height > MaxPixels {
+		return nil, "", ErrUnsupportedImage
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, "", ErrUnsupportedImage
+	}
+
+	for contentType, name := range ContentTypes {
+		if name == format {
+			return img, contentType, nil
+		}
+	}
+
+	return nil, "", ErrUnsupportedImage
+}
+
+// Scale resizes the image to width by height pixels.
+func Scale(img image.Image, width int, height int) image.Image {
+	scaled := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), img, img.Bounds(), draw.Over, nil)
+	return scaled
+}
+
+// Encode writes a JPEG image back as JPEG and any other as PNG, which keeps the transparency.
+// It returns the encoded image with its content type.
+func Encode(img image.Image, contentType string) ([]byte, string, error) {
+	buffer := bytes.Buffer{}
+
+	if contentType == "image/jpeg" {
+		err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: 85})
+		return buffer.Bytes(), "image/jpeg", err
+	}
+
+	err := png.Encode(&buffer, img)
+	return buffer.Bytes(), "image/png", err
+}
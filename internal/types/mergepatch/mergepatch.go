@@ -0,0 +1,49 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ContentType is the media type of a JSON Merge Patch document.
+const ContentType = "application/merge-patch+json"
+
+var ErrInvalidPatch = errors.New("invalid merge patch document")
+
+// Apply merges the patch into the JSON document following RFC 7396:
+// members set to null are removed, objects are merged recursively and any other value replaces the target.
+func Apply(document []byte, patch []byte) ([]byte, error) {
+	var target any
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+
+	var changes any
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return nil, ErrInvalidPatch
+	}
+
+	return json.Marshal(merge(target, changes))
+}
+
+func merge(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = merge(targetObject[name], value)
+	}
+
+	return targetObject
+}
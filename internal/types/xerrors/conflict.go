@@ -0,0 +1,18 @@
+package xerrors
+
+// ConflictError reports that a resource changed since the client last read it.
+type ConflictError struct {
+	Description string
+	Code        string
+}
+
+func (e ConflictError) Error() string {
+	return "Conflict - " + e.Code + " - " + e.Description
+}
+
+func NewConflictError(code string, desc string) ConflictError {
+	return ConflictError{
+		Code:        code,
+		Description: desc,
+	}
+}
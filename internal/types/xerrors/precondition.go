@@ -0,0 +1,18 @@
+package xerrors
+
+// PreconditionFailedError reports that a precondition the client sent, as If-Match, does not hold.
+type PreconditionFailedError struct {
+	Description string
+	Code        string
+}
+
+func (e PreconditionFailedError) Error() string {
+	return "Precondition Failed - " + e.Code + " - " + e.Description
+}
+
+func NewPreconditionFailedError(code string, desc string) PreconditionFailedError {
+	return PreconditionFailedError{
+		Code:        code,
+		Description: desc,
+	}
+}
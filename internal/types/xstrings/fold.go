@@ -0,0 +1,101 @@
+package xstrings
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Fold lowercases the text and strips its diacritics, so "Açaí" and "acai" compare equal.
+func Fold(text string) string {
+	folded, _ := fold(text)
+	return string(folded)
+}
+
+// Terms splits a search expression into folded words, ignoring quotes and negated (-word) terms.
+func Terms(expression string) []string {
+	terms := []string{}
+
+	for _, word := range strings.Fields(expression) {
+		word = strings.Trim(word, `"`)
+		if word == "" || strings.HasPrefix(word, "-") {
+			continue
+		}
+		terms = append(terms, Fold(word))
+	}
+
+	return terms
+}
+
+// Highlight wraps every accent and case insensitive occurrence of the terms with the open and close tags,
+// keeping the original spelling of the text. It reports whether any term was found.
+func Highlight(text string, terms []string, open string, close string) (string, bool) {
+	original := []rune(text)
+	folded, index := fold(text)
+	marked := make([]bool, len(original))
+	found := false
+
+	for _, term := range terms {
+		needle := []rune(Fold(term))
+		if len(needle) == 0 {
+			continue
+		}
+		for start := 0; start+len(needle) <= len(folded); start++ {
+			if !hasPrefix(folded[start:], needle) {
+				continue
+			}
+			for i := index[start]; i <= index[start+len(needle)-1]; i++ {
+				marked[i] = true
+			}
+			found = true
+		}
+	}
+
+	if !found {
+		return text, false
+	}
+
+	builder := strings.Builder{}
+	for i, r := range original {
+		if marked[i] && (i == 0 || !marked[i-1]) {
+			builder.WriteString(open)
+		}
+		builder.WriteRune(r)
+		if marked[i] && (i == len(original)-1 || !marked[i+1]) {
+			builder.WriteString(close)
+		}
+	}
+
+	return builder.String(), true
+}
+
+// fold returns the folded runes along with the position in the original text each of them came from.
+func fold(text string) ([]rune, []int) {
+	folded := []rune{}
+	index := []int{}
+
+	for i, r := range []rune(text) {
+		for _, d := range norm.NFD.String(string(r)) {
+			if unicode.Is(unicode.Mn, d) {
+				continue
+			}
+			folded = append(folded, unicode.ToLower(d))
+			index = append(index, i)
+		}
+	}
+
+	return folded, index
+}
+
+func hasPrefix(text []rune, prefix []rune) bool {
+	if len(text) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if text[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
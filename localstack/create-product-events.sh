@@ -0,0 +1,9 @@
+#!/bin/sh
+# Creates the product events topic and a queue subscribed to it, run by LocalStack once ready.
+awslocal sns create-topic --name product-events
+awslocal sqs create-queue --queue-name product-events
+awslocal sns subscribe \
+    --topic-arn arn:aws:sns:us-east-1:000000000000:product-events \
+    --protocol sqs \
+    --notification-endpoint arn:aws:sqs:us-east-1:000000000000:product-events \
+    --attributes RawMessageDelivery=true
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tbtec/tremligeiro/internal/core/domain/entity"
@@ -10,44 +11,126 @@ import (
 )
 
 type MockProductRepo struct {
-	CreateFunc         func(ctx context.Context, product *model.Product) error
-	DeleteByIdFunc     func(ctx context.Context, id string) (*model.Product, error)
-	FindByCategoryFunc func(ctx context.Context, categoryId int) (*[]model.Product, error)
-	FindOneFunc        func(ctx context.Context, id string) (*model.Product, error)
-	UpdateByIdFunc     func(ctx context.Context, product *model.Product) error
-	ExecuteFunc        func(ctx context.Context, productId string) (string, error)
+	CreateFunc       func(ctx context.Context, product *model.Product) error
+	DeleteByIdFunc   func(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error)
+	FindFunc         func(ctx context.Context, query model.ProductQuery) (*[]model.Product, error)
+	CountFunc        func(ctx context.Context, query model.ProductQuery) (int64, error)
+	StreamFunc       func(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error
+	SearchFunc       func(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error)
+	FindOneFunc      func(ctx context.Context, id string) (*model.Product, error)
+	UpdateByIdFunc   func(ctx context.Context, product *model.Product) error
+	BulkWriteFunc    func(ctx context.Context, writes []model.ProductWrite) ([]error, error)
+	RestoreFunc      func(ctx context.Context, id string, at time.Time) (*model.Product, error)
+	PurgeDeletedFunc func(ctx context.Context, before time.Time) (int64, error)
+	ExecuteFunc      func(ctx context.Context, productId string) (string, error)
 }
 
 type MockCategoryRepo struct {
-	FindByIdFunc func(id int) *entity.Category
+	CreateFunc     func(ctx context.Context, category *model.Category) error
+	FindByIdFunc   func(ctx context.Context, id int) (*model.Category, error)
+	FindAllFunc    func(ctx context.Context) (*[]model.Category, error)
+	UpdateByIdFunc func(ctx context.Context, category *model.Category) error
+	DeleteByIdFunc func(ctx context.Context, id int) error
 }
 
 func (m *MockProductRepo) Execute(ctx context.Context, productId string) (string, error) {
 	return m.ExecuteFunc(ctx, productId)
 }
 
-func (m *MockCategoryRepo) FindById(id int) *entity.Category {
-	return m.FindByIdFunc(id)
+func (m *MockCategoryRepo) Create(ctx context.Context, category *model.Category) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepo) FindById(ctx context.Context, id int) (*model.Category, error) {
+	if m.FindByIdFunc != nil {
+		return m.FindByIdFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepo) FindAll(ctx context.Context) (*[]model.Category, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx)
+	}
+	return &[]model.Category{}, nil
+}
+
+func (m *MockCategoryRepo) UpdateById(ctx context.Context, category *model.Category) error {
+	if m.UpdateByIdFunc != nil {
+		return m.UpdateByIdFunc(ctx, category)
+	}
+	return nil
+}
+
+func (m *MockCategoryRepo) DeleteById(ctx context.Context, id int) error {
+	if m.DeleteByIdFunc != nil {
+		return m.DeleteByIdFunc(ctx, id)
+	}
+	return nil
 }
 
 func (m *MockProductRepo) Create(ctx context.Context, product *model.Product) error {
 	return m.CreateFunc(ctx, product)
 }
 
-func (m *MockProductRepo) DeleteById(ctx context.Context, id string) (*model.Product, error) {
+func (m *MockProductRepo) DeleteById(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
 	if m.DeleteByIdFunc != nil {
-		return m.DeleteByIdFunc(ctx, id)
+		return m.DeleteByIdFunc(ctx, id, version, at)
 	}
-	return nil, nil
+	return &model.Product{ID: id}, nil
 }
 
-func (m *MockProductRepo) FindByCategory(ctx context.Context, categoryId int) (*[]model.Product, error) {
-	if m.FindByCategoryFunc != nil {
-		return m.FindByCategoryFunc(ctx, categoryId)
+func (m *MockProductRepo) Find(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(ctx, query)
 	}
 	return nil, nil
 }
 
+// Count falls back to the size of the Find result when no CountFunc is given
+func (m *MockProductRepo) Count(ctx context.Context, query model.ProductQuery) (int64, error) {
+	if m.CountFunc != nil {
+		return m.CountFunc(ctx, query)
+	}
+	if m.FindFunc != nil {
+		products, err := m.FindFunc(ctx, query)
+		if err != nil || products == nil {
+			return 0, err
+		}
+		return int64(len(*products)), nil
+	}
+	return 0, nil
+}
+
+// Stream falls back to the Find result when no StreamFunc is given
+func (m *MockProductRepo) Stream(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error {
+	if m.StreamFunc != nil {
+		return m.StreamFunc(ctx, query, fn)
+	}
+	if m.FindFunc != nil {
+		products, err := m.FindFunc(ctx, query)
+		if err != nil || products == nil {
+			return err
+		}
+		for _, product := range *products {
+			if err := fn(product); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MockProductRepo) Search(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, text, query, limit)
+	}
+	return &[]model.ProductMatch{}, nil
+}
+
 func (m *MockProductRepo) FindOne(ctx context.Context, id string) (*model.Product, error) {
 	if m.FindOneFunc != nil {
 		return m.FindOneFunc(ctx, id)
@@ -62,6 +145,27 @@ func (m *MockProductRepo) UpdateById(ctx context.Context, product *model.Product
 	return nil
 }
 
+func (m *MockProductRepo) BulkWrite(ctx context.Context, writes []model.ProductWrite) ([]error, error) {
+	if m.BulkWriteFunc != nil {
+		return m.BulkWriteFunc(ctx, writes)
+	}
+	return make([]error, len(writes)), nil
+}
+
+func (m *MockProductRepo) Restore(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+	if m.RestoreFunc != nil {
+		return m.RestoreFunc(ctx, id, at)
+	}
+	return nil, nil
+}
+
+func (m *MockProductRepo) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	if m.PurgeDeletedFunc != nil {
+		return m.PurgeDeletedFunc(ctx, before)
+	}
+	return 0, nil
+}
+
 // Mock compatível com a interface IProductRepository
 type MockProductRepoInterface struct{}
 
@@ -69,27 +173,63 @@ func (m *MockProductRepoInterface) Create(ctx context.Context, p *model.Product)
 func (m *MockProductRepoInterface) FindOne(ctx context.Context, id string) (*model.Product, error) {
 	return nil, nil
 }
-func (m *MockProductRepoInterface) FindByCategory(ctx context.Context, categoryId int) (*[]model.Product, error) {
-	return nil, nil
+func (m *MockProductRepoInterface) Find(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+	return &[]model.Product{}, nil
+}
+func (m *MockProductRepoInterface) Count(ctx context.Context, query model.ProductQuery) (int64, error) {
+	return 0, nil
+}
+func (m *MockProductRepoInterface) Stream(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error {
+	return nil
+}
+func (m *MockProductRepoInterface) Search(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+	return &[]model.ProductMatch{}, nil
 }
 func (m *MockProductRepoInterface) UpdateById(ctx context.Context, p *model.Product) error {
 	return nil
 }
-func (m *MockProductRepoInterface) DeleteById(ctx context.Context, id string) (*model.Product, error) {
+func (m *MockProductRepoInterface) BulkWrite(ctx context.Context, writes []model.ProductWrite) ([]error, error) {
+	return make([]error, len(writes)), nil
+}
+func (m *MockProductRepoInterface) DeleteById(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
+	return &model.Product{ID: id}, nil
+}
+func (m *MockProductRepoInterface) Restore(ctx context.Context, id string, at time.Time) (*model.Product, error) {
 	return nil, nil
 }
+func (m *MockProductRepoInterface) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
 
 // Mock compatível com a interface ICategoryRepository
 type MockCategoryRepoInterface struct{}
 
-func (m *MockCategoryRepoInterface) FindById(id int) *entity.Category {
-	return &entity.Category{ID: id, Name: "Mock"}
+func (m *MockCategoryRepoInterface) Create(ctx context.Context, c *model.Category) error { return nil }
+func (m *MockCategoryRepoInterface) FindById(ctx context.Context, id int) (*model.Category, error) {
+	return &model.Category{ID: id, Name: "Mock"}, nil
+}
+func (m *MockCategoryRepoInterface) FindAll(ctx context.Context) (*[]model.Category, error) {
+	return &[]model.Category{{ID: 1, Name: "Mock"}}, nil
+}
+func (m *MockCategoryRepoInterface) UpdateById(ctx context.Context, c *model.Category) error {
+	return nil
 }
+func (m *MockCategoryRepoInterface) DeleteById(ctx context.Context, id int) error { return nil }
 
 // Mock category repo that returns nil
 type MockCategoryRepoNotFound struct{}
 
-func (m *MockCategoryRepoNotFound) FindById(id int) *entity.Category { return nil }
+func (m *MockCategoryRepoNotFound) Create(ctx context.Context, c *model.Category) error { return nil }
+func (m *MockCategoryRepoNotFound) FindById(ctx context.Context, id int) (*model.Category, error) {
+	return nil, nil
+}
+func (m *MockCategoryRepoNotFound) FindAll(ctx context.Context) (*[]model.Category, error) {
+	return &[]model.Category{}, nil
+}
+func (m *MockCategoryRepoNotFound) UpdateById(ctx context.Context, c *model.Category) error {
+	return nil
+}
+func (m *MockCategoryRepoNotFound) DeleteById(ctx context.Context, id int) error { return nil }
 
 // Mock product repo that returns error
 type MockProductRepoError struct{}
@@ -98,12 +238,24 @@ func (m *MockProductRepoError) Create(ctx context.Context, p *model.Product) err
 	return assert.AnError
 }
 
-func (m *MockProductRepoError) DeleteById(ctx context.Context, id string) (*model.Product, error) {
+func (m *MockProductRepoError) DeleteById(ctx context.Context, id string, version int64, at time.Time) (*model.Product, error) {
 	return nil, errors.New("erro ao deletar produto")
 }
 
-func (m *MockProductRepoError) FindByCategory(ctx context.Context, categoryId int) (*[]model.Product, error) {
-	return nil, nil
+func (m *MockProductRepoError) Find(ctx context.Context, query model.ProductQuery) (*[]model.Product, error) {
+	return nil, errors.New("erro ao buscar produtos")
+}
+
+func (m *MockProductRepoError) Count(ctx context.Context, query model.ProductQuery) (int64, error) {
+	return 0, errors.New("erro ao contar produtos")
+}
+
+func (m *MockProductRepoError) Stream(ctx context.Context, query model.ProductQuery, fn func(product model.Product) error) error {
+	return errors.New("erro ao listar produtos")
+}
+
+func (m *MockProductRepoError) Search(ctx context.Context, text string, query model.ProductQuery, limit int) (*[]model.ProductMatch, error) {
+	return nil, errors.New("erro ao pesquisar produtos")
 }
 
 func (m *MockProductRepoError) FindOne(ctx context.Context, id string) (*model.Product, error) {
@@ -122,3 +274,43 @@ func (m *MockProductRepoError) FindAll(ctx context.Context) ([]*entity.Product,
 func (m *MockProductRepoError) UpdateById(ctx context.Context, product *model.Product) error {
 	return errors.New("erro ao atualizar produto")
 }
+
+func (m *MockProductRepoError) BulkWrite(ctx context.Context, writes []model.ProductWrite) ([]error, error) {
+	return nil, errors.New("erro ao atualizar produtos")
+}
+
+func (m *MockProductRepoError) Restore(ctx context.Context, id string, at time.Time) (*model.Product, error) {
+	return nil, errors.New("erro ao restaurar produto")
+}
+
+func (m *MockProductRepoError) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	return 0, errors.New("erro ao expurgar produtos")
+}
+
+// Mock outbox repo, pending events are always empty
+type MockOutboxRepo struct {
+	AddFunc func(ctx context.Context, events ...model.OutboxEvent) error
+}
+
+func (m *MockOutboxRepo) Add(ctx context.Context, events ...model.OutboxEvent) error {
+	if m.AddFunc != nil {
+		return m.AddFunc(ctx, events...)
+	}
+	return nil
+}
+
+func (m *MockOutboxRepo) FindPending(ctx context.Context, limit int) (*[]model.OutboxEvent, error) {
+	return &[]model.OutboxEvent{}, nil
+}
+
+func (m *MockOutboxRepo) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	return nil
+}
+
+func (m *MockOutboxRepo) MarkFailed(ctx context.Context, id string, reason string) error {
+	return nil
+}
+
+func (m *MockOutboxRepo) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}